@@ -21,6 +21,7 @@ import (
 	"math"
 	"os"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize/english"
@@ -124,6 +125,8 @@ func RenderDiffEvent(action apitype.UpdateKind, event engine.Event,
 		return renderDiffDiagEvent(event.Payload().(engine.DiagEventPayload), opts)
 	case engine.PolicyViolationEvent:
 		return renderDiffPolicyViolationEvent(event.Payload().(engine.PolicyViolationEventPayload), opts)
+	case engine.PolicyRemediationEvent:
+		return renderDiffPolicyRemediationEvent(event.Payload().(engine.PolicyRemediationEventPayload), "", opts)
 
 	default:
 		contract.Failf("unknown event type '%s'", event.Type)
@@ -142,6 +145,31 @@ func renderDiffPolicyViolationEvent(payload engine.PolicyViolationEventPayload,
 	return opts.Color.Colorize(payload.Prefix + payload.Message)
 }
 
+// renderDiffPolicyRemediationEvent renders a policy remediation as a header that names the policy and the resource it
+// remediated followed by the changes the remediation made to the resource's inputs. Each line is prefixed with the
+// given indentation.
+func renderDiffPolicyRemediationEvent(payload engine.PolicyRemediationEventPayload, indent string, opts Options) string {
+	var b bytes.Buffer
+	fprintfIgnoreError(&b, "%s%s[%s]  %s v%s %s %s (%s: %s)\n", indent,
+		colors.SpecInfo, apitype.Remediate,
+		payload.PolicyPackName,
+		payload.PolicyPackVersion, colors.Reset,
+		payload.PolicyName,
+		payload.ResourceURN.Type(),
+		payload.ResourceURN.Name())
+
+	if diff := payload.Before.Diff(payload.After); diff != nil {
+		var buf bytes.Buffer
+		engine.PrintObjectDiff(&buf, *diff, nil /*include*/, false /*planning*/, 2, true /*summary*/, opts.Debug)
+		for _, line := range strings.SplitAfter(buf.String(), "\n") {
+			if line != "" {
+				fprintIgnoreError(&b, indent+line)
+			}
+		}
+	}
+	return opts.Color.Colorize(b.String())
+}
+
 func renderStdoutColorEvent(payload engine.StdoutEventPayload, opts Options) string {
 	return opts.Color.Colorize(payload.Message)
 }
@@ -40,6 +40,13 @@ func ShowEvents(
 	if opts.EventLogPath != "" {
 		events, done = startEventLogger(events, done, opts.EventLogPath)
 	}
+	if opts.PolicyReportPath != "" {
+		stderr := opts.Stderr
+		if stderr == nil {
+			stderr = os.Stderr
+		}
+		events, done = startPolicyReporter(events, done, opts.PolicyReportPath, stderr)
+	}
 
 	if opts.JSONDisplay {
 		// TODO[pulumi/pulumi#2390]: enable JSON display for real deployments.
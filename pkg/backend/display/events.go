@@ -6,6 +6,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/engine"
 	"github.com/pulumi/pulumi/pkg/v3/resource/stack"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
@@ -57,14 +58,32 @@ func ConvertEngineEvent(e engine.Event) (apitype.EngineEvent, error) {
 			return apiEvent, eventTypePayloadMismatch
 		}
 		apiEvent.PolicyEvent = &apitype.PolicyEvent{
+			ResourceURN:            string(p.ResourceURN),
+			Message:                p.Message,
+			Color:                  string(p.Color),
+			PolicyName:             p.PolicyName,
+			PolicyPackName:         p.PolicyPackName,
+			PolicyPackVersion:      p.PolicyPackVersion,
+			PolicyPackVersionTag:   p.PolicyPackVersion,
+			EnforcementLevel:       string(p.EnforcementLevel),
+			Exempted:               p.Exempted,
+			ExemptionJustification: p.ExemptionJustification,
+		}
+
+	case engine.PolicyRemediationEvent:
+		p, ok := e.Payload().(engine.PolicyRemediationEventPayload)
+		if !ok {
+			return apiEvent, eventTypePayloadMismatch
+		}
+		apiEvent.PolicyRemediationEvent = &apitype.PolicyRemediationEvent{
 			ResourceURN:          string(p.ResourceURN),
-			Message:              p.Message,
 			Color:                string(p.Color),
 			PolicyName:           p.PolicyName,
 			PolicyPackName:       p.PolicyPackName,
 			PolicyPackVersion:    p.PolicyPackVersion,
 			PolicyPackVersionTag: p.PolicyPackVersion,
-			EnforcementLevel:     string(p.EnforcementLevel),
+			Before:               serializePropertyMap(p.Before),
+			After:                serializePropertyMap(p.After),
 		}
 
 	case engine.PreludeEvent:
@@ -199,13 +218,6 @@ func convertStepEventStateMetadata(md *engine.StepEventStateMetadata) *apitype.S
 		return nil
 	}
 
-	encrypter := config.BlindingCrypter
-	inputs, err := stack.SerializeProperties(md.Inputs, encrypter, false /* showSecrets */)
-	contract.IgnoreError(err)
-
-	outputs, err := stack.SerializeProperties(md.Outputs, encrypter, false /* showSecrets */)
-	contract.IgnoreError(err)
-
 	return &apitype.StepEventStateMetadata{
 		Type: string(md.Type),
 		URN:  string(md.URN),
@@ -215,8 +227,17 @@ func convertStepEventStateMetadata(md *engine.StepEventStateMetadata) *apitype.S
 		ID:         string(md.ID),
 		Parent:     string(md.Parent),
 		Protect:    md.Protect,
-		Inputs:     inputs,
-		Outputs:    outputs,
+		Inputs:     serializePropertyMap(md.Inputs),
+		Outputs:    serializePropertyMap(md.Outputs),
 		InitErrors: md.InitErrors,
 	}
 }
+
+// serializePropertyMap serializes the given property map for inclusion in an engine event.
+//
+// IMPORTANT: Any secret values are encrypted using the blinding encrypter, and are unrecoverable.
+func serializePropertyMap(m resource.PropertyMap) map[string]interface{} {
+	serialized, err := stack.SerializeProperties(m, config.BlindingCrypter, false /* showSecrets */)
+	contract.IgnoreError(err)
+	return serialized
+}
@@ -89,6 +89,16 @@ func stateForJSONOutput(s *resource.State, opts Options) *resource.State {
 		s.ImportID)
 }
 
+// propertiesForJSONOutput prepares a property map for JSON output. Secret values are replaced with "[secret]".
+func propertiesForJSONOutput(m resource.PropertyMap) map[string]interface{} {
+	props, err := stack.SerializeProperties(MassageSecrets(m, false), config.NewPanicCrypter(), false /* showSecrets */)
+	if err != nil {
+		logging.V(7).Infof("not adding properties as there was an error serializing: %s", err)
+		return nil
+	}
+	return props
+}
+
 // ShowJSONEvents renders engine events from a preview into a well-formed JSON document. Note that this does not
 // emit events incrementally so that it can guarantee anything emitted to stdout is well-formed. This means that,
 // if used interactively, the experience will lead to potentially very long pauses. If run in CI, it is up to the
@@ -184,6 +194,17 @@ func ShowJSONEvents(op string, action apitype.UpdateKind, events <-chan engine.E
 		case engine.PolicyViolationEvent:
 			// At this point in time, we don't handle policy events in JSON serialization
 			continue
+		case engine.PolicyRemediationEvent:
+			// Record the remediation along with the inputs before and after it was applied.
+			p := e.Payload().(engine.PolicyRemediationEventPayload)
+			digest.PolicyRemediations = append(digest.PolicyRemediations, previewPolicyRemediation{
+				URN:               p.ResourceURN,
+				PolicyName:        p.PolicyName,
+				PolicyPackName:    p.PolicyPackName,
+				PolicyPackVersion: p.PolicyPackVersion,
+				Before:            propertiesForJSONOutput(p.Before),
+				After:             propertiesForJSONOutput(p.After),
+			})
 		case engine.SummaryEvent:
 			// At the end of the preview, a summary event indicates the final conclusions.
 			p := e.Payload().(engine.SummaryEventPayload)
@@ -211,6 +232,8 @@ type previewDigest struct {
 	// Diagnostics contains a record of all warnings/errors that took place during the preview. Note that
 	// ephemeral and debug messages are omitted from this list, as they are meant for display purposes only.
 	Diagnostics []previewDiagnostic `json:"diagnostics,omitempty"`
+	// PolicyRemediations contains a record of all policy remediations that were applied to resource inputs.
+	PolicyRemediations []previewPolicyRemediation `json:"policyRemediations,omitempty"`
 
 	// Duration records the amount of time it took to perform the preview.
 	Duration time.Duration `json:"duration,omitempty"`
@@ -249,6 +272,16 @@ type previewStep struct {
 }
 
 // previewDiagnostic is a warning or error emitted during the execution of the preview.
+// previewPolicyRemediation records a policy remediation that transformed a resource's inputs.
+type previewPolicyRemediation struct {
+	URN               resource.URN           `json:"urn,omitempty"`
+	PolicyName        string                 `json:"policyName"`
+	PolicyPackName    string                 `json:"policyPackName"`
+	PolicyPackVersion string                 `json:"policyPackVersion"`
+	Before            map[string]interface{} `json:"before,omitempty"`
+	After             map[string]interface{} `json:"after,omitempty"`
+}
+
 type previewDiagnostic struct {
 	URN      resource.URN  `json:"urn,omitempty"`
 	Prefix   string        `json:"prefix,omitempty"`
@@ -225,6 +225,8 @@ func getEventUrnAndMetadata(event engine.Event) (resource.URN, *engine.StepEvent
 		return event.Payload().(engine.DiagEventPayload).URN, nil
 	case engine.PolicyViolationEvent:
 		return event.Payload().(engine.PolicyViolationEventPayload).ResourceURN, nil
+	case engine.PolicyRemediationEvent:
+		return event.Payload().(engine.PolicyRemediationEventPayload).ResourceURN, nil
 	default:
 		return "", nil
 	}
@@ -736,6 +738,7 @@ func (display *ProgressDisplay) processEndSteps() {
 	display.writeBlankLine()
 	wroteDiagnosticHeader := display.printDiagnostics()
 	wrotePolicyViolations := display.printPolicyViolations()
+	display.printPolicyRemediations()
 	display.printOutputs()
 	// If no policies violated, print policy packs applied.
 	if !wrotePolicyViolations {
@@ -867,9 +870,13 @@ func (display *ProgressDisplay) printPolicyViolations() bool {
 		if policyEvent.EnforcementLevel == apitype.Mandatory {
 			c = colors.SpecError
 		}
+		level := string(policyEvent.EnforcementLevel)
+		if policyEvent.Exempted {
+			c, level = colors.SpecInfo, "exempted "+level
+		}
 
 		policyNameLine := fmt.Sprintf("    %s[%s]  %s v%s %s %s (%s: %s)",
-			c, policyEvent.EnforcementLevel,
+			c, level,
 			policyEvent.PolicyPackName,
 			policyEvent.PolicyPackVersion, colors.Reset,
 			policyEvent.PolicyName,
@@ -885,6 +892,40 @@ func (display *ProgressDisplay) printPolicyViolations() bool {
 	return true
 }
 
+// printPolicyRemediations prints a new "Policy Remediations:" section with all of the remediations
+// grouped by policy pack. If no policy remediations were applied, prints nothing.
+func (display *ProgressDisplay) printPolicyRemediations() {
+	// Loop through every resource and gather up all policy remediations applied.
+	var remediationEvents []engine.PolicyRemediationEventPayload
+	for _, row := range display.eventUrnToResourceRow {
+		remediationEvents = append(remediationEvents, row.PolicyRemediationPayloads()...)
+	}
+	if len(remediationEvents) == 0 {
+		return
+	}
+	// Sort remediations by: policy pack name, policy pack version, and the URN of the resource.
+	// Remediations of the same resource are left in the order they were applied.
+	sort.SliceStable(remediationEvents, func(i, j int) bool {
+		eventI, eventJ := remediationEvents[i], remediationEvents[j]
+		if eventI.PolicyPackName != eventJ.PolicyPackName {
+			return eventI.PolicyPackName < eventJ.PolicyPackName
+		}
+		if eventI.PolicyPackVersion != eventJ.PolicyPackVersion {
+			return eventI.PolicyPackVersion < eventJ.PolicyPackVersion
+		}
+		return eventI.ResourceURN < eventJ.ResourceURN
+	})
+
+	display.writeSimpleMessage(display.opts.Color.Colorize(colors.SpecHeadline + "Policy Remediations:" + colors.Reset))
+	for _, remediationEvent := range remediationEvents {
+		msg := renderDiffPolicyRemediationEvent(remediationEvent, "    ", display.opts)
+		for _, line := range splitIntoDisplayableLines(msg) {
+			display.writeSimpleMessage(strings.TrimRightFunc(line, unicode.IsSpace))
+		}
+	}
+	display.writeBlankLine()
+}
+
 // printOutputs prints the Stack's outputs for the display in a new section, if appropriate.
 func (display *ProgressDisplay) printOutputs() {
 	// Printing the stack's outputs wasn't desired.
@@ -1091,7 +1132,8 @@ func (display *ProgressDisplay) processNormalEvent(event engine.Event) {
 	// Always show row if there's a policy violation event. Policy violations prevent resource
 	// registration, so if we don't show the row, the violation gets attributed to the stack
 	// resource rather than the resources whose policy failed.
-	hideRowIfUnnecessary = hideRowIfUnnecessary || event.Type == engine.PolicyViolationEvent
+	hideRowIfUnnecessary = hideRowIfUnnecessary || event.Type == engine.PolicyViolationEvent ||
+		event.Type == engine.PolicyRemediationEvent
 	if !hideRowIfUnnecessary {
 		row.SetHideRowIfUnnecessary(false)
 	}
@@ -1126,6 +1168,9 @@ func (display *ProgressDisplay) processNormalEvent(event engine.Event) {
 	} else if event.Type == engine.PolicyViolationEvent {
 		// also record this policy violation so we print it at the end.
 		row.RecordPolicyViolationEvent(event)
+	} else if event.Type == engine.PolicyRemediationEvent {
+		// also record this policy remediation so we print it at the end.
+		row.RecordPolicyRemediationEvent(event)
 	} else {
 		contract.Failf("Unhandled event type '%s'", event.Type)
 	}
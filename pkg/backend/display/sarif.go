@@ -0,0 +1,273 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// The types below model the subset of the SARIF 2.1.0 format (https://docs.oasis-open.org/sarif/sarif/v2.1.0/) that is
+// used to report policy violations.
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID               string                 `json:"id"`
+	Name             string                 `json:"name,omitempty"`
+	ShortDescription *sarifMessage          `json:"shortDescription,omitempty"`
+	Properties       map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID       string             `json:"ruleId"`
+	RuleIndex    int                `json:"ruleIndex"`
+	Level        string             `json:"level"`
+	Message      sarifMessage       `json:"message"`
+	Locations    []sarifLocation    `json:"locations,omitempty"`
+	Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+	Name               string `json:"name,omitempty"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind,omitempty"`
+}
+
+// sarifLevel returns the SARIF level that corresponds to the given enforcement level.
+func sarifLevel(level apitype.EnforcementLevel) string {
+	switch level {
+	case apitype.Mandatory:
+		return "error"
+	case apitype.Advisory:
+		return "warning"
+	case apitype.Remediate:
+		return "note"
+	default:
+		return "none"
+	}
+}
+
+// newPolicySARIFLog builds a SARIF log that reports the given policy diagnostics. Each policy is reported as a rule
+// whose ID is the name of the policy qualified by the name of its policy pack, and each diagnostic is reported as a
+// result whose logical location is the URN of the resource that violated the policy and whose physical location, if
+// known, is the position of the code that registered the resource. Exempted diagnostics are reported as externally
+// suppressed results.
+func newPolicySARIFLog(diagnostics []engine.PolicyDiagnostic) *sarifLog {
+	rules := []sarifRule{}
+	ruleIndices := map[string]int{}
+	results := []sarifResult{}
+	for _, d := range diagnostics {
+		id := d.PolicyPackName + "/" + d.PolicyName
+		index, ok := ruleIndices[id]
+		if !ok {
+			rule := sarifRule{
+				ID:   id,
+				Name: d.PolicyName,
+				Properties: map[string]interface{}{
+					"policyPackName":    d.PolicyPackName,
+					"policyPackVersion": d.PolicyPackVersion,
+				},
+			}
+			if d.Description != "" {
+				rule.ShortDescription = &sarifMessage{Text: d.Description}
+			}
+			if len(d.Tags) != 0 {
+				rule.Properties["tags"] = d.Tags
+			}
+
+			index = len(rules)
+			ruleIndices[id] = index
+			rules = append(rules, rule)
+		}
+
+		result := sarifResult{
+			RuleID:    id,
+			RuleIndex: index,
+			Level:     sarifLevel(d.EnforcementLevel),
+			Message:   sarifMessage{Text: d.Message},
+		}
+		if d.URN != "" || d.SourcePosition != nil {
+			var location sarifLocation
+			if d.URN != "" {
+				location.LogicalLocations = []sarifLogicalLocation{{
+					Name:               string(d.URN.Name()),
+					FullyQualifiedName: string(d.URN),
+					Kind:               "resource",
+				}}
+			}
+			if pos := d.SourcePosition; pos != nil {
+				location.PhysicalLocation = &sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: pos.URI},
+				}
+				if pos.Line > 0 {
+					location.PhysicalLocation.Region = &sarifRegion{StartLine: pos.Line, StartColumn: pos.Column}
+				}
+			}
+			result.Locations = []sarifLocation{location}
+		}
+		if d.Exemption != nil {
+			result.Suppressions = []sarifSuppression{{
+				Kind:          "external",
+				Justification: d.Exemption.Justification,
+			}}
+		}
+		results = append(results, result)
+	}
+
+	return &sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{
+				Driver: sarifDriver{
+					Name:           "pulumi",
+					InformationURI: "https://www.pulumi.com/docs/guides/crossguard/",
+					Rules:          rules,
+				},
+			},
+			Results: results,
+		}},
+	}
+}
+
+// WritePolicySARIF writes a SARIF 2.1.0 report of the given policy diagnostics to the given writer.
+func WritePolicySARIF(w io.Writer, diagnostics []engine.PolicyDiagnostic) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+	return encoder.Encode(newPolicySARIFLog(diagnostics))
+}
+
+// startPolicyReporter collects the policy violations reported by the given events and, once the events are complete,
+// writes a SARIF 2.1.0 report of them to the file at the given path.
+func startPolicyReporter(events <-chan engine.Event, done chan<- bool, path string,
+	stderr io.Writer) (<-chan engine.Event, chan<- bool) {
+
+	outEvents, outDone := make(chan engine.Event), make(chan bool)
+	go func() {
+		defer close(done)
+
+		var diagnostics []engine.PolicyDiagnostic
+		for e := range events {
+			if e.Type == engine.PolicyViolationEvent {
+				diagnostics = append(diagnostics, policyDiagnosticFromPayload(e.Payload().(engine.PolicyViolationEventPayload)))
+			}
+
+			outEvents <- e
+
+			if e.Type == engine.CancelEvent {
+				break
+			}
+		}
+
+		<-outDone
+
+		if err := writePolicyReport(path, diagnostics); err != nil {
+			fmt.Fprintf(stderr, "error: could not write policy report: %v\n", err)
+		}
+	}()
+
+	return outEvents, outDone
+}
+
+// policyDiagnosticFromPayload recovers the policy diagnostic reported by a policy violation event.
+func policyDiagnosticFromPayload(p engine.PolicyViolationEventPayload) engine.PolicyDiagnostic {
+	d := engine.PolicyDiagnostic{
+		AnalyzeDiagnostic: plugin.AnalyzeDiagnostic{
+			PolicyName:        p.PolicyName,
+			PolicyPackName:    p.PolicyPackName,
+			PolicyPackVersion: p.PolicyPackVersion,
+			Description:       p.Description,
+			Message:           strings.TrimSpace(colors.Never.Colorize(p.Message)),
+			Tags:              p.Tags,
+			EnforcementLevel:  p.EnforcementLevel,
+			URN:               p.ResourceURN,
+			SourcePosition:    p.SourcePosition,
+		},
+	}
+	if p.Exempted {
+		d.Exemption = &workspace.PolicyExemption{Policy: p.PolicyName, Justification: p.ExemptionJustification}
+	}
+	return d
+}
+
+func writePolicyReport(path string, diagnostics []engine.PolicyDiagnostic) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err = WritePolicySARIF(f, diagnostics); err != nil {
+		contract.IgnoreClose(f)
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,149 @@
+package display
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func TestPolicySARIF(t *testing.T) {
+	urn := resource.URN("urn:pulumi:dev::proj::aws:s3/bucket:Bucket::logs")
+	diags := []plugin.AnalyzeDiagnostic{
+		{
+			PolicyName:        "s3-encryption",
+			PolicyPackName:    "security",
+			PolicyPackVersion: "1.0.0",
+			Description:       "Buckets must be encrypted.",
+			Message:           "Bucket 'logs' is not encrypted.",
+			Tags:              []string{"security"},
+			EnforcementLevel:  apitype.Mandatory,
+			URN:               urn,
+			SourcePosition:    &resource.SourcePosition{URI: "file:///app/index.ts", Line: 12, Column: 5},
+		},
+		{
+			PolicyName:        "cost-tags",
+			PolicyPackName:    "security",
+			PolicyPackVersion: "1.0.0",
+			Message:           "Missing cost tags.",
+			EnforcementLevel:  apitype.Advisory,
+		},
+		{
+			PolicyName:        "s3-encryption",
+			PolicyPackName:    "security",
+			PolicyPackVersion: "1.0.0",
+			Message:           "Bucket 'data' is not encrypted.",
+			EnforcementLevel:  apitype.Mandatory,
+		},
+	}
+
+	policyDiags := make([]engine.PolicyDiagnostic, len(diags))
+	for i, d := range diags {
+		policyDiags[i] = engine.PolicyDiagnostic{AnalyzeDiagnostic: d}
+	}
+	policyDiags[2].Exemption = &workspace.PolicyExemption{
+		Policy:        "s3-encryption",
+		URN:           "*",
+		Justification: "Scheduled for deletion.",
+		Expires:       "2021-07-01",
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, WritePolicySARIF(&buf, policyDiags))
+
+	var log sarifLog
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &log))
+	assert.Equal(t, "2.1.0", log.Version)
+	if !assert.Len(t, log.Runs, 1) {
+		return
+	}
+	run := log.Runs[0]
+
+	// Diagnostics of the same policy should share a rule.
+	if assert.Len(t, run.Tool.Driver.Rules, 2) {
+		assert.Equal(t, "security/s3-encryption", run.Tool.Driver.Rules[0].ID)
+		assert.Equal(t, &sarifMessage{Text: "Buckets must be encrypted."}, run.Tool.Driver.Rules[0].ShortDescription)
+		assert.Equal(t, "security/cost-tags", run.Tool.Driver.Rules[1].ID)
+	}
+
+	if assert.Len(t, run.Results, 3) {
+		assert.Equal(t, "error", run.Results[0].Level)
+		assert.Equal(t, 0, run.Results[0].RuleIndex)
+		assert.Equal(t, []sarifLocation{{
+			PhysicalLocation: &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "file:///app/index.ts"},
+				Region:           &sarifRegion{StartLine: 12, StartColumn: 5},
+			},
+			LogicalLocations: []sarifLogicalLocation{{Name: "logs", FullyQualifiedName: string(urn), Kind: "resource"}},
+		}}, run.Results[0].Locations)
+
+		assert.Equal(t, "warning", run.Results[1].Level)
+		assert.Equal(t, 1, run.Results[1].RuleIndex)
+		assert.Empty(t, run.Results[1].Locations)
+		assert.Empty(t, run.Results[1].Suppressions)
+
+		assert.Equal(t, 0, run.Results[2].RuleIndex)
+		assert.Equal(t, []sarifSuppression{{Kind: "external", Justification: "Scheduled for deletion."}},
+			run.Results[2].Suppressions)
+	}
+}
+
+func TestPolicyReporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.sarif")
+
+	events, done := make(chan engine.Event), make(chan bool)
+	outEvents, outDone := startPolicyReporter(events, done, path, ioutil.Discard)
+
+	// Stand in for the display, which stops after the cancel event.
+	go func() {
+		for e := range outEvents {
+			if e.Type == engine.CancelEvent {
+				break
+			}
+		}
+		close(outDone)
+	}()
+
+	events <- engine.NewEvent(engine.PolicyViolationEvent, engine.PolicyViolationEventPayload{
+		ResourceURN:            "urn:pulumi:dev::proj::aws:s3/bucket:Bucket::logs",
+		Message:                colors.SpecNote + "Bucket 'logs' is not encrypted." + colors.Reset + "\n",
+		PolicyName:             "s3-encryption",
+		PolicyPackName:         "security",
+		PolicyPackVersion:      "1.0.0",
+		EnforcementLevel:       apitype.Mandatory,
+		SourcePosition:         &resource.SourcePosition{URI: "file:///app/main.go", Line: 7},
+		Exempted:               true,
+		ExemptionJustification: "Scheduled for deletion.",
+	})
+	events <- engine.NewEvent(engine.CancelEvent, nil)
+	<-done
+
+	b, err := ioutil.ReadFile(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	var log sarifLog
+	assert.NoError(t, json.Unmarshal(b, &log))
+	if assert.Len(t, log.Runs, 1) && assert.Len(t, log.Runs[0].Results, 1) {
+		result := log.Runs[0].Results[0]
+		assert.Equal(t, "security/s3-encryption", result.RuleID)
+		assert.Equal(t, "error", result.Level)
+		assert.Equal(t, "Bucket 'logs' is not encrypted.", result.Message.Text)
+		assert.Equal(t, &sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: "file:///app/main.go"},
+			Region:           &sarifRegion{StartLine: 7},
+		}, result.Locations[0].PhysicalLocation)
+		assert.Equal(t, []sarifSuppression{{Kind: "external", Justification: "Scheduled for deletion."}},
+			result.Suppressions)
+	}
+}
@@ -61,7 +61,12 @@ import (
 // Backend extends the base backend interface with specific information about local backends.
 type Backend interface {
 	backend.Backend
-	local() // at the moment, no local specific info, so just use a marker function.
+	local() // a marker function, as there is little local-specific information.
+
+	// AddStackToPolicyGroup adds the stacks whose names match the given pattern to a Policy Group.
+	AddStackToPolicyGroup(ctx context.Context, policyGroup, stack string) error
+	// RemoveStackFromPolicyGroup removes a stack name or pattern from a Policy Group.
+	RemoveStackFromPolicyGroup(ctx context.Context, policyGroup, stack string) error
 }
 
 type localBackend struct {
@@ -232,20 +237,6 @@ func (b *localBackend) StateDir() string {
 	return workspace.BookkeepingDir
 }
 
-func (b *localBackend) GetPolicyPack(ctx context.Context, policyPack string,
-	d diag.Sink) (backend.PolicyPack, error) {
-
-	return nil, fmt.Errorf("File state backend does not support resource policy")
-}
-
-func (b *localBackend) ListPolicyGroups(ctx context.Context, orgName string) (apitype.ListPolicyGroupsResponse, error) {
-	return apitype.ListPolicyGroupsResponse{}, fmt.Errorf("File state backend does not support resource policy")
-}
-
-func (b *localBackend) ListPolicyPacks(ctx context.Context, orgName string) (apitype.ListPolicyPacksResponse, error) {
-	return apitype.ListPolicyPacksResponse{}, fmt.Errorf("File state backend does not support resource policy")
-}
-
 // SupportsOrganizations tells whether a user can belong to multiple organizations in this backend.
 func (b *localBackend) SupportsOrganizations() bool {
 	return false
@@ -560,6 +551,13 @@ func (b *localBackend) apply(
 		return nil, result.FromError(err)
 	}
 
+	// Run the Policy Packs that are enabled for the stack's Policy Groups.
+	requiredPolicies, err := b.GetStackPolicyPacks(ctx, stackRef)
+	if err != nil {
+		return nil, result.FromError(err)
+	}
+	op.Opts.Engine.RequiredPolicies = append(op.Opts.Engine.RequiredPolicies, requiredPolicies...)
+
 	// Spawn a display loop to show events on the CLI.
 	displayEvents := make(chan engine.Event)
 	displayDone := make(chan bool)
@@ -0,0 +1,763 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gocloud.dev/gcerrors"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	resourceanalyzer "github.com/pulumi/pulumi/pkg/v3/resource/analyzer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// Policy Packs and Policy Groups are stored in the state bucket, next to the stacks they govern:
+//
+//     .pulumi/policies/groups.json                  the Policy Groups and the Policy Packs enabled for them
+//     .pulumi/policies/packs/<name>/<version>.json  the metadata of a published version of a Policy Pack
+//     .pulumi/policies/packs/<name>/<version>.tgz   the contents of a published version of a Policy Pack
+//     .pulumi/policies/packs/<name>/history.json    the number and tags of every version that has been published
+//
+// Versions are numbered from 1 in the order in which they are published. The numbers and tags of removed versions
+// are never reused, so that a version always refers to the same contents.
+
+const (
+	policyGroupsFile  = "groups.json"
+	policyPacksDir    = "packs"
+	policyHistoryFile = "history.json"
+)
+
+var policyPackVersionTagRE = regexp.MustCompile("^[a-zA-Z0-9-_.]{1,100}$")
+
+// policyPackVersion is the metadata of a published version of a Policy Pack.
+type policyPackVersion struct {
+	Name        string           `json:"name"`
+	DisplayName string           `json:"displayName"`
+	Version     int              `json:"version"`
+	VersionTag  string           `json:"versionTag"`
+	Policies    []apitype.Policy `json:"policies"`
+}
+
+// policyPackHistory records the versions of a Policy Pack that have ever been published, including those that have
+// since been removed.
+type policyPackHistory struct {
+	LatestVersion int      `json:"latestVersion"`
+	VersionTags   []string `json:"versionTags"`
+}
+
+// configSchema returns the config schema of each of the policies in this version of the Policy Pack.
+func (v *policyPackVersion) configSchema() map[string]apitype.PolicyConfigSchema {
+	schema := map[string]apitype.PolicyConfigSchema{}
+	for _, p := range v.Policies {
+		if p.ConfigSchema != nil {
+			schema[p.Name] = *p.ConfigSchema
+		}
+	}
+	return schema
+}
+
+// policyGroup is a set of Policy Packs that are enforced for the stacks that belong to the group. Stacks are
+// matched by name, and the names may contain glob patterns (e.g. `prod-*`). Stacks that do not belong to any other
+// group belong to the default group.
+type policyGroup struct {
+	Name        string                       `json:"name"`
+	Stacks      []string                     `json:"stacks,omitempty"`
+	PolicyPacks []apitype.PolicyPackMetadata `json:"policyPacks,omitempty"`
+}
+
+// matches returns true if the given stack belongs to this group.
+func (g *policyGroup) matches(stack tokens.QName) bool {
+	for _, pattern := range g.Stacks {
+		if ok, err := path.Match(pattern, string(stack)); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// enabledVersion returns the index of the enabled version of the given Policy Pack, or -1 if it is not enabled.
+func (g *policyGroup) enabledVersion(name string) int {
+	for i, p := range g.PolicyPacks {
+		if p.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// policyGroups is the content of the Policy Groups file.
+type policyGroups struct {
+	PolicyGroups []*policyGroup `json:"policyGroups"`
+}
+
+// get returns the Policy Group with the given name, or the default Policy Group if the name is empty. If create is
+// true, a missing group is created.
+func (gs *policyGroups) get(name string, create bool) *policyGroup {
+	if name == "" {
+		name = apitype.DefaultPolicyGroup
+	}
+	for _, g := range gs.PolicyGroups {
+		if g.Name == name {
+			return g
+		}
+	}
+	if !create {
+		return nil
+	}
+	g := &policyGroup{Name: name}
+	gs.PolicyGroups = append(gs.PolicyGroups, g)
+	return g
+}
+
+// forStack returns the Policy Groups that the given stack belongs to.
+func (gs *policyGroups) forStack(stack tokens.QName) []*policyGroup {
+	var groups []*policyGroup
+	for _, g := range gs.PolicyGroups {
+		if g.Name != apitype.DefaultPolicyGroup && g.matches(stack) {
+			groups = append(groups, g)
+		}
+	}
+	if len(groups) == 0 {
+		if def := gs.get("", false); def != nil {
+			groups = append(groups, def)
+		}
+	}
+	return groups
+}
+
+func (b *localBackend) policyDirectory() string {
+	return filepath.Join(b.StateDir(), workspace.PolicyDir)
+}
+
+func (b *localBackend) policyPackDirectory(name string) string {
+	return filepath.Join(b.policyDirectory(), policyPacksDir, name)
+}
+
+func (b *localBackend) policyPackPath(name string, version int, ext string) string {
+	return filepath.Join(b.policyPackDirectory(name), strconv.Itoa(version)+ext)
+}
+
+// getPolicyGroups loads the Policy Groups from the bucket. The default Policy Group always exists.
+func (b *localBackend) getPolicyGroups(ctx context.Context) (*policyGroups, error) {
+	var groups policyGroups
+	byts, err := b.bucket.ReadAll(ctx, filepath.Join(b.policyDirectory(), policyGroupsFile))
+	switch {
+	case gcerrors.Code(errors.Cause(err)) == gcerrors.NotFound:
+	case err != nil:
+		return nil, errors.Wrap(err, "reading policy groups")
+	default:
+		if err = json.Unmarshal(byts, &groups); err != nil {
+			return nil, errors.Wrap(err, "reading policy groups")
+		}
+	}
+	groups.get("", true)
+	return &groups, nil
+}
+
+func (b *localBackend) savePolicyGroups(ctx context.Context, groups *policyGroups) error {
+	byts, err := json.MarshalIndent(groups, "", "    ")
+	if err != nil {
+		return err
+	}
+	return b.bucket.WriteAll(ctx, filepath.Join(b.policyDirectory(), policyGroupsFile), byts, nil)
+}
+
+// listPolicyPackNames returns the names of all published Policy Packs.
+func (b *localBackend) listPolicyPackNames() ([]string, error) {
+	files, err := listBucket(b.bucket, filepath.Join(b.policyDirectory(), policyPacksDir))
+	if err != nil {
+		if gcerrors.Code(errors.Cause(err)) == gcerrors.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var names []string
+	for _, file := range files {
+		if file.IsDir {
+			names = append(names, path.Base(file.Key))
+		}
+	}
+	return names, nil
+}
+
+// listPolicyPackVersions returns the published versions of the given Policy Pack, oldest first.
+func (b *localBackend) listPolicyPackVersions(ctx context.Context, name string) ([]*policyPackVersion, error) {
+	files, err := listBucket(b.bucket, b.policyPackDirectory(name))
+	if err != nil {
+		if gcerrors.Code(errors.Cause(err)) == gcerrors.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var versions []*policyPackVersion
+	for _, file := range files {
+		if file.IsDir || path.Ext(file.Key) != ".json" || path.Base(file.Key) == policyHistoryFile {
+			continue
+		}
+		byts, err := b.bucket.ReadAll(ctx, file.Key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading %s", file.Key)
+		}
+		var v policyPackVersion
+		if err = json.Unmarshal(byts, &v); err != nil {
+			return nil, errors.Wrapf(err, "reading %s", file.Key)
+		}
+		versions = append(versions, &v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	return versions, nil
+}
+
+// getPolicyPackHistory loads the publishing history of the given Policy Pack. Versions that were published before the
+// history was recorded are added to it.
+func (b *localBackend) getPolicyPackHistory(ctx context.Context, name string,
+	versions []*policyPackVersion) (*policyPackHistory, error) {
+
+	var history policyPackHistory
+	key := filepath.Join(b.policyPackDirectory(name), policyHistoryFile)
+	byts, err := b.bucket.ReadAll(ctx, key)
+	switch {
+	case gcerrors.Code(errors.Cause(err)) == gcerrors.NotFound:
+	case err != nil:
+		return nil, errors.Wrapf(err, "reading %s", key)
+	default:
+		if err = json.Unmarshal(byts, &history); err != nil {
+			return nil, errors.Wrapf(err, "reading %s", key)
+		}
+	}
+
+	for _, v := range versions {
+		if !history.hasVersionTag(v.VersionTag) {
+			history.VersionTags = append(history.VersionTags, v.VersionTag)
+		}
+		if v.Version > history.LatestVersion {
+			history.LatestVersion = v.Version
+		}
+	}
+	return &history, nil
+}
+
+func (b *localBackend) savePolicyPackHistory(ctx context.Context, name string, history *policyPackHistory) error {
+	byts, err := json.MarshalIndent(history, "", "    ")
+	if err != nil {
+		return err
+	}
+	return b.bucket.WriteAll(ctx, filepath.Join(b.policyPackDirectory(name), policyHistoryFile), byts, nil)
+}
+
+func (h *policyPackHistory) hasVersionTag(versionTag string) bool {
+	for _, t := range h.VersionTags {
+		if t == versionTag {
+			return true
+		}
+	}
+	return false
+}
+
+// getPolicyPackVersion returns the given version of a Policy Pack, or its latest version if versionTag is nil.
+func (b *localBackend) getPolicyPackVersion(ctx context.Context, name string,
+	versionTag *string) (*policyPackVersion, error) {
+
+	versions, err := b.listPolicyPackVersions(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, errors.Errorf("policy pack %q has not been published", name)
+	}
+	if versionTag == nil {
+		return versions[len(versions)-1], nil
+	}
+	for _, v := range versions {
+		if v.VersionTag == *versionTag {
+			return v, nil
+		}
+	}
+	return nil, errors.Errorf("policy pack %q has no version %q", name, *versionTag)
+}
+
+// GetStackPolicyPacks returns the Policy Packs that are enabled for the Policy Groups the given stack belongs to.
+func (b *localBackend) GetStackPolicyPacks(ctx context.Context,
+	stackRef backend.StackReference) ([]engine.RequiredPolicy, error) {
+
+	stack := stackRef.Name()
+	groups, err := b.getPolicyGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orgName, err := b.CurrentUser()
+	if err != nil {
+		return nil, err
+	}
+
+	var required []engine.RequiredPolicy
+	seen := map[string]bool{}
+	for _, g := range groups.forStack(stack) {
+		for _, p := range g.PolicyPacks {
+			// A Policy Pack that is enabled for several of the stack's groups is only run once.
+			if seen[p.Name] {
+				continue
+			}
+			seen[p.Name] = true
+			required = append(required, &localRequiredPolicy{PolicyPackMetadata: p, b: b, orgName: orgName})
+		}
+	}
+	return required, nil
+}
+
+func (b *localBackend) parsePolicyPackReference(s string) (*localPolicyPackReference, error) {
+	var orgName, name string
+	switch split := strings.Split(s, "/"); len(split) {
+	case 1:
+		name = split[0]
+	case 2:
+		orgName, name = split[0], split[1]
+	default:
+		return nil, errors.Errorf("could not parse policy pack name '%s'; must be of the form "+
+			"<org-name>/<policy-pack-name> or <policy-pack-name>", s)
+	}
+
+	if orgName == "" {
+		currentUser, err := b.CurrentUser()
+		if err != nil {
+			return nil, err
+		}
+		orgName = currentUser
+	}
+	return &localPolicyPackReference{orgName: orgName, name: tokens.QName(name)}, nil
+}
+
+func (b *localBackend) GetPolicyPack(ctx context.Context, policyPack string,
+	d diag.Sink) (backend.PolicyPack, error) {
+
+	ref, err := b.parsePolicyPackReference(policyPack)
+	if err != nil {
+		return nil, err
+	}
+	return &localPolicyPack{ref: ref, b: b}, nil
+}
+
+// ListPolicyGroups returns the Policy Groups stored in the state bucket. Self-managed backends do not have
+// organizations, so the organization name is ignored.
+func (b *localBackend) ListPolicyGroups(ctx context.Context, orgName string) (apitype.ListPolicyGroupsResponse, error) {
+	groups, err := b.getPolicyGroups(ctx)
+	if err != nil {
+		return apitype.ListPolicyGroupsResponse{}, err
+	}
+	stacks, err := b.getLocalStacks()
+	if err != nil {
+		return apitype.ListPolicyGroupsResponse{}, err
+	}
+
+	numStacks := map[string]int{}
+	for _, stack := range stacks {
+		for _, g := range groups.forStack(stack) {
+			numStacks[g.Name]++
+		}
+	}
+
+	resp := apitype.ListPolicyGroupsResponse{PolicyGroups: []apitype.PolicyGroupSummary{}}
+	for _, g := range groups.PolicyGroups {
+		resp.PolicyGroups = append(resp.PolicyGroups, apitype.PolicyGroupSummary{
+			Name:                  g.Name,
+			IsOrgDefault:          g.Name == apitype.DefaultPolicyGroup,
+			NumStacks:             numStacks[g.Name],
+			NumEnabledPolicyPacks: len(g.PolicyPacks),
+		})
+	}
+	return resp, nil
+}
+
+// ListPolicyPacks returns the Policy Packs stored in the state bucket. Self-managed backends do not have
+// organizations, so the organization name is ignored.
+func (b *localBackend) ListPolicyPacks(ctx context.Context, orgName string) (apitype.ListPolicyPacksResponse, error) {
+	names, err := b.listPolicyPackNames()
+	if err != nil {
+		return apitype.ListPolicyPacksResponse{}, err
+	}
+
+	resp := apitype.ListPolicyPacksResponse{PolicyPacks: []apitype.PolicyPackWithVersions{}}
+	for _, name := range names {
+		versions, err := b.listPolicyPackVersions(ctx, name)
+		if err != nil {
+			return apitype.ListPolicyPacksResponse{}, err
+		}
+		if len(versions) == 0 {
+			continue
+		}
+
+		pack := apitype.PolicyPackWithVersions{
+			Name:        name,
+			DisplayName: versions[len(versions)-1].DisplayName,
+		}
+		for _, v := range versions {
+			pack.Versions = append(pack.Versions, v.Version)
+			pack.VersionTags = append(pack.VersionTags, v.VersionTag)
+		}
+		resp.PolicyPacks = append(resp.PolicyPacks, pack)
+	}
+	return resp, nil
+}
+
+// AddStackToPolicyGroup adds the stacks whose names match the given pattern to a Policy Group, creating the group
+// if it does not exist.
+func (b *localBackend) AddStackToPolicyGroup(ctx context.Context, policyGroup, stack string) error {
+	if policyGroup == "" || policyGroup == apitype.DefaultPolicyGroup {
+		return errors.New("stacks cannot be added to the default policy group; " +
+			"it applies to every stack that does not belong to another policy group")
+	}
+	if _, err := path.Match(stack, ""); err != nil {
+		return errors.Wrapf(err, "invalid stack pattern %q", stack)
+	}
+
+	groups, err := b.getPolicyGroups(ctx)
+	if err != nil {
+		return err
+	}
+	g := groups.get(policyGroup, true)
+	for _, s := range g.Stacks {
+		if s == stack {
+			return nil
+		}
+	}
+	g.Stacks = append(g.Stacks, stack)
+	return b.savePolicyGroups(ctx, groups)
+}
+
+// RemoveStackFromPolicyGroup removes a stack name or pattern that was previously added to a Policy Group.
+func (b *localBackend) RemoveStackFromPolicyGroup(ctx context.Context, policyGroup, stack string) error {
+	groups, err := b.getPolicyGroups(ctx)
+	if err != nil {
+		return err
+	}
+	g := groups.get(policyGroup, false)
+	if g == nil {
+		return errors.Errorf("policy group %q does not exist", policyGroup)
+	}
+	for i, s := range g.Stacks {
+		if s == stack {
+			g.Stacks = append(g.Stacks[:i], g.Stacks[i+1:]...)
+			return b.savePolicyGroups(ctx, groups)
+		}
+	}
+	return errors.Errorf("stack %q is not in policy group %q", stack, policyGroup)
+}
+
+// localRequiredPolicy is a Policy Pack that is enabled for a stack in a self-managed backend.
+type localRequiredPolicy struct {
+	apitype.PolicyPackMetadata
+	b       *localBackend
+	orgName string
+}
+
+var _ engine.RequiredPolicy = (*localRequiredPolicy)(nil)
+
+func (rp *localRequiredPolicy) Name() string    { return rp.PolicyPackMetadata.Name }
+func (rp *localRequiredPolicy) Version() string { return strconv.Itoa(rp.PolicyPackMetadata.Version) }
+
+func (rp *localRequiredPolicy) Config() map[string]*json.RawMessage {
+	return rp.PolicyPackMetadata.Config
+}
+
+func (rp *localRequiredPolicy) Install(ctx context.Context) (string, error) {
+	policy := rp.PolicyPackMetadata
+
+	policyPackPath, installed, err := workspace.GetPolicyPath(rp.orgName,
+		rp.b.policyPackInstallName(policy.Name), policy.VersionTag)
+	if err != nil {
+		// Failed to get a sensible PolicyPack path.
+		return "", err
+	} else if installed {
+		// We've already downloaded and installed the PolicyPack. Return.
+		return policyPackPath, nil
+	}
+
+	fmt.Printf("Installing policy pack %s %s...\n", policy.Name, policy.VersionTag)
+
+	tarball, err := rp.b.bucket.ReadAll(ctx, rp.b.policyPackPath(policy.Name, policy.Version, ".tgz"))
+	if err != nil {
+		return "", errors.Wrapf(err, "reading policy pack %s %s", policy.Name, policy.VersionTag)
+	}
+	return policyPackPath, backend.InstallPolicyPack(policyPackPath, ioutil.NopCloser(bytes.NewReader(tarball)))
+}
+
+// policyPackInstallName returns the name under which a Policy Pack from this backend is installed locally. The name
+// includes a hash of the backend's URL, as Policy Packs in different backends may share a name and version tag.
+func (b *localBackend) policyPackInstallName(name string) string {
+	hash := sha256.Sum256([]byte(b.url))
+	return fmt.Sprintf("%s-%x", strings.Replace(name, tokens.QNameDelimiter, "_", -1), hash[:4])
+}
+
+// localPolicyPackReference is a reference to a Policy Pack stored in a self-managed backend.
+type localPolicyPackReference struct {
+	// orgName is the name of the organization the Policy Pack is installed for. Self-managed backends do not have
+	// organizations, so this is only used to choose where the Policy Pack is installed locally.
+	orgName string
+	// name of the Policy Pack.
+	name tokens.QName
+}
+
+var _ backend.PolicyPackReference = (*localPolicyPackReference)(nil)
+
+func (pr *localPolicyPackReference) String() string {
+	return fmt.Sprintf("%s/%s", pr.orgName, pr.name)
+}
+
+func (pr *localPolicyPackReference) OrgName() string {
+	return pr.orgName
+}
+
+func (pr *localPolicyPackReference) Name() tokens.QName {
+	return pr.name
+}
+
+// localPolicyPack is the self-managed backend implementation of the PolicyPack interface.
+type localPolicyPack struct {
+	ref *localPolicyPackReference
+	b   *localBackend
+}
+
+var _ backend.PolicyPack = (*localPolicyPack)(nil)
+
+func (pack *localPolicyPack) Ref() backend.PolicyPackReference {
+	return pack.ref
+}
+
+func (pack *localPolicyPack) Backend() backend.Backend {
+	return pack.b
+}
+
+func (pack *localPolicyPack) Publish(ctx context.Context, op backend.PublishOperation) result.Result {
+	fmt.Println("Obtaining policy metadata from policy plugin")
+
+	abs, err := filepath.Abs(op.PlugCtx.Pwd)
+	if err != nil {
+		return result.FromError(err)
+	}
+
+	analyzer, err := op.PlugCtx.Host.PolicyAnalyzer(tokens.QName(abs), op.PlugCtx.Pwd, nil /*opts*/)
+	if err != nil {
+		return result.FromError(err)
+	}
+
+	analyzerInfo, err := analyzer.GetAnalyzerInfo()
+	if err != nil {
+		return result.FromError(err)
+	}
+
+	policies := make([]apitype.Policy, len(analyzerInfo.Policies))
+	for i, policy := range analyzerInfo.Policies {
+		configSchema, err := resourceanalyzer.ConvertPolicyConfigSchema(policy.ConfigSchema)
+		if err != nil {
+			return result.FromError(err)
+		}
+		policies[i] = apitype.Policy{
+			Name:             policy.Name,
+			DisplayName:      policy.DisplayName,
+			Description:      policy.Description,
+			EnforcementLevel: policy.EnforcementLevel,
+			Message:          policy.Message,
+			ConfigSchema:     configSchema,
+		}
+	}
+
+	fmt.Println("Compressing policy pack")
+
+	tarball, err := backend.PackPolicyPack(op.PolicyPack.Runtime.Name(), op.PlugCtx.Pwd)
+	if err != nil {
+		return result.FromError(err)
+	}
+
+	pack.ref.name = tokens.QName(analyzerInfo.Name)
+	version, err := pack.b.publishPolicyPack(ctx, policyPackVersion{
+		Name:        analyzerInfo.Name,
+		DisplayName: analyzerInfo.DisplayName,
+		VersionTag:  analyzerInfo.Version,
+		Policies:    policies,
+	}, tarball)
+	if err != nil {
+		return result.FromError(err)
+	}
+
+	fmt.Printf("Published %q version %s to %s\n", version.Name, version.VersionTag, pack.b.URL())
+	return nil
+}
+
+// publishPolicyPack stores a new version of a Policy Pack in the bucket. The version number is assigned here, and
+// the version tag defaults to the version number if the Policy Pack does not report one.
+func (b *localBackend) publishPolicyPack(ctx context.Context, version policyPackVersion,
+	tarball []byte) (*policyPackVersion, error) {
+
+	if version.VersionTag != "" && !policyPackVersionTagRE.MatchString(version.VersionTag) {
+		return nil, errors.Errorf("invalid version %q - version may only contain alphanumeric, hyphens, "+
+			"or underscores. It must also be between 1 and 100 characters long.", version.VersionTag)
+	}
+
+	versions, err := b.listPolicyPackVersions(ctx, version.Name)
+	if err != nil {
+		return nil, err
+	}
+	history, err := b.getPolicyPackHistory(ctx, version.Name, versions)
+	if err != nil {
+		return nil, err
+	}
+	version.Version = history.LatestVersion + 1
+	if version.VersionTag == "" {
+		version.VersionTag = strconv.Itoa(version.Version)
+	}
+	if history.hasVersionTag(version.VersionTag) {
+		return nil, errors.Errorf("version %s of policy pack %q has already been published",
+			version.VersionTag, version.Name)
+	}
+
+	byts, err := json.MarshalIndent(version, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the version in the history first, so that its number and tag are not reused even if writing the
+	// version fails part of the way through. Then write the contents, so that the version is never listed without
+	// them.
+	history.LatestVersion = version.Version
+	history.VersionTags = append(history.VersionTags, version.VersionTag)
+	if err = b.savePolicyPackHistory(ctx, version.Name, history); err != nil {
+		return nil, errors.Wrap(err, "writing policy pack")
+	}
+	if err = b.bucket.WriteAll(ctx, b.policyPackPath(version.Name, version.Version, ".tgz"), tarball, nil); err != nil {
+		return nil, errors.Wrap(err, "writing policy pack")
+	}
+	if err = b.bucket.WriteAll(ctx, b.policyPackPath(version.Name, version.Version, ".json"), byts, nil); err != nil {
+		return nil, errors.Wrap(err, "writing policy pack")
+	}
+	return &version, nil
+}
+
+func (pack *localPolicyPack) Enable(ctx context.Context, policyGroup string, op backend.PolicyPackOperation) error {
+	version, err := pack.b.getPolicyPackVersion(ctx, string(pack.ref.name), op.VersionTag)
+	if err != nil {
+		return err
+	}
+	if err = resourceanalyzer.ValidatePolicyPackConfig(version.configSchema(), op.Config); err != nil {
+		return err
+	}
+
+	groups, err := pack.b.getPolicyGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Only one version of a Policy Pack can be enabled for a Policy Group, so enabling a version replaces any
+	// other version that is enabled.
+	metadata := apitype.PolicyPackMetadata{
+		Name:        version.Name,
+		DisplayName: version.DisplayName,
+		Version:     version.Version,
+		VersionTag:  version.VersionTag,
+		Config:      op.Config,
+	}
+	g := groups.get(policyGroup, true)
+	if i := g.enabledVersion(version.Name); i != -1 {
+		g.PolicyPacks[i] = metadata
+	} else {
+		g.PolicyPacks = append(g.PolicyPacks, metadata)
+	}
+	return pack.b.savePolicyGroups(ctx, groups)
+}
+
+func (pack *localPolicyPack) Disable(ctx context.Context, policyGroup string, op backend.PolicyPackOperation) error {
+	groups, err := pack.b.getPolicyGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	g := groups.get(policyGroup, false)
+	if g == nil {
+		return errors.Errorf("policy group %q does not exist", policyGroup)
+	}
+	i := g.enabledVersion(string(pack.ref.name))
+	if i == -1 || op.VersionTag != nil && g.PolicyPacks[i].VersionTag != *op.VersionTag {
+		return errors.Errorf("policy pack %q is not enabled for policy group %q", pack.ref.name, g.Name)
+	}
+	g.PolicyPacks = append(g.PolicyPacks[:i], g.PolicyPacks[i+1:]...)
+	return pack.b.savePolicyGroups(ctx, groups)
+}
+
+func (pack *localPolicyPack) Validate(ctx context.Context, op backend.PolicyPackOperation) error {
+	version, err := pack.b.getPolicyPackVersion(ctx, string(pack.ref.name), op.VersionTag)
+	if err != nil {
+		return err
+	}
+	return resourceanalyzer.ValidatePolicyPackConfig(version.configSchema(), op.Config)
+}
+
+func (pack *localPolicyPack) Remove(ctx context.Context, op backend.PolicyPackOperation) error {
+	name := string(pack.ref.name)
+	versions, err := pack.b.listPolicyPackVersions(ctx, name)
+	if err != nil {
+		return err
+	}
+	if op.VersionTag != nil {
+		v, err := pack.b.getPolicyPackVersion(ctx, name, op.VersionTag)
+		if err != nil {
+			return err
+		}
+		versions = []*policyPackVersion{v}
+	} else if len(versions) == 0 {
+		return errors.Errorf("policy pack %q has not been published", name)
+	}
+
+	// Versions that are enabled for a Policy Group cannot be removed.
+	groups, err := pack.b.getPolicyGroups(ctx)
+	if err != nil {
+		return err
+	}
+	for _, v := range versions {
+		for _, g := range groups.PolicyGroups {
+			if i := g.enabledVersion(name); i != -1 && g.PolicyPacks[i].Version == v.Version {
+				return errors.Errorf("version %s of policy pack %q is enabled for policy group %q; "+
+					"it must be disabled before it can be removed", v.VersionTag, name, g.Name)
+			}
+		}
+	}
+
+	for _, v := range versions {
+		for _, ext := range []string{".json", ".tgz"} {
+			if err := pack.b.bucket.Delete(ctx, pack.b.policyPackPath(name, v.Version, ext)); err != nil {
+				return errors.Wrapf(err, "removing version %s of policy pack %q", v.VersionTag, name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestate
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/archive"
+)
+
+func newTestBackend(t *testing.T) *localBackend {
+	dir, err := ioutil.TempDir("", "filestate")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	b, err := New(nil, FilePathPrefix+dir)
+	require.NoError(t, err)
+	return b.(*localBackend)
+}
+
+func publishTestPolicyPack(t *testing.T, b *localBackend, name, versionTag string) {
+	dir, err := ioutil.TempDir("", "policypack")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "PulumiPolicy.yaml"), []byte("runtime: go\n"), 0600)
+	require.NoError(t, err)
+	tarball, err := archive.TGZ(dir, "package", true)
+	require.NoError(t, err)
+
+	_, err = b.publishPolicyPack(context.Background(), policyPackVersion{
+		Name:       name,
+		VersionTag: versionTag,
+		Policies: []apitype.Policy{{
+			Name: "s3-encryption",
+			ConfigSchema: &apitype.PolicyConfigSchema{
+				Type:     apitype.Object,
+				Required: []string{"algorithm"},
+			},
+		}},
+	}, tarball)
+	require.NoError(t, err)
+}
+
+func TestPolicyPacks(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+
+	publishTestPolicyPack(t, b, "security", "1.0.0")
+	publishTestPolicyPack(t, b, "security", "")
+
+	packs, err := b.ListPolicyPacks(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []apitype.PolicyPackWithVersions{{
+		Name:        "security",
+		Versions:    []int{1, 2},
+		VersionTags: []string{"1.0.0", "2"},
+	}}, packs.PolicyPacks)
+
+	_, err = b.publishPolicyPack(ctx, policyPackVersion{Name: "security", VersionTag: "1.0.0"}, nil)
+	assert.EqualError(t, err, `version 1.0.0 of policy pack "security" has already been published`)
+
+	pack, err := b.GetPolicyPack(ctx, "org/security", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "org/security", pack.Ref().String())
+
+	// Configuration is validated against the schema of the enabled version.
+	version := "1.0.0"
+	err = pack.Enable(ctx, "", backend.PolicyPackOperation{VersionTag: &version})
+	assert.Error(t, err)
+
+	config := json.RawMessage(`{"algorithm": "AES256"}`)
+	op := backend.PolicyPackOperation{
+		VersionTag: &version,
+		Config:     map[string]*json.RawMessage{"s3-encryption": &config},
+	}
+	require.NoError(t, pack.Enable(ctx, "", op))
+	require.NoError(t, pack.Enable(ctx, "production", backend.PolicyPackOperation{Config: op.Config}))
+
+	// Enabled versions cannot be removed.
+	err = pack.Remove(ctx, backend.PolicyPackOperation{})
+	assert.EqualError(t, err, `version 1.0.0 of policy pack "security" is enabled for policy group `+
+		`"default-policy-group"; it must be disabled before it can be removed`)
+
+	err = pack.Disable(ctx, "missing", backend.PolicyPackOperation{})
+	assert.EqualError(t, err, `policy group "missing" does not exist`)
+	require.NoError(t, pack.Disable(ctx, "", backend.PolicyPackOperation{}))
+	require.NoError(t, pack.Remove(ctx, backend.PolicyPackOperation{VersionTag: &version}))
+
+	packs, err = b.ListPolicyPacks(ctx, "")
+	require.NoError(t, err)
+	if assert.Len(t, packs.PolicyPacks, 1) {
+		assert.Equal(t, []string{"2"}, packs.PolicyPacks[0].VersionTags)
+	}
+
+	// The numbers and tags of removed versions are not reused.
+	require.NoError(t, pack.Disable(ctx, "production", backend.PolicyPackOperation{}))
+	require.NoError(t, pack.Remove(ctx, backend.PolicyPackOperation{}))
+	_, err = b.publishPolicyPack(ctx, policyPackVersion{Name: "security", VersionTag: "1.0.0"}, nil)
+	assert.EqualError(t, err, `version 1.0.0 of policy pack "security" has already been published`)
+	publishTestPolicyPack(t, b, "security", "")
+
+	packs, err = b.ListPolicyPacks(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []apitype.PolicyPackWithVersions{{
+		Name:        "security",
+		Versions:    []int{3},
+		VersionTags: []string{"3"},
+	}}, packs.PolicyPacks)
+}
+
+func TestPolicyGroups(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+
+	home, err := ioutil.TempDir("", "home")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	os.Setenv("PULUMI_HOME", home)
+	defer os.Unsetenv("PULUMI_HOME")
+
+	publishTestPolicyPack(t, b, "security", "1.0.0")
+	publishTestPolicyPack(t, b, "cost", "0.1.0")
+
+	security, err := b.GetPolicyPack(ctx, "security", nil)
+	require.NoError(t, err)
+	cost, err := b.GetPolicyPack(ctx, "cost", nil)
+	require.NoError(t, err)
+	config := json.RawMessage(`{"algorithm": "AES256"}`)
+	op := backend.PolicyPackOperation{Config: map[string]*json.RawMessage{"s3-encryption": &config}}
+	require.NoError(t, security.Enable(ctx, "", op))
+	require.NoError(t, cost.Enable(ctx, "production", op))
+
+	err = b.AddStackToPolicyGroup(ctx, "", "dev")
+	assert.Error(t, err)
+	require.NoError(t, b.AddStackToPolicyGroup(ctx, "production", "prod-*"))
+
+	groups, err := b.ListPolicyGroups(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []apitype.PolicyGroupSummary{
+		{Name: apitype.DefaultPolicyGroup, IsOrgDefault: true, NumEnabledPolicyPacks: 1},
+		{Name: "production", NumEnabledPolicyPacks: 1},
+	}, groups.PolicyGroups)
+
+	// Stacks that match another group do not belong to the default group.
+	required, err := b.GetStackPolicyPacks(ctx, localBackendReference{name: "prod-east"})
+	require.NoError(t, err)
+	if assert.Len(t, required, 1) {
+		assert.Equal(t, "cost", required[0].Name())
+
+		path, err := required[0].Install(ctx)
+		require.NoError(t, err)
+		assert.FileExists(t, filepath.Join(path, "PulumiPolicy.yaml"))
+	}
+
+	required, err = b.GetStackPolicyPacks(ctx, localBackendReference{name: "dev"})
+	require.NoError(t, err)
+	if assert.Len(t, required, 1) {
+		assert.Equal(t, "security", required[0].Name())
+		if assert.Contains(t, required[0].Config(), "s3-encryption") {
+			assert.JSONEq(t, string(config), string(*required[0].Config()["s3-encryption"]))
+		}
+	}
+
+	require.NoError(t, b.RemoveStackFromPolicyGroup(ctx, "production", "prod-*"))
+	required, err = b.GetStackPolicyPacks(ctx, localBackendReference{name: "prod-east"})
+	require.NoError(t, err)
+	if assert.Len(t, required, 1) {
+		assert.Equal(t, "security", required[0].Name())
+	}
+}
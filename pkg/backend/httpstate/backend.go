@@ -381,6 +381,26 @@ func (b *cloudBackend) StackConsoleURL(stackRef backend.StackReference) (string,
 	return url, nil
 }
 
+func (b *cloudBackend) GetStackPolicyPacks(ctx context.Context,
+	stackRef backend.StackReference) ([]engine.RequiredPolicy, error) {
+
+	stackID, err := b.getCloudStackIdentifier(stackRef)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := b.client.GetStackPolicyPacks(ctx, stackID)
+	if err != nil {
+		return nil, err
+	}
+
+	required := make([]engine.RequiredPolicy, len(policies))
+	for i, policy := range policies {
+		required[i] = newCloudRequiredPolicy(b.client, policy, stackID.Owner)
+	}
+	return required, nil
+}
+
 func (b *cloudBackend) Name() string {
 	if b.url == PulumiCloudURL {
 		return "pulumi.com"
@@ -95,6 +95,10 @@ func (be *MockBackend) ListPolicyPacks(context.Context, string) (apitype.ListPol
 	panic("not implemented")
 }
 
+func (be *MockBackend) GetStackPolicyPacks(context.Context, StackReference) ([]engine.RequiredPolicy, error) {
+	panic("not implemented")
+}
+
 func (be *MockBackend) GetPolicyPack(
 	ctx context.Context, policyPack string, d diag.Sink) (PolicyPack, error) {
 
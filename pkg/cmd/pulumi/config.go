@@ -685,6 +685,17 @@ func loadProjectStack(stack backend.Stack) (*workspace.ProjectStack, error) {
 	return workspace.LoadProjectStack(stackConfigFile)
 }
 
+// getPolicyExemptions returns the policy exemptions that apply to the given stack: those declared by the project
+// followed by those declared in the stack's configuration file.
+func getPolicyExemptions(proj *workspace.Project, stack backend.Stack) ([]workspace.PolicyExemption, error) {
+	ps, err := loadProjectStack(stack)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading stack configuration")
+	}
+	exemptions := append([]workspace.PolicyExemption{}, proj.PolicyExemptions...)
+	return append(exemptions, ps.PolicyExemptions...), nil
+}
+
 func saveProjectStack(stack backend.Stack, ps *workspace.ProjectStack) error {
 	if stackConfigFile == "" {
 		return workspace.SaveProjectStack(stack.Ref().Name(), ps)
@@ -0,0 +1,202 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/dotnet"
+	gogen "github.com/pulumi/pulumi/pkg/v3/codegen/go"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/nodejs"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/python"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+type projectGeneratorFunc func(project workspace.Project, program *hcl2.Program) (map[string][]byte, hcl.Diagnostics, error)
+
+// projectGenerators maps each supported target language to its project generator.
+var projectGenerators = map[string]projectGeneratorFunc{
+	"csharp":     dotnet.GenerateProject,
+	"dotnet":     dotnet.GenerateProject,
+	"go":         gogen.GenerateProject,
+	"nodejs":     nodejs.GenerateProject,
+	"python":     python.GenerateProject,
+	"typescript": nodejs.GenerateProject,
+}
+
+func newConvertCmd() *cobra.Command {
+	var from string
+	var language string
+	var out string
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "convert",
+		Args:  cmdutil.NoArgs,
+		Short: "Convert a Pulumi program to another language",
+		Long: "Convert a Pulumi program to another language.\n" +
+			"\n" +
+			"The program in the current directory is bound using the schemas of the resource plugins\n" +
+			"it references and a complete project is generated for the target language into the output\n" +
+			"directory: the program itself, a Pulumi.yaml, and the language's project files (e.g. go.mod\n" +
+			"or package.json). Any diagnostics are reported along with the source ranges they refer to.\n" +
+			"\n" +
+			"Currently, only programs written in PCL (.pp files) can be converted.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			if from != "pcl" {
+				return errors.Errorf("unsupported source language %q; only pcl is supported", from)
+			}
+			generator, ok := projectGenerators[language]
+			if !ok {
+				return errors.Errorf("unsupported language %q; expected one of csharp, go, python, or typescript", language)
+			}
+
+			cwd, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+			outDir, err := filepath.Abs(out)
+			if err != nil {
+				return err
+			}
+			if name == "" {
+				name = workspace.ValueOrSanitizedDefaultProjectName("", "", filepath.Base(outDir))
+			}
+			if err := workspace.ValidateProjectName(name); err != nil {
+				return err
+			}
+
+			sink := cmdutil.Diag()
+			ctx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
+			if err != nil {
+				return err
+			}
+			defer contract.IgnoreClose(ctx)
+
+			project := workspace.Project{Name: tokens.PackageName(name)}
+			files, err := convertPCLProgram(cwd, project, generator, schema.NewPluginLoader(ctx.Host), os.Stderr)
+			if err != nil {
+				return err
+			}
+			if err := writeGeneratedFiles(outDir, files); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Converted program to %s in %s\n", language, outDir)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVar(&from, "from", "pcl",
+		"The language of the program to convert; currently only pcl is supported")
+	cmd.PersistentFlags().StringVarP(&language, "language", "l", "",
+		"The language to convert the program to: one of csharp, go, python, or typescript")
+	cmd.PersistentFlags().StringVarP(&out, "out", "o", ".",
+		"The directory to write the generated project to")
+	cmd.PersistentFlags().StringVarP(&name, "name", "n", "",
+		"The name of the generated project; defaults to the name of the output directory")
+	contract.AssertNoError(cmd.MarkPersistentFlagRequired("language"))
+
+	return cmd
+}
+
+// convertPCLProgram parses and binds the PCL program in dir and generates a project from it using the given generator.
+// Diagnostics from each phase are written to diagOut; an error is returned if any of them are errors.
+func convertPCLProgram(dir string, project workspace.Project, generator projectGeneratorFunc, loader schema.Loader,
+	diagOut io.Writer) (map[string][]byte, error) {
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, e := range entries {
+		if !e.IsDir() && filepath.Ext(e.Name()) == ".pp" {
+			paths = append(paths, filepath.Join(dir, e.Name()))
+		}
+	}
+	if len(paths) == 0 {
+		return nil, errors.Errorf("no PCL files (*.pp) found in %s", dir)
+	}
+	sort.Strings(paths)
+
+	parser := syntax.NewParser()
+	for _, path := range paths {
+		if err := parsePCLFile(parser, path); err != nil {
+			return nil, err
+		}
+	}
+	diagWriter := parser.NewDiagnosticWriter(diagOut, 0, cmdutil.GetGlobalColorization() != colors.Never)
+	if err := writeConvertDiagnostics(diagWriter, parser.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	program, diags, err := hcl2.BindProgram(parser.Files, hcl2.Loader(loader))
+	if err != nil {
+		return nil, errors.Wrap(err, "binding program")
+	}
+	if err := writeConvertDiagnostics(diagWriter, diags); err != nil {
+		return nil, err
+	}
+
+	files, diags, err := generator(project, program)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating project")
+	}
+	if err := writeConvertDiagnostics(diagWriter, diags); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func parsePCLFile(parser *syntax.Parser, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer contract.IgnoreClose(f)
+
+	return parser.ParseFile(f, filepath.Base(path))
+}
+
+// writeConvertDiagnostics writes the given diagnostics and returns an error if any of them are errors.
+func writeConvertDiagnostics(w hcl.DiagnosticWriter, diags hcl.Diagnostics) error {
+	if len(diags) == 0 {
+		return nil
+	}
+	if err := w.WriteDiagnostics(diags); err != nil {
+		return err
+	}
+	if diags.HasErrors() {
+		return errors.New("failed to convert program")
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+type noPackagesLoader struct{}
+
+func (noPackagesLoader) LoadPackage(pkg string, version *semver.Version) (*schema.Package, error) {
+	return nil, errors.Errorf("unknown package %s", pkg)
+}
+
+func writeTestProgram(t *testing.T, source string) string {
+	dir, err := ioutil.TempDir("", "pulumi-convert-test")
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "main.pp"), []byte(source), 0600))
+	return dir
+}
+
+func TestConvertPCLProgram(t *testing.T) {
+	dir := writeTestProgram(t, `config greeting string {
+	default = "hello"
+}
+
+output message {
+	value = "${greeting}, world"
+}
+`)
+	defer os.RemoveAll(dir)
+
+	project := workspace.Project{Name: "greeter"}
+	expectedFiles := map[string][]string{
+		"csharp":     {"Pulumi.yaml", "MyStack.cs", "Program.cs", "greeter.csproj"},
+		"go":         {"Pulumi.yaml", "main.go", "go.mod"},
+		"python":     {"Pulumi.yaml", "__main__.py", "requirements.txt"},
+		"typescript": {"Pulumi.yaml", "index.ts", "package.json", "tsconfig.json"},
+	}
+	for language, expected := range expectedFiles {
+		t.Run(language, func(t *testing.T) {
+			var diags bytes.Buffer
+			files, err := convertPCLProgram(dir, project, projectGenerators[language], noPackagesLoader{}, &diags)
+			assert.NoError(t, err)
+			assert.Empty(t, diags.String())
+
+			assert.Len(t, files, len(expected))
+			for _, name := range expected {
+				assert.Contains(t, files, name)
+			}
+			assert.Contains(t, string(files["Pulumi.yaml"]), "name: greeter")
+		})
+	}
+}
+
+func TestConvertPCLProgramDiagnostics(t *testing.T) {
+	dir := writeTestProgram(t, `output message {
+	value = undefinedVariable
+}
+`)
+	defer os.RemoveAll(dir)
+
+	var diags bytes.Buffer
+	_, err := convertPCLProgram(dir, workspace.Project{Name: "broken"}, projectGenerators["go"],
+		noPackagesLoader{}, &diags)
+	assert.Error(t, err)
+	assert.Contains(t, diags.String(), "undefined variable undefinedVariable")
+	assert.Contains(t, diags.String(), "on main.pp line")
+}
+
+func TestConvertPCLProgramNoFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pulumi-convert-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = convertPCLProgram(dir, workspace.Project{Name: "empty"}, projectGenerators["go"],
+		noPackagesLoader{}, &bytes.Buffer{})
+	assert.Error(t, err)
+}
@@ -96,12 +96,13 @@ func makeImportFile(typ, name, id, parentSpec, providerSpec, version string) (im
 }
 
 type importSpec struct {
-	Type     tokens.Type  `json:"type"`
-	Name     tokens.QName `json:"name"`
-	ID       resource.ID  `json:"id"`
-	Parent   string       `json:"parent"`
-	Provider string       `json:"provider"`
-	Version  string       `json:"version"`
+	Type         tokens.Type  `json:"type"`
+	Name         tokens.QName `json:"name"`
+	ID           resource.ID  `json:"id"`
+	Parent       string       `json:"parent"`
+	Provider     string       `json:"provider"`
+	Version      string       `json:"version"`
+	Dependencies []string     `json:"dependencies,omitempty"`
 }
 
 type importFile struct {
@@ -123,7 +124,49 @@ func readImportFile(p string) (importFile, error) {
 	return result, nil
 }
 
-func parseImportFile(f importFile, protectResources bool) ([]deploy.Import, importer.NameTable, error) {
+func parseImportFile(f importFile, stackName tokens.QName, projectName tokens.PackageName,
+	protectResources bool) ([]deploy.Import, importer.NameTable, error) {
+
+	// Parents and dependencies may refer to entries in the name table or to other resources in the import file. Compute
+	// the URN of each resource in the file so that these references can be resolved.
+	specs := map[string]int{}
+	for i, spec := range f.Resources {
+		specs[string(spec.Name)] = i
+	}
+	urns := make([]resource.URN, len(f.Resources))
+	resolving := make([]bool, len(f.Resources))
+	var resolve func(name string) (resource.URN, bool, error)
+	resolve = func(name string) (resource.URN, bool, error) {
+		if urn, ok := f.NameTable[name]; ok {
+			return urn, true, nil
+		}
+		i, ok := specs[name]
+		if !ok {
+			return "", false, nil
+		}
+		if urns[i] != "" {
+			return urns[i], true, nil
+		}
+		if resolving[i] {
+			return "", false, fmt.Errorf("the resource '%v' is its own ancestor", name)
+		}
+		resolving[i] = true
+
+		spec := f.Resources[i]
+		var parentType tokens.Type
+		if spec.Parent != "" {
+			parent, ok, err := resolve(spec.Parent)
+			if err != nil {
+				return "", false, err
+			}
+			if ok {
+				parentType = parent.QualifiedType()
+			}
+		}
+		urns[i] = resource.NewURN(stackName, projectName, parentType, spec.Type, spec.Name)
+		return urns[i], true, nil
+	}
+
 	// Build the name table.
 	names := importer.NameTable{}
 	for name, urn := range f.NameTable {
@@ -139,8 +182,19 @@ func parseImportFile(f importFile, protectResources bool) ([]deploy.Import, impo
 			Protect: protectResources,
 		}
 
+		urn, _, err := resolve(string(spec.Name))
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, has := names[urn]; !has {
+			names[urn] = string(spec.Name)
+		}
+
 		if spec.Parent != "" {
-			urn, ok := f.NameTable[spec.Parent]
+			urn, ok, err := resolve(spec.Parent)
+			if err != nil {
+				return nil, nil, err
+			}
 			if !ok {
 				return nil, nil, fmt.Errorf("the parent '%v' for resource '%v' of type '%v' has no name",
 					spec.Parent, spec.Name, spec.Type)
@@ -166,6 +220,18 @@ func parseImportFile(f importFile, protectResources bool) ([]deploy.Import, impo
 			imp.Version = &v
 		}
 
+		for _, dep := range spec.Dependencies {
+			urn, ok, err := resolve(dep)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !ok {
+				return nil, nil, fmt.Errorf("the dependency '%v' of resource '%v' of type '%v' has no name",
+					dep, spec.Name, spec.Type)
+			}
+			imp.Dependencies = append(imp.Dependencies, urn)
+		}
+
 		imports[i] = imp
 	}
 
@@ -257,6 +323,10 @@ func newImportCmd() *cobra.Command {
 	var providerSpec string
 	var importFilePath string
 	var outputFilePath string
+	var discoverPackage string
+	var discoverTypes []string
+	var discoverTags []string
+	var discoveredFilePath string
 
 	var debug bool
 	var message string
@@ -328,10 +398,25 @@ func newImportCmd() *cobra.Command {
 			"these names must correspond to entries in the name table. If a resource does not\n" +
 			"specify a provider, it will be imported using the default provider for its type. A\n" +
 			"resource that does specify a provider may specify the version of the provider\n" +
-			"that will be used for its import.\n",
+			"that will be used for its import.\n" +
+			"\n" +
+			"Rather than specifying the resources to import, the resources managed by a provider\n" +
+			"may be discovered using the `--discover` flag, provided that the provider supports\n" +
+			"listing its resources. The provider is configured using the stack's configuration,\n" +
+			"and the discovered resources may be filtered by type using `--type` and by tag using\n" +
+			"`--tag key=value`. A resource that is contained by another discovered resource is\n" +
+			"parented to that resource, and a resource whose properties refer to the ID of\n" +
+			"another discovered resource depends upon that resource. The generated import file\n" +
+			"may be saved using `--discover-out` for later review or reuse.\n",
 		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
 			var importFile importFile
-			if importFilePath != "" {
+			if discoverPackage != "" {
+				if len(args) != 0 || parentSpec != "" || providerSpec != "" || importFilePath != "" {
+					return result.Errorf("resources may not be specified in conjunction with --discover")
+				}
+			} else if len(discoverTypes) != 0 || len(discoverTags) != 0 || discoveredFilePath != "" {
+				return result.Errorf("--type, --tag and --discover-out may only be used with --discover")
+			} else if importFilePath != "" {
 				if len(args) != 0 || parentSpec != "" || providerSpec != "" {
 					return result.Errorf("an inline resource may not be specified in conjunction with an import file")
 				}
@@ -362,7 +447,7 @@ func newImportCmd() *cobra.Command {
 				output = f
 			}
 
-			imports, nameTable, err := parseImportFile(importFile, protectResources)
+			discoverFilterTags, err := parseTagFilters(discoverTags)
 			if err != nil {
 				return result.FromError(err)
 			}
@@ -453,6 +538,28 @@ func newImportCmd() *cobra.Command {
 				return result.FromError(errors.Wrap(err, "getting stack configuration"))
 			}
 
+			if discoverPackage != "" {
+				f, err := discoverImportFile(discoverPackage, discoverTypes, discoverFilterTags, s.Ref().Name(),
+					proj.Name, cfg)
+				if err != nil {
+					return result.FromError(errors.Wrap(err, "discovering resources"))
+				}
+				if len(f.Resources) == 0 {
+					return result.Errorf("no resources to import were discovered")
+				}
+				if discoveredFilePath != "" {
+					if err := writeImportFile(discoveredFilePath, f); err != nil {
+						return result.FromError(errors.Wrap(err, "could not write import file"))
+					}
+				}
+				importFile = f
+			}
+
+			imports, nameTable, err := parseImportFile(importFile, s.Ref().Name(), proj.Name, protectResources)
+			if err != nil {
+				return result.FromError(err)
+			}
+
 			opts.Engine = engine.UpdateOptions{
 				Parallel:      parallel,
 				Debug:         debug,
@@ -533,6 +640,15 @@ func newImportCmd() *cobra.Command {
 		&importFilePath, "file", "f", "", "The path to a JSON-encoded file containing a list of resources to import")
 	cmd.PersistentFlags().StringVarP(
 		&outputFilePath, "out", "o", "", "The path to the file that will contain the generated resource declarations")
+	cmd.PersistentFlags().StringVar(
+		&discoverPackage, "discover", "",
+		"Discover the resources to import using the provider for the given package, optionally followed by @VERSION")
+	cmd.PersistentFlags().StringSliceVar(
+		&discoverTypes, "type", nil, "Only import discovered resources of the given types")
+	cmd.PersistentFlags().StringArrayVar(
+		&discoverTags, "tag", nil, "Only import discovered resources with the given tag, in the format key=value")
+	cmd.PersistentFlags().StringVar(
+		&discoveredFilePath, "discover-out", "", "The path to which to write the import file for the discovered resources")
 
 	cmd.PersistentFlags().BoolVarP(
 		&debug, "debug", "d", false,
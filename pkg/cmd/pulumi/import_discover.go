@@ -0,0 +1,257 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// parseTagFilters parses a list of tag filters of the form key=value.
+func parseTagFilters(specs []string) (map[string]string, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	tags := map[string]string{}
+	for _, spec := range specs {
+		equals := strings.Index(spec, "=")
+		if equals <= 0 {
+			return nil, errors.Errorf("tag filter '%v' must be of the form key=value", spec)
+		}
+		tags[spec[:equals]] = spec[equals+1:]
+	}
+	return tags, nil
+}
+
+// discoverImportFile lists the existing resources managed by the given package's provider that match the given types
+// and tags and returns an import file that describes them. The provider is configured using the stack's configuration
+// for the package.
+func discoverImportFile(packageSpec string, types []string, tags map[string]string, stackName tokens.QName,
+	projectName tokens.PackageName, cfg backend.StackConfiguration) (importFile, error) {
+
+	name, version, err := parsePluginSpec(packageSpec)
+	if err != nil {
+		return importFile{}, err
+	}
+	pkg := tokens.Package(name)
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return importFile{}, err
+	}
+	sink := cmdutil.Diag()
+	ctx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
+	if err != nil {
+		return importFile{}, err
+	}
+	defer contract.IgnoreClose(ctx)
+
+	prov, err := ctx.Host.Provider(pkg, version)
+	if err != nil {
+		return importFile{}, errors.Wrapf(err, "loading provider for package %v", pkg)
+	}
+
+	// Configure the provider using the ambient configuration for its package, as for a default provider.
+	target := &deploy.Target{Config: cfg.Config, Decrypter: cfg.Decrypter}
+	inputs, err := target.GetPackageConfig(pkg)
+	if err != nil {
+		return importFile{}, errors.Wrap(err, "fetching provider config")
+	}
+	urn := resource.NewURN(stackName, projectName, "", providers.MakeProviderType(pkg), "default")
+	inputs, failures, err := prov.CheckConfig(urn, nil, inputs, false)
+	if err != nil {
+		return importFile{}, errors.Wrap(err, "validating provider config")
+	}
+	if len(failures) != 0 {
+		var reasons []string
+		for _, f := range failures {
+			reasons = append(reasons, f.Reason)
+		}
+		return importFile{}, errors.Errorf("invalid provider config: %v", strings.Join(reasons, "; "))
+	}
+	if err = prov.Configure(inputs); err != nil {
+		return importFile{}, errors.Wrap(err, "configuring provider")
+	}
+
+	return listImportFile(prov, types, tags)
+}
+
+// listImportFile lists the existing resources managed by the given provider that match the given types and tags and
+// returns an import file that describes them.
+//
+// Each resource is given a unique name that is a valid identifier in each of the supported languages. A resource that
+// is contained by another listed resource is parented to that resource, and a resource whose properties refer to the
+// ID of another listed resource depends upon that resource.
+func listImportFile(prov plugin.Provider, types []string, tags map[string]string) (importFile, error) {
+	typeTokens := make([]tokens.Type, len(types))
+	for i, t := range types {
+		typeTokens[i] = tokens.Type(t)
+	}
+
+	listed, err := prov.ListResources(typeTokens, tags)
+	if err != nil {
+		if err == plugin.ErrListResourcesNotSupported {
+			return importFile{}, errors.Errorf("the %v provider does not support resource discovery", prov.Pkg())
+		}
+		return importFile{}, errors.Wrap(err, "listing resources")
+	}
+	sort.Slice(listed, func(i, j int) bool {
+		if listed[i].Type != listed[j].Type {
+			return listed[i].Type < listed[j].Type
+		}
+		return listed[i].ID < listed[j].ID
+	})
+
+	// Assign each resource a unique name and index the resources by ID. IDs that are shared by several resources are
+	// ambiguous, and are not used to assign parents or dependencies.
+	names := make([]string, len(listed))
+	usedNames := map[string]bool{}
+	byID := map[resource.ID]int{}
+	for i, r := range listed {
+		names[i] = uniqueName(importName(r), usedNames)
+
+		if _, has := byID[r.ID]; has {
+			byID[r.ID] = -1
+		} else {
+			byID[r.ID] = i
+		}
+	}
+	lookup := func(id resource.ID) (int, bool) {
+		i, ok := byID[id]
+		return i, ok && i != -1
+	}
+
+	specs := make([]importSpec, len(listed))
+	for i, r := range listed {
+		spec := importSpec{
+			Type: r.Type,
+			Name: tokens.QName(names[i]),
+			ID:   r.ID,
+		}
+
+		if parent, ok := lookup(r.Parent); ok && parent != i {
+			spec.Parent = names[parent]
+		}
+
+		deps := map[string]bool{}
+		walkStrings(resource.NewObjectProperty(r.Properties), func(s string) {
+			if dep, ok := lookup(resource.ID(s)); ok && dep != i && names[dep] != spec.Parent {
+				deps[names[dep]] = true
+			}
+		})
+		for dep := range deps {
+			spec.Dependencies = append(spec.Dependencies, dep)
+		}
+		sort.Strings(spec.Dependencies)
+
+		specs[i] = spec
+	}
+
+	return importFile{
+		NameTable: map[string]resource.URN{},
+		Resources: specs,
+	}, nil
+}
+
+// importName returns a suggested name for a listed resource. The name is derived from the resource's suggested name
+// or its ID, and is converted to a camel-cased identifier.
+func importName(r plugin.ListedResource) string {
+	base := r.Name
+	if base == "" {
+		base = string(r.ID)
+		if i := strings.LastIndexAny(base, "/:"); i != -1 && i < len(base)-1 {
+			base = base[i+1:]
+		}
+	}
+
+	var b strings.Builder
+	upper := false
+	for _, c := range base {
+		switch {
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			upper = b.Len() > 0
+		case upper:
+			b.WriteRune(unicode.ToUpper(c))
+			upper = false
+		case b.Len() == 0:
+			b.WriteRune(unicode.ToLower(c))
+		default:
+			b.WriteRune(c)
+		}
+	}
+
+	name := b.String()
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		typeName := string(r.Type.Name())
+		if typeName == "" {
+			typeName = "resource"
+		}
+		name = strings.ToLower(typeName[:1]) + typeName[1:] + name
+	}
+	return name
+}
+
+// uniqueName returns name if it has not been used, or name suffixed with the smallest integer that makes it unique.
+// The returned name is marked as used.
+func uniqueName(name string, used map[string]bool) string {
+	unique := name
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	used[unique] = true
+	return unique
+}
+
+// walkStrings calls visit for each non-secret string in the given property value.
+func walkStrings(v resource.PropertyValue, visit func(s string)) {
+	switch {
+	case v.IsString():
+		visit(v.StringValue())
+	case v.IsArray():
+		for _, e := range v.ArrayValue() {
+			walkStrings(e, visit)
+		}
+	case v.IsObject():
+		for _, e := range v.ObjectValue() {
+			walkStrings(e, visit)
+		}
+	}
+}
+
+// writeImportFile writes the given import file to the given path as JSON.
+func writeImportFile(path string, f importFile) error {
+	contents, err := json.MarshalIndent(f, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(contents, '\n'), 0600)
+}
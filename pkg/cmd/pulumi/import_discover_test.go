@@ -0,0 +1,119 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func TestListImportFile(t *testing.T) {
+	var requestedTypes []tokens.Type
+	var requestedTags map[string]string
+	prov := &deploytest.Provider{
+		Package: "cloud",
+		ListResourcesF: func(types []tokens.Type, tags map[string]string) ([]plugin.ListedResource, error) {
+			requestedTypes, requestedTags = types, tags
+			return []plugin.ListedResource{
+				{
+					Type:   "cloud:index:Subnet",
+					ID:     "subnet-1",
+					Name:   "app subnet",
+					Parent: "vpc-1",
+					Properties: resource.PropertyMap{
+						"vpcId":    resource.NewStringProperty("vpc-1"),
+						"routeIds": resource.NewArrayProperty([]resource.PropertyValue{resource.NewStringProperty("rt-1")}),
+					},
+				},
+				{
+					Type: "cloud:index:Instance",
+					ID:   "i-0123",
+					Properties: resource.PropertyMap{
+						"subnetId": resource.NewStringProperty("subnet-1"),
+						"password": resource.MakeSecret(resource.NewStringProperty("vpc-1")),
+					},
+				},
+				{Type: "cloud:index:Vpc", ID: "vpc-1", Name: "main"},
+				{Type: "cloud:index:RouteTable", ID: "arn:cloud:rt/rt-1"},
+				{Type: "cloud:index:RouteTable", ID: "rt-1", Name: "main"},
+			}, nil
+		},
+	}
+
+	f, err := listImportFile(prov, []string{"cloud:index:Vpc"}, map[string]string{"env": "prod"})
+	require.NoError(t, err)
+	assert.Equal(t, []tokens.Type{"cloud:index:Vpc"}, requestedTypes)
+	assert.Equal(t, map[string]string{"env": "prod"}, requestedTags)
+
+	assert.Equal(t, []importSpec{
+		{Type: "cloud:index:Instance", Name: "i0123", ID: "i-0123", Dependencies: []string{"appSubnet"}},
+		{Type: "cloud:index:RouteTable", Name: "rt1", ID: "arn:cloud:rt/rt-1"},
+		{Type: "cloud:index:RouteTable", Name: "main", ID: "rt-1"},
+		{
+			Type:         "cloud:index:Subnet",
+			Name:         "appSubnet",
+			ID:           "subnet-1",
+			Parent:       "main2",
+			Dependencies: []string{"main"},
+		},
+		{Type: "cloud:index:Vpc", Name: "main2", ID: "vpc-1"},
+	}, f.Resources)
+
+	// The import file should resolve parents and dependencies to other resources in the file.
+	imports, names, err := parseImportFile(f, "dev", "proj", true)
+	require.NoError(t, err)
+	vpcURN := resource.NewURN("dev", "proj", "", "cloud:index:Vpc", "main2")
+	subnetURN := resource.NewURN("dev", "proj", "cloud:index:Vpc", "cloud:index:Subnet", "appSubnet")
+	assert.Equal(t, vpcURN, imports[3].Parent)
+	assert.Equal(t, []resource.URN{subnetURN}, imports[0].Dependencies)
+	assert.Equal(t, "appSubnet", names[subnetURN])
+
+	// Providers that cannot list resources should produce a helpful error.
+	_, err = listImportFile(&deploytest.Provider{Package: "cloud"}, nil, nil)
+	assert.EqualError(t, err, "the cloud provider does not support resource discovery")
+}
+
+func TestParseImportFileCycles(t *testing.T) {
+	_, _, err := parseImportFile(importFile{
+		Resources: []importSpec{
+			{Type: "cloud:index:Vpc", Name: "a", ID: "a", Parent: "b"},
+			{Type: "cloud:index:Vpc", Name: "b", ID: "b", Parent: "a"},
+		},
+	}, "dev", "proj", false)
+	assert.Error(t, err)
+
+	_, _, err = parseImportFile(importFile{
+		Resources: []importSpec{
+			{Type: "cloud:index:Vpc", Name: "a", ID: "a", Dependencies: []string{"missing"}},
+		},
+	}, "dev", "proj", false)
+	assert.EqualError(t, err, "the dependency 'missing' of resource 'a' of type 'cloud:index:Vpc' has no name")
+}
+
+func TestParseTagFilters(t *testing.T) {
+	tags, err := parseTagFilters([]string{"env=prod", "team=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "prod", "team": "a=b"}, tags)
+
+	_, err = parseTagFilters([]string{"=prod"})
+	assert.Error(t, err)
+}
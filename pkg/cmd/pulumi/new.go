@@ -61,12 +61,15 @@ type newArgs struct {
 	force             bool
 	generateOnly      bool
 	interactive       bool
+	list              bool
 	name              string
 	offline           bool
 	prompt            promptForValueFunc
+	runPostCreate     bool
 	secretsProvider   string
 	stack             string
 	templateNameOrURL string
+	templateValues    []string
 	yes               bool
 }
 
@@ -155,9 +158,10 @@ func runNew(args newArgs) error {
 		}
 	}
 
-	// Do a dry run, if we're not forcing files to be overwritten.
+	// Do a dry run, if we're not forcing files to be overwritten, of the files that don't depend on the template's
+	// values, so that we fail before prompting for anything.
 	if !args.force {
-		if err = workspace.CopyTemplateFilesDryRun(template.Dir, cwd, args.name); err != nil {
+		if err = template.CopyStaticFilesDryRun(cwd, args.name); err != nil {
 			if os.IsNotExist(err) {
 				return errors.Wrapf(err, "template '%s' not found", args.templateNameOrURL)
 			}
@@ -186,7 +190,8 @@ func runNew(args newArgs) error {
 	}
 
 	// Show instructions, if we're going to show at least one prompt.
-	hasAtLeastOnePrompt := (args.name == "") || (args.description == "") || (!args.generateOnly && args.stack == "")
+	hasAtLeastOnePrompt := (args.name == "") || (args.description == "") || (!args.generateOnly && args.stack == "") ||
+		len(template.Prompts) > 0
 	if !args.yes && hasAtLeastOnePrompt {
 		fmt.Println("This command will walk you through creating a new Pulumi project.")
 		fmt.Println()
@@ -225,8 +230,25 @@ func runNew(args newArgs) error {
 		}
 	}
 
+	// Prompt for the template's values, if it has any.
+	values, err := promptForTemplateValues(args.prompt, template, args.name, args.description, args.templateValues,
+		args.yes, opts)
+	if err != nil {
+		return err
+	}
+
+	// Do a dry run of all of the files, now that their names are known.
+	if !args.force {
+		if err = template.CopyFilesDryRun(cwd, values); err != nil {
+			if os.IsNotExist(err) {
+				return errors.Wrapf(err, "template '%s' not found", args.templateNameOrURL)
+			}
+			return err
+		}
+	}
+
 	// Actually copy the files.
-	if err = workspace.CopyTemplateFiles(template.Dir, cwd, args.force, args.name, args.description); err != nil {
+	if err = template.CopyFiles(cwd, args.force, values); err != nil {
 		if os.IsNotExist(err) {
 			return errors.Wrapf(err, "template '%s' not found", args.templateNameOrURL)
 		}
@@ -248,6 +270,16 @@ func runNew(args newArgs) error {
 		return errors.Wrap(err, "saving project")
 	}
 
+	// Run the template's post-create commands, if any and if they're confirmed.
+	commands, err := template.PostCreateCommands(values)
+	if err != nil {
+		return err
+	}
+	confirm := args.interactive && !args.yes
+	if err = runPostCreateCommands(root, commands, args.runPostCreate, confirm, opts); err != nil {
+		return err
+	}
+
 	// Create the stack, if needed.
 	if !args.generateOnly && s == nil {
 		if s, err = promptAndCreateStack(args.prompt,
@@ -358,12 +390,26 @@ func newNewCmd() *cobra.Command {
 			"To create the project from a branch of a specific source control location, pass the url to the branch, e.g.\n" +
 			"* `pulumi new https://gitlab.com/<user>/<repo>/tree/<branch>`\n" +
 			"* `pulumi new https://bitbucket.org/<user>/<repo>/tree/<branch>`\n" +
-			"* `pulumi new https://github.com/<user>/<repo>/tree/<branch>`\n",
+			"* `pulumi new https://github.com/<user>/<repo>/tree/<branch>`\n" +
+			"\n" +
+			"Templates may declare prompts in the `template` section of their Pulumi.yaml, whose answers are used\n" +
+			"to render files ending in `.tmpl`, to render file names, to include or exclude files, and to run\n" +
+			"the template's post-create commands. Answers may be passed non-interactively with\n" +
+			"`--template-value name=value`.\n" +
+			"\n" +
+			"Templates are retrieved from the template sources configured in ~/.pulumi/templates.json, if any,\n" +
+			"instead of from the public Pulumi templates. Each source is a Git repository, a tarball served over\n" +
+			"HTTP, or a local directory. Use `pulumi new --list` to list the available templates, qualify a\n" +
+			"template with its source as in `pulumi new <source>/<template>`, and pin a template to a version\n" +
+			"(a tag of a Git source) as in `pulumi new <template>@<version>`.\n",
 		Args: cmdutil.MaximumNArgs(1),
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, cliArgs []string) error {
 			if len(cliArgs) > 0 {
 				args.templateNameOrURL = cliArgs[0]
 			}
+			if args.list {
+				return listTemplates(args.templateNameOrURL, args.offline)
+			}
 			args.yes = args.yes || skipConfirmations()
 			return runNew(args)
 		}),
@@ -418,15 +464,24 @@ func newNewCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVarP(
 		&args.generateOnly, "generate-only", "g", false,
 		"Generate the project only; do not create a stack, save config, or install dependencies")
+	cmd.PersistentFlags().BoolVar(
+		&args.list, "list", false,
+		"List the available templates, with their sources and descriptions, and exit")
 	cmd.PersistentFlags().StringVarP(
 		&args.name, "name", "n", "",
 		"The project name; if not specified, a prompt will request it")
 	cmd.PersistentFlags().BoolVarP(
 		&args.offline, "offline", "o", false,
 		"Use locally cached templates without making any network requests")
+	cmd.PersistentFlags().BoolVar(
+		&args.runPostCreate, "run-post-create", false,
+		"Run the template's post-create commands without confirmation; --yes does not imply this")
 	cmd.PersistentFlags().StringVarP(
 		&args.stack, "stack", "s", "",
 		"The stack name; either an existing stack or stack to create; if not specified, a prompt will request it")
+	cmd.PersistentFlags().StringArrayVar(
+		&args.templateValues, "template-value", []string{},
+		"An answer to one of the template's prompts, in the form name=value; may be repeated")
 	cmd.PersistentFlags().BoolVarP(
 		&args.yes, "yes", "y", false,
 		"Skip prompts and proceed with default values")
@@ -778,6 +833,53 @@ func pythonCommands() []string {
 	return commands
 }
 
+// listTemplates prints the templates in the given template repository, or all of the available templates if
+// templateNameOrURL is empty.
+func listTemplates(templateNameOrURL string, offline bool) error {
+	repo, err := workspace.RetrieveTemplates(templateNameOrURL, offline, workspace.TemplateKindPulumiProject)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		contract.IgnoreError(repo.Delete())
+	}()
+
+	templates, err := repo.Templates()
+	if err != nil {
+		return err
+	}
+	if len(templates) == 0 {
+		fmt.Println("No templates found.")
+		return nil
+	}
+
+	showSources := false
+	for _, template := range templates {
+		showSources = showSources || template.Source != ""
+	}
+
+	rows := []cmdutil.TableRow{}
+	for _, template := range templates {
+		columns := []string{template.Name}
+		if showSources {
+			columns = append(columns, template.Source)
+		}
+		columns = append(columns, template.Description)
+		rows = append(rows, cmdutil.TableRow{Columns: columns})
+	}
+
+	headers := []string{"NAME"}
+	if showSources {
+		headers = append(headers, "SOURCE")
+	}
+	headers = append(headers, "DESCRIPTION")
+	cmdutil.PrintTable(cmdutil.Table{
+		Headers: headers,
+		Rows:    rows,
+	})
+	return nil
+}
+
 // chooseTemplate will prompt the user to choose amongst the available templates.
 func chooseTemplate(templates []workspace.Template, opts display.Options) (workspace.Template, error) {
 	const chooseTemplateErr = "no template selected; please use `pulumi new` to choose one"
@@ -969,6 +1071,113 @@ func promptForConfig(
 	return c, nil
 }
 
+// promptForTemplateValues returns the values used to render a template's files: the project's name and description,
+// and the answers to the template's prompts. Answers passed as `name=value` strings are used as given; the remaining
+// prompts are asked, or answered with their defaults if yes is true.
+func promptForTemplateValues(prompt promptForValueFunc, template workspace.Template, name, description string,
+	answers []string, yes bool, opts display.Options) (workspace.TemplateValues, error) {
+
+	given := make(map[string]string)
+	for _, answer := range answers {
+		kvp := strings.SplitN(answer, "=", 2)
+		if len(kvp) != 2 {
+			return nil, errors.Errorf("invalid template value %q; expected name=value", answer)
+		}
+		given[kvp[0]] = kvp[1]
+	}
+
+	values := workspace.NewTemplateValues(name, description)
+	for _, p := range template.Prompts {
+		answer, ok := given[p.Name]
+		if ok {
+			delete(given, p.Name)
+		} else {
+			label := p.Name
+			if p.Description != "" {
+				label = p.Description
+			}
+			switch {
+			case len(p.Choices) > 0:
+				label = fmt.Sprintf("%s [%s]", label, strings.Join(p.Choices, "/"))
+			case p.Type == "bool":
+				label = label + " [true/false]"
+			}
+			validate := func(s string) error {
+				_, err := p.ParseValue(s)
+				return err
+			}
+			defaultValue := p.Default
+			if p.Type == "bool" && defaultValue == "" {
+				defaultValue = "false"
+			}
+			value, err := prompt(yes, label, defaultValue, false, validate, opts)
+			if err != nil {
+				return nil, err
+			}
+			answer = value
+		}
+		parsed, err := p.ParseValue(answer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for template prompt %q", p.Name)
+		}
+		values[p.Name] = parsed
+	}
+	for name := range given {
+		return nil, errors.Errorf("template %s has no prompt %q", template.Name, name)
+	}
+	return values, nil
+}
+
+// runPostCreateCommands runs a template's post-create commands in the new project's directory using the system shell.
+// The commands are always printed first. Because they run arbitrary code from the template, they are only run if run is
+// true (i.e. --run-post-create was passed) or if confirm is true and the user confirms them; --yes alone never runs
+// them.
+func runPostCreateCommands(dir string, commands []string, run, confirm bool, opts display.Options) error {
+	if len(commands) == 0 {
+		return nil
+	}
+
+	fmt.Println("This template has post-create commands:")
+	for _, command := range commands {
+		fmt.Printf("    %s\n", command)
+	}
+	fmt.Println()
+
+	if !run && confirm {
+		surveycore.DisableColor = true
+		surveycore.QuestionIcon = ""
+		cmdutil.EndKeypadTransmitMode()
+		if err := survey.AskOne(&survey.Confirm{
+			Message: opts.Color.Colorize(colors.SpecPrompt + "Run these commands?" + colors.Reset),
+		}, &run, nil); err != nil {
+			return err
+		}
+		fmt.Println()
+	}
+	if !run {
+		fmt.Println("Skipping the post-create commands; run them yourself, or pass --run-post-create to run them.")
+		fmt.Println()
+		return nil
+	}
+
+	for _, command := range commands {
+		fmt.Printf("Running `%s`...\n", command)
+		var cmd *exec.Cmd
+		if runtime.GOOS == "windows" {
+			cmd = exec.Command("cmd", "/C", command)
+		} else {
+			cmd = exec.Command("sh", "-c", command)
+		}
+		cmd.Dir = dir
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+		if err := cmd.Run(); err != nil {
+			return errors.Wrapf(err, "running post-create command `%s`", command)
+		}
+	}
+	fmt.Println()
+	return nil
+}
+
 // promptForValue prompts the user for a value with a defaultValue preselected. Hitting enter accepts the
 // default. If yes is true, defaultValue is returned without prompting. isValidFn is an optional parameter;
 // when specified, it will be run to validate that value entered. When this function returns a non nil error
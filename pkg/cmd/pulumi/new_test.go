@@ -20,6 +20,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -811,6 +812,58 @@ func TestSetFail(t *testing.T) {
 	}
 }
 
+func TestPromptForTemplateValues(t *testing.T) {
+	template := workspace.Template{
+		Name: "test",
+		Prompts: []workspace.ProjectTemplatePrompt{
+			{Name: "region", Default: "us-west-2"},
+			{Name: "useVpc", Type: "bool", Default: "false"},
+			{Name: "cloud", Type: "choice", Choices: []string{"aws", "azure"}, Default: "aws"},
+			{Name: "debug", Type: "bool"},
+		},
+	}
+
+	values, err := promptForTemplateValues(promptMock("", ""), template, projectName, "A project",
+		[]string{"useVpc=true", "cloud=azure"}, true, display.Options{})
+	require.NoError(t, err)
+	assert.Equal(t, workspace.TemplateValues{
+		"Project":     projectName,
+		"Description": "A project",
+		"region":      "us-west-2",
+		"useVpc":      true,
+		"cloud":       "azure",
+		"debug":       false,
+	}, values)
+
+	_, err = promptForTemplateValues(promptMock("", ""), template, projectName, "",
+		[]string{"cloud=gcp"}, true, display.Options{})
+	assert.Error(t, err)
+
+	_, err = promptForTemplateValues(promptMock("", ""), template, projectName, "",
+		[]string{"size=large"}, true, display.Options{})
+	assert.Error(t, err)
+
+	_, err = promptForTemplateValues(promptMock("", ""), template, projectName, "",
+		[]string{"region"}, true, display.Options{})
+	assert.Error(t, err)
+}
+
+func TestRunPostCreateCommandsRequiresOptIn(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("post-create test commands use sh")
+	}
+
+	dir := t.TempDir()
+	commands := []string{"touch ran"}
+
+	// Without --run-post-create or a confirmation, e.g. with --yes, the commands are only printed.
+	require.NoError(t, runPostCreateCommands(dir, commands, false, false, display.Options{}))
+	assert.NoFileExists(t, filepath.Join(dir, "ran"))
+
+	require.NoError(t, runPostCreateCommands(dir, commands, true, false, display.Options{}))
+	assert.FileExists(t, filepath.Join(dir, "ran"))
+}
+
 const projectName = "test_project"
 const stackName = "test_stack"
 
@@ -0,0 +1,111 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+func newPackageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "package",
+		Short: "Work with Pulumi packages",
+		Long: "Work with Pulumi packages.\n" +
+			"\n" +
+			"A Pulumi package is described by a schema that lists the resources, functions and types\n" +
+			"offered by a provider. The package family of commands operates on these schemas, e.g.\n" +
+			"to generate language SDKs for a provider.",
+		Args: cmdutil.NoArgs,
+	}
+
+	cmd.AddCommand(newPackageDiffSchemaCmd())
+	cmd.AddCommand(newPackageGenDocsCmd())
+	cmd.AddCommand(newPackageGenSDKCmd())
+	cmd.AddCommand(newPackagePackSchemaCmd())
+	cmd.AddCommand(newPackageValidateSchemaCmd())
+
+	return cmd
+}
+
+// loadPackageSpec loads a package schema from the given source. The source is either the path to a schema file or
+// the name of a resource plugin, optionally followed by `@VERSION`, whose schema is fetched via GetSchema.
+func loadPackageSpec(source string) (*schema.PackageSpec, error) {
+	if _, err := os.Stat(source); err == nil {
+		return schema.ReadPackageSpecFile(source)
+	}
+
+	name, version, err := parsePluginSpec(source)
+	if err != nil {
+		return nil, err
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	sink := cmdutil.Diag()
+	ctx, err := plugin.NewContext(sink, sink, nil, nil, cwd, nil, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(ctx)
+
+	provider, err := ctx.Host.Provider(tokens.Package(name), version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading provider %s", source)
+	}
+	schemaBytes, err := provider.GetSchema(0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fetching schema from provider %s", source)
+	}
+
+	var spec schema.PackageSpec
+	if err := json.Unmarshal(schemaBytes, &spec); err != nil {
+		return nil, errors.Wrapf(err, "unmarshaling schema from provider %s", source)
+	}
+	return &spec, nil
+}
+
+// parsePluginSpec parses a plugin reference of the form `NAME[@VERSION]`.
+func parsePluginSpec(spec string) (string, *semver.Version, error) {
+	name, versionString := spec, ""
+	if at := strings.LastIndex(spec, "@"); at != -1 {
+		name, versionString = spec[:at], spec[at+1:]
+	}
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return "", nil, errors.Errorf("%s is neither a schema file nor a plugin name", spec)
+	}
+	if versionString == "" {
+		return name, nil, nil
+	}
+
+	version, err := semver.ParseTolerant(versionString)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "invalid version for plugin %s", name)
+	}
+	return name, &version, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+func newPackageDiffSchemaCmd() *cobra.Command {
+	var jsonOut bool
+
+	cmd := &cobra.Command{
+		Use:   "diff-schema <old_schema_source> <new_schema_source>",
+		Args:  cmdutil.ExactArgs(2),
+		Short: "Report the changes between two versions of a package schema",
+		Long: "Report the changes between two versions of a package schema.\n" +
+			"\n" +
+			"Each schema source is either the path to a schema file or the name of an installed\n" +
+			"resource plugin, optionally followed by `@VERSION`. Changes that may break users of\n" +
+			"SDKs generated from the old schema--e.g. removed resources, functions or properties,\n" +
+			"newly-required inputs, type changes and removed enum values--are reported as breaking.\n" +
+			"\n" +
+			"Renamed resources are detected using the new resource's aliases. Functions and types\n" +
+			"have no aliases, so renaming one is reported as a removal and an addition.\n" +
+			"\n" +
+			"The command exits with a non-zero status if any breaking changes are found.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			oldSpec, err := loadPackageSpec(args[0])
+			if err != nil {
+				return err
+			}
+			newSpec, err := loadPackageSpec(args[1])
+			if err != nil {
+				return err
+			}
+
+			changes := schema.CompareSpecs(oldSpec, newSpec)
+			if jsonOut {
+				if changes == nil {
+					changes = schema.Changes{}
+				}
+				if err = printJSON(changes); err != nil {
+					return err
+				}
+			} else {
+				printSchemaChanges(changes)
+			}
+
+			if changes.HasBreakingChanges() {
+				return errors.New("the new schema contains breaking changes")
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&jsonOut, "json", "j", false, "Emit output as JSON")
+
+	return cmd
+}
+
+func printSchemaChanges(changes schema.Changes) {
+	if len(changes) == 0 {
+		fmt.Println("No changes found.")
+		return
+	}
+
+	breaking := 0
+	for _, c := range changes {
+		kind := "non-breaking"
+		if c.Breaking {
+			kind = "breaking"
+			breaking++
+		}
+		fmt.Printf("%s: %s\n", kind, c)
+	}
+
+	fmt.Printf("\n%d change(s), %d breaking\n", len(changes), breaking)
+}
@@ -0,0 +1,79 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/docs"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+func newPackageGenDocsCmd() *cobra.Command {
+	var format string
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "gen-docs <schema_source>",
+		Args:  cmdutil.ExactArgs(1),
+		Short: "Generate a documentation site for a package",
+		Long: "Generate a documentation site for a package.\n" +
+			"\n" +
+			"The schema source is either the path to a schema file or the name of an installed\n" +
+			"resource plugin, optionally followed by `@VERSION`, whose schema will be retrieved\n" +
+			"from the provider. The generated site is a standalone set of plain Markdown or HTML\n" +
+			"pages: an index page for the package and each of its modules, and a page for each\n" +
+			"resource and function with its per-language signatures, examples, properties and\n" +
+			"supporting types. A search index of every page and type is written to\n" +
+			"search-index.json; HTML sites also include a search page.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			spec, err := loadPackageSpec(args[0])
+			if err != nil {
+				return err
+			}
+			return genDocs(spec, docs.SiteFormat(format), out)
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(&format, "format", "f", string(docs.MarkdownSite),
+		"The format of the generated pages: markdown or html")
+	cmd.PersistentFlags().StringVarP(&out, "out", "o", "docs",
+		"The directory to write the generated site to")
+
+	return cmd
+}
+
+// genDocs generates a documentation site in the given format for the package described by spec into out.
+func genDocs(spec *schema.PackageSpec, format docs.SiteFormat, out string) error {
+	pkg, err := schema.ImportSpec(*spec, nil)
+	if err != nil {
+		return errors.Wrap(err, "binding schema")
+	}
+
+	files, err := docs.GenerateSite("pulumi", pkg, format)
+	if err != nil {
+		return errors.Wrap(err, "generating docs")
+	}
+	if err := writeGeneratedFiles(out, files); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Generated %s docs in %s\n", format, out)
+	return nil
+}
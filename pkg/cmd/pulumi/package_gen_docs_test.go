@@ -0,0 +1,59 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/docs"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+func TestGenDocs(t *testing.T) {
+	spec := schema.PackageSpec{
+		Name:    "example",
+		Version: "0.0.1",
+		Resources: map[string]schema.ResourceSpec{
+			"example:index:Thing": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "A thing.",
+					Properties: map[string]schema.PropertySpec{
+						"size": {TypeSpec: schema.TypeSpec{Type: "integer"}},
+					},
+				},
+			},
+		},
+	}
+
+	out, err := ioutil.TempDir("", "gen-docs")
+	assert.NoError(t, err)
+	defer os.RemoveAll(out)
+
+	err = genDocs(&spec, docs.HTMLSite, out)
+	assert.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(out, "index.html"))
+	assert.FileExists(t, filepath.Join(out, "thing.html"))
+	assert.FileExists(t, filepath.Join(out, "search.html"))
+	assert.FileExists(t, filepath.Join(out, "search-index.json"))
+
+	err = genDocs(&spec, docs.SiteFormat("pdf"), out)
+	assert.Error(t, err)
+}
@@ -0,0 +1,153 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/dotnet"
+	gogen "github.com/pulumi/pulumi/pkg/v3/codegen/go"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/nodejs"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/python"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+// sdkGenerator describes the code generator for a single language.
+type sdkGenerator struct {
+	importer schema.Language
+	generate func(tool string, pkg *schema.Package) (map[string][]byte, error)
+}
+
+// sdkGenerators maps each supported language to its SDK generator.
+var sdkGenerators = map[string]sdkGenerator{
+	"dotnet": {
+		importer: dotnet.Importer,
+		generate: func(tool string, pkg *schema.Package) (map[string][]byte, error) {
+			return dotnet.GeneratePackage(tool, pkg, nil)
+		},
+	},
+	"go": {
+		importer: gogen.Importer,
+		generate: gogen.GeneratePackage,
+	},
+	"nodejs": {
+		importer: nodejs.Importer,
+		generate: func(tool string, pkg *schema.Package) (map[string][]byte, error) {
+			return nodejs.GeneratePackage(tool, pkg, nil)
+		},
+	},
+	"python": {
+		importer: python.Importer,
+		generate: func(tool string, pkg *schema.Package) (map[string][]byte, error) {
+			return python.GeneratePackage(tool, pkg, nil)
+		},
+	},
+}
+
+func newPackageGenSDKCmd() *cobra.Command {
+	var languages []string
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "gen-sdk <schema_source>",
+		Args:  cmdutil.ExactArgs(1),
+		Short: "Generate language SDKs for a package",
+		Long: "Generate language SDKs for a package.\n" +
+			"\n" +
+			"The schema source is either the path to a schema file or the name of an installed\n" +
+			"resource plugin, optionally followed by `@VERSION`, whose schema will be retrieved\n" +
+			"from the provider. An SDK is generated for each selected language into a subdirectory\n" +
+			"of the output directory named after the language. Per-language options are taken\n" +
+			"from the `language` section of the schema.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			spec, err := loadPackageSpec(args[0])
+			if err != nil {
+				return err
+			}
+			return genSDKs(spec, languages, out)
+		}),
+	}
+
+	cmd.PersistentFlags().StringSliceVarP(&languages, "language", "l", []string{"dotnet", "go", "nodejs", "python"},
+		"The languages to generate SDKs for: any of dotnet, go, nodejs, and python")
+	cmd.PersistentFlags().StringVarP(&out, "out", "o", "sdk",
+		"The directory to write the generated SDKs to")
+
+	return cmd
+}
+
+// genSDKs generates an SDK for each of the given languages into a subdirectory of out.
+func genSDKs(spec *schema.PackageSpec, languages []string, out string) error {
+	importers := map[string]schema.Language{}
+	for _, language := range languages {
+		generator, ok := sdkGenerators[language]
+		if !ok {
+			return errors.Errorf("unsupported language %q", language)
+		}
+		importers[language] = generator.importer
+	}
+
+	// Sort the languages so that output is deterministic.
+	sorted := make([]string, 0, len(importers))
+	for language := range importers {
+		sorted = append(sorted, language)
+	}
+	sort.Strings(sorted)
+
+	for _, language := range sorted {
+		// Each generator may mutate the package, so bind a fresh copy for each language.
+		pkg, err := schema.ImportSpec(*spec, importers)
+		if err != nil {
+			return errors.Wrap(err, "binding schema")
+		}
+
+		files, err := sdkGenerators[language].generate("pulumi", pkg)
+		if err != nil {
+			return errors.Wrapf(err, "generating %s SDK", language)
+		}
+
+		root := filepath.Join(out, language)
+		if err := writeGeneratedFiles(root, files); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Generated %s SDK in %s\n", language, root)
+	}
+
+	return nil
+}
+
+// writeGeneratedFiles writes a set of generated files, keyed by their slash-separated relative paths, into root. The
+// files are source code meant to be shared and published, so they are created with the usual permissions for source
+// files rather than being private to the current user.
+func writeGeneratedFiles(root string, files map[string][]byte) error {
+	for path, contents := range files {
+		path = filepath.Join(root, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return errors.Wrapf(err, "creating directory for %s", path)
+		}
+		if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+			return errors.Wrapf(err, "writing %s", path)
+		}
+	}
+	return nil
+}
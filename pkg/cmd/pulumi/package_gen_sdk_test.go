@@ -0,0 +1,81 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+func TestParsePluginSpec(t *testing.T) {
+	name, version, err := parsePluginSpec("aws")
+	assert.NoError(t, err)
+	assert.Equal(t, "aws", name)
+	assert.Nil(t, version)
+
+	name, version, err = parsePluginSpec("aws@4.1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "aws", name)
+	assert.Equal(t, "4.1.0", version.String())
+
+	_, _, err = parsePluginSpec("aws@latest")
+	assert.Error(t, err)
+
+	_, _, err = parsePluginSpec("./missing/schema.json")
+	assert.Error(t, err)
+}
+
+func TestGenSDKs(t *testing.T) {
+	spec := schema.PackageSpec{
+		Name:    "example",
+		Version: "0.0.1",
+		Resources: map[string]schema.ResourceSpec{
+			"example:index:Thing": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"size": {TypeSpec: schema.TypeSpec{Type: "integer"}},
+					},
+				},
+				InputProperties: map[string]schema.PropertySpec{
+					"size": {TypeSpec: schema.TypeSpec{Type: "integer"}},
+				},
+			},
+		},
+		Language: map[string]json.RawMessage{
+			"go": json.RawMessage(`{"importBasePath": "github.com/example/sdk/go/example"}`),
+		},
+	}
+
+	out, err := ioutil.TempDir("", "gen-sdk")
+	assert.NoError(t, err)
+	defer os.RemoveAll(out)
+
+	err = genSDKs(&spec, []string{"go", "nodejs"}, out)
+	assert.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(out, "go", "example", "thing.go"))
+	assert.FileExists(t, filepath.Join(out, "nodejs", "thing.ts"))
+	assert.NoDirExists(t, filepath.Join(out, "python"))
+
+	err = genSDKs(&spec, []string{"cobol"}, out)
+	assert.Error(t, err)
+}
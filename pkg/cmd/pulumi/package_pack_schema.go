@@ -0,0 +1,87 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+func newPackagePackSchemaCmd() *cobra.Command {
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "pack-schema <schema_file>",
+		Args:  cmdutil.ExactArgs(1),
+		Short: "Pack a package schema into a single JSON document",
+		Long: "Pack a package schema into a single JSON document.\n" +
+			"\n" +
+			"Package schemas may be authored in YAML as well as JSON, and may be split across\n" +
+			"multiple files that are joined by `$ref`s to relative file paths, optionally followed by\n" +
+			"a JSON pointer (e.g. `$ref: ./types.yaml#/example:index:Widget`). This command resolves\n" +
+			"all such references, validates the result, and writes the schema in its canonical\n" +
+			"single-file JSON form to stdout or to the file given by --out.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			packed, err := packSchema(args[0])
+			if err != nil {
+				return err
+			}
+			if out == "" {
+				_, err = os.Stdout.Write(packed)
+				return err
+			}
+			return ioutil.WriteFile(out, packed, 0600)
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(&out, "out", "o", "",
+		"The file to write the packed schema to; defaults to stdout")
+
+	return cmd
+}
+
+// packSchema bundles the schema in the file at the given path into a single document, validates it, and returns its
+// canonical JSON form.
+func packSchema(path string) ([]byte, error) {
+	schemaBytes, err := schema.BundleSpecDocument(path)
+	if err != nil {
+		return nil, err
+	}
+
+	diags := schema.ValidateSpecDocument(schemaBytes)
+	if len(diags) != 0 {
+		writer := hcl.NewDiagnosticTextWriter(os.Stderr, nil, 0, false)
+		if err := writer.WriteDiagnostics(diags); err != nil {
+			return nil, err
+		}
+	}
+	if diags.HasErrors() {
+		return nil, errors.Errorf("schema %s is invalid", path)
+	}
+
+	var spec schema.PackageSpec
+	if err := json.Unmarshal(schemaBytes, &spec); err != nil {
+		return nil, errors.Wrapf(err, "unmarshaling schema %s", path)
+	}
+	return schema.MarshalSpecDocument(&spec)
+}
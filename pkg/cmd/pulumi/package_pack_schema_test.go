@@ -0,0 +1,69 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackSchema(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pack-schema-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "schema.yaml"), []byte(`
+name: example
+version: 0.0.1
+resources:
+  example:index:Thing:
+    $ref: ./thing.yaml
+`), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "thing.yaml"), []byte(`
+description: A <thing>.
+properties:
+  size:
+    type: integer
+`), 0600))
+
+	packed, err := packSchema(filepath.Join(dir, "schema.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, `{
+  "name": "example",
+  "version": "0.0.1",
+  "config": {},
+  "provider": {},
+  "resources": {
+    "example:index:Thing": {
+      "description": "A <thing>.",
+      "properties": {
+        "size": {
+          "type": "integer"
+        }
+      }
+    }
+  }
+}
+`, string(packed))
+
+	// Schemas with unknown fields are rejected.
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "thing.yaml"), []byte("sizes: 3\n"), 0600))
+	_, err = packSchema(filepath.Join(dir, "schema.yaml"))
+	assert.Error(t, err)
+}
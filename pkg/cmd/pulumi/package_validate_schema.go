@@ -0,0 +1,71 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+func newPackageValidateSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-schema <schema_source>",
+		Args:  cmdutil.ExactArgs(1),
+		Short: "Validate a package schema",
+		Long: "Validate a package schema.\n" +
+			"\n" +
+			"The schema source is either the path to a schema file or the name of an installed\n" +
+			"resource plugin, optionally followed by `@VERSION`. The schema is checked against the\n" +
+			"package metaschema and for semantic errors such as references to undefined types,\n" +
+			"invalid tokens, and default values of the wrong type. Every problem found is reported\n" +
+			"along with a JSON pointer to its location in the schema.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			var diags hcl.Diagnostics
+			if _, err := os.Stat(args[0]); err == nil {
+				// Validate the document itself so that unknown fields are reported.
+				schemaBytes, err := schema.BundleSpecDocument(args[0])
+				if err != nil {
+					return err
+				}
+				diags = schema.ValidateSpecDocument(schemaBytes)
+			} else {
+				spec, err := loadPackageSpec(args[0])
+				if err != nil {
+					return err
+				}
+				diags = schema.ValidateSpec(*spec)
+			}
+
+			if len(diags) != 0 {
+				writer := hcl.NewDiagnosticTextWriter(os.Stderr, nil, 0, false)
+				if err := writer.WriteDiagnostics(diags); err != nil {
+					return err
+				}
+			}
+			if diags.HasErrors() {
+				return errors.Errorf("schema %s is invalid", args[0])
+			}
+			return nil
+		}),
+	}
+
+	return cmd
+}
@@ -44,18 +44,29 @@ func newPluginCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(newPluginInstallCmd())
+	cmd.AddCommand(newPluginLockCmd())
 	cmd.AddCommand(newPluginLsCmd())
+	cmd.AddCommand(newPluginMirrorCmd())
+	cmd.AddCommand(newPluginPruneCmd())
 	cmd.AddCommand(newPluginRmCmd())
 
 	return cmd
 }
 
-// getProjectPlugins fetches a list of plugins used by this project.
-func getProjectPlugins() ([]workspace.PluginInfo, error) {
+// getProjectPlugins fetches a list of plugins used by this project. If includeLocal is false, plugins that the project
+// overrides with local plugins are skipped.
+func getProjectPlugins(includeLocal bool) ([]workspace.PluginInfo, error) {
 	proj, root, err := readProject()
 	if err != nil {
 		return nil, err
 	}
+	return getPluginsForProject(proj, root, includeLocal)
+}
+
+// getPluginsForProject fetches a list of plugins used by the given project. If includeLocal is false, plugins that the
+// project overrides with local plugins are skipped.
+func getPluginsForProject(proj *workspace.Project, root string,
+	includeLocal bool) ([]workspace.PluginInfo, error) {
 
 	projinfo := &engine.Projinfo{Proj: proj, Root: root}
 	pwd, main, ctx, err := engine.ProjectInfoContext(projinfo, nil, nil, cmdutil.Diag(), cmdutil.Diag(), false, nil)
@@ -75,7 +86,12 @@ func getProjectPlugins() ([]workspace.PluginInfo, error) {
 		return nil, err
 	}
 	for _, plugin := range plugins {
-		if _, path, _ := workspace.GetPluginPath(plugin.Kind, plugin.Name, plugin.Version); path != "" {
+		if !includeLocal && isLocalPlugin(ctx.ProjectPlugins, plugin) {
+			continue
+		}
+		if _, path, _ := workspace.GetPluginPathWithOverrides(
+			plugin.Kind, plugin.Name, plugin.Version, ctx.ProjectPlugins); path != "" {
+			plugin.Path = path
 			err = plugin.SetFileMetadata(path)
 			if err != nil {
 				return nil, err
@@ -86,3 +102,17 @@ func getProjectPlugins() ([]workspace.PluginInfo, error) {
 	}
 	return results, nil
 }
+
+// isLocalPlugin returns true if the given plugin is overridden by one of the project's local plugins.
+func isLocalPlugin(projectPlugins []workspace.ProjectPlugin, info workspace.PluginInfo) bool {
+	_, path, err := workspace.GetPluginPathWithOverrides(info.Kind, info.Name, info.Version, projectPlugins)
+	if err != nil || path == "" {
+		return false
+	}
+	for _, p := range projectPlugins {
+		if p.Path == path {
+			return true
+		}
+	}
+	return false
+}
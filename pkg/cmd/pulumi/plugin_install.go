@@ -36,6 +36,7 @@ func newPluginInstallCmd() *cobra.Command {
 	var exact bool
 	var file string
 	var reinstall bool
+	var checksum string
 
 	var cmd = &cobra.Command{
 		Use:   "install [KIND NAME VERSION]",
@@ -49,7 +50,13 @@ func newPluginInstallCmd() *cobra.Command {
 			"project.  VERSION cannot be a range: it must be a specific number.\n" +
 			"\n" +
 			"If you let Pulumi compute the set to download, it is conservative and may end up\n" +
-			"downloading more plugins than is strictly necessary.",
+			"downloading more plugins than is strictly necessary.\n" +
+			"\n" +
+			"Downloaded plugins are verified against the SHA-256 checksums in the checksum manifest\n" +
+			"that the plugin server publishes alongside each plugin, if any. To require a manifest,\n" +
+			"set PULUMI_PLUGIN_REQUIRE_CHECKSUMS=true. To also require that the manifest is signed,\n" +
+			"set PULUMI_PLUGIN_SIGNING_KEY to the base64-encoded Ed25519 public key of the signer.\n" +
+			"The expected checksum of a specific plugin may also be passed with `--checksum`.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 			displayOpts := display.Options{
 				Color: cmdutil.GetGlobalColorization(),
@@ -74,17 +81,29 @@ func newPluginInstallCmd() *cobra.Command {
 					Name:      args[1],
 					Version:   &version,
 					ServerURL: serverURL, // If empty, will use default plugin source.
+					Checksum:  checksum,
 				})
 			} else {
 				if file != "" {
 					return errors.New("--file (-f) is only valid if a specific package is being installed")
 				}
+				if checksum != "" {
+					return errors.New("--checksum is only valid if a specific package is being installed")
+				}
 
-				// If a specific plugin wasn't given, compute the set of plugins the current project needs.
-				plugins, err := getProjectPlugins()
+				// If a specific plugin wasn't given, compute the set of plugins the current project needs, pinned to
+				// the plugins in the project's lock file, if any.
+				proj, root, err := readProject()
 				if err != nil {
 					return err
 				}
+				plugins, err := getPluginsForProject(proj, root, false /*includeLocal*/)
+				if err != nil {
+					return err
+				}
+				if plugins, err = applyProjectPluginLock(root, plugins); err != nil {
+					return err
+				}
 				for _, plugin := range plugins {
 					// Skip language plugins; by definition, we already have one installed.
 					// TODO[pulumi/pulumi#956]: eventually we will want to honor and install these in the usual way.
@@ -152,6 +171,8 @@ func newPluginInstallCmd() *cobra.Command {
 		"file", "f", "", "Install a plugin from a tarball file, instead of downloading it")
 	cmd.PersistentFlags().BoolVar(&reinstall,
 		"reinstall", false, "Reinstall a plugin even if it already exists")
+	cmd.PersistentFlags().StringVar(&checksum,
+		"checksum", "", "The expected SHA-256 checksum of the plugin's tarball")
 
 	return cmd
 }
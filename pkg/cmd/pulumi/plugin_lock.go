@@ -0,0 +1,206 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newPluginLockCmd() *cobra.Command {
+	var platforms []string
+
+	var cmd = &cobra.Command{
+		Use:   "lock",
+		Args:  cmdutil.NoArgs,
+		Short: "Record the exact plugins the current project uses in Pulumi.lock",
+		Long: "Record the exact plugins the current project uses in Pulumi.lock.\n" +
+			"\n" +
+			"This command writes a Pulumi.lock file next to the project's Pulumi.yaml that records the kind,\n" +
+			"name, exact version, download server, and SHA-256 checksums of every plugin the program\n" +
+			"requires. Plugins that the program requires without a specific version are locked to the newest\n" +
+			"installed version. Check the file into source control so that every machine that deploys the\n" +
+			"project uses the same plugins.\n" +
+			"\n" +
+			"Updates and previews install and use the locked plugins, and warn if the program requires\n" +
+			"plugins that do not match the lock file. Pass `--locked` to `pulumi up` and `pulumi preview`\n" +
+			"to fail instead, for example in CI.\n" +
+			"\n" +
+			"Checksums are recorded for the current platform and for any platforms already in the lock file.\n" +
+			"Use `--platform` to record checksums for other platforms, e.g. `--platform linux-amd64`.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			proj, root, err := readProject()
+			if err != nil {
+				return err
+			}
+			plugins, err := getPluginsForProject(proj, root, false /*includeLocal*/)
+			if err != nil {
+				return err
+			}
+
+			path := filepath.Join(root, workspace.PluginLockFile)
+			existing, err := workspace.LoadPluginLock(path)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+
+			lock, err := lockPlugins(plugins, existing, platforms)
+			if err != nil {
+				return err
+			}
+			if err = lock.Save(path); err != nil {
+				return err
+			}
+			fmt.Printf("Locked %d plugins in %s\n", len(lock.Plugins), path)
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringArrayVar(&platforms,
+		"platform", nil, "A platform to record checksums for, in the form <os>-<arch>; may be repeated")
+
+	return cmd
+}
+
+// lockPlugins returns a plugin lock for the given plugins. Checksums are reused from the existing lock, if any, for
+// plugins whose version and server are unchanged, and are otherwise fetched for the current platform, the platforms
+// already in the existing lock, and the given platforms.
+func lockPlugins(plugins []workspace.PluginInfo, existing *workspace.PluginLock,
+	platforms []string) (*workspace.PluginLock, error) {
+
+	installed, err := workspace.GetPlugins()
+	if err != nil {
+		return nil, err
+	}
+
+	lock := &workspace.PluginLock{}
+	for _, plugin := range plugins {
+		if plugin.Kind == workspace.LanguagePlugin {
+			continue
+		}
+
+		// Lock plugins without a version to the version that would be loaded, i.e. the newest installed version.
+		if plugin.Version == nil {
+			version, err := newestInstalledVersion(installed, plugin.Kind, plugin.Name)
+			if err != nil {
+				return nil, err
+			}
+			plugin.Version = version
+		}
+
+		locked := workspace.LockedPlugin{
+			Kind:      plugin.Kind,
+			Name:      plugin.Name,
+			Version:   plugin.Version.String(),
+			Server:    plugin.ServerURL,
+			Checksums: map[string]string{},
+		}
+		if locked.Server == "" {
+			locked.Server = workspace.DefaultPluginServerURL
+		}
+
+		wanted := map[string]bool{workspace.CurrentPlatform(): true}
+		for _, platform := range platforms {
+			wanted[platform] = true
+		}
+		if prev, ok := existing.Get(plugin.Kind, plugin.Name); ok {
+			for platform, checksum := range prev.Checksums {
+				wanted[platform] = true
+				if prev.Version == locked.Version && prev.Server == locked.Server {
+					locked.Checksums[platform] = checksum
+				}
+			}
+		}
+
+		// The checksum for the current platform is that of the installed plugin, if it was recorded.
+		if _, ok := locked.Checksums[workspace.CurrentPlatform()]; !ok {
+			if checksum, err := plugin.InstalledChecksum(); err == nil && checksum != "" {
+				locked.Checksums[workspace.CurrentPlatform()] = checksum
+			}
+		}
+
+		var missing []string
+		for platform := range wanted {
+			if _, ok := locked.Checksums[platform]; !ok {
+				missing = append(missing, platform)
+			}
+		}
+		sort.Strings(missing)
+		checksums, err := workspace.GetPluginChecksums(plugin, missing)
+		if err != nil {
+			return nil, errors.Wrapf(err, "locking %s plugin %s", plugin.Kind, plugin)
+		}
+		for platform, checksum := range checksums {
+			locked.Checksums[platform] = checksum
+		}
+
+		lock.Set(locked)
+	}
+	return lock, nil
+}
+
+// applyProjectPluginLock pins the given plugins to the plugins recorded in the lock file of the project in the given
+// root directory, if it has one. Plugins that are locked to a different version than the one required are left as is.
+func applyProjectPluginLock(root string, plugins []workspace.PluginInfo) ([]workspace.PluginInfo, error) {
+	lock, err := workspace.LoadPluginLock(filepath.Join(root, workspace.PluginLockFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return plugins, nil
+		}
+		return nil, err
+	}
+
+	results := make([]workspace.PluginInfo, len(plugins))
+	for i, plugin := range plugins {
+		results[i] = plugin
+		if locked, ok := lock.Get(plugin.Kind, plugin.Name); ok {
+			pinned := locked.PluginInfo()
+			if plugin.Version == nil || plugin.Version.EQ(*pinned.Version) {
+				if plugin.ServerURL != "" {
+					pinned.ServerURL = plugin.ServerURL
+				}
+				results[i] = pinned
+			}
+		}
+	}
+	return results, nil
+}
+
+// newestInstalledVersion returns the newest installed version of the given plugin.
+func newestInstalledVersion(installed []workspace.PluginInfo, kind workspace.PluginKind,
+	name string) (*semver.Version, error) {
+
+	var newest *semver.Version
+	for _, plugin := range installed {
+		if plugin.Kind == kind && plugin.Name == name && plugin.Version != nil &&
+			(newest == nil || plugin.Version.GT(*newest)) {
+			newest = plugin.Version
+		}
+	}
+	if newest == nil {
+		return nil, errors.Errorf("the program requires %s plugin %s without a version, and no version is installed; "+
+			"install it with `pulumi plugin install %s %s <version>`", kind, name, kind, name)
+	}
+	return newest, nil
+}
@@ -38,7 +38,7 @@ func newPluginLsCmd() *cobra.Command {
 			var plugins []workspace.PluginInfo
 			var err error
 			if projectOnly {
-				if plugins, err = getProjectPlugins(); err != nil {
+				if plugins, err = getProjectPlugins(true /*includeLocal*/); err != nil {
 					return errors.Wrapf(err, "loading project plugins")
 				}
 			} else {
@@ -69,7 +69,7 @@ func newPluginLsCmd() *cobra.Command {
 
 	cmd.PersistentFlags().BoolVarP(
 		&projectOnly, "project", "p", false,
-		"List only the plugins used by the current project")
+		"List only the plugins used by the current project, and the paths they are loaded from")
 	cmd.PersistentFlags().BoolVarP(
 		&jsonOut, "json", "j", false,
 		"Emit output as JSON")
@@ -86,6 +86,7 @@ type pluginInfoJSON struct {
 	Size         int     `json:"size"`
 	InstallTime  *string `json:"installTime,omitempty"`
 	LastUsedTime *string `json:"lastUsedTime,omitempty"`
+	Path         string  `json:"path,omitempty"`
 }
 
 func formatPluginsJSON(plugins []workspace.PluginInfo) error {
@@ -100,6 +101,7 @@ func formatPluginsJSON(plugins []workspace.PluginInfo) error {
 			Kind:    string(plugin.Kind),
 			Version: plugin.Version.String(),
 			Size:    int(plugin.Size),
+			Path:    plugin.Path,
 		}
 
 		if !plugin.InstallTime.IsZero() {
@@ -117,6 +119,15 @@ func formatPluginsJSON(plugins []workspace.PluginInfo) error {
 func formatPluginConsole(plugins []workspace.PluginInfo) error {
 	var totalSize uint64
 
+	// Plugins only have paths when listing the plugins used by a project, in which case the paths show where each
+	// plugin is loaded from, including plugins that the project overrides with local plugins.
+	showPaths := false
+	for _, plugin := range plugins {
+		if plugin.Path != "" {
+			showPaths = true
+		}
+	}
+
 	rows := []cmdutil.TableRow{}
 
 	for _, plugin := range plugins {
@@ -143,15 +154,25 @@ func formatPluginConsole(plugins []workspace.PluginInfo) error {
 			lastUsedTime = humanize.Time(plugin.LastUsedTime)
 		}
 
-		rows = append(rows, cmdutil.TableRow{
-			Columns: []string{plugin.Name, string(plugin.Kind), version, bytes, installTime, lastUsedTime},
-		})
+		columns := []string{plugin.Name, string(plugin.Kind), version, bytes, installTime, lastUsedTime}
+		if showPaths {
+			path := plugin.Path
+			if path == "" {
+				path = naString
+			}
+			columns = append(columns, path)
+		}
+		rows = append(rows, cmdutil.TableRow{Columns: columns})
 
 		totalSize += uint64(plugin.Size)
 	}
 
+	headers := []string{"NAME", "KIND", "VERSION", "SIZE", "INSTALLED", "LAST USED"}
+	if showPaths {
+		headers = append(headers, "PATH")
+	}
 	cmdutil.PrintTable(cmdutil.Table{
-		Headers: []string{"NAME", "KIND", "VERSION", "SIZE", "INSTALLED", "LAST USED"},
+		Headers: headers,
 		Rows:    rows,
 	})
 
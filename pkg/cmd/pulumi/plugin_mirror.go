@@ -0,0 +1,139 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newPluginMirrorCmd() *cobra.Command {
+	var platforms []string
+
+	var cmd = &cobra.Command{
+		Use:   "mirror <dir> [project-dir...]",
+		Args:  cmdutil.MinimumNArgs(1),
+		Short: "Download the plugins required by projects into a local plugin cache",
+		Long: "Download the plugins required by projects into a local plugin cache.\n" +
+			"\n" +
+			"This command downloads the tarballs of the plugins required by each of the given projects,\n" +
+			"or by the current project if none are given, into a directory that can then be used as a\n" +
+			"plugin source, for example on machines without internet access. Checksum manifests are\n" +
+			"copied along with the tarballs so that the mirrored plugins are verified when installed.\n" +
+			"\n" +
+			"Plugin sources are searched in order for plugins before the server of each plugin. They are\n" +
+			"read from the PULUMI_PLUGIN_SOURCES environment variable, a comma-separated list of mirror\n" +
+			"URLs and local directories, or else from the `sources` list in ~/.pulumi/plugins.json.\n" +
+			"\n" +
+			"By default, plugins are downloaded for the current platform. Use `--platform` to download\n" +
+			"them for other platforms, e.g. `--platform linux-amd64 --platform windows-amd64`.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			dir := args[0]
+
+			type platform struct{ os, arch string }
+			var targets []platform
+			for _, p := range platforms {
+				parts := strings.Split(p, "-")
+				if len(parts) != 2 {
+					return errors.Errorf("invalid platform %q; expected <os>-<arch>, e.g. linux-amd64", p)
+				}
+				targets = append(targets, platform{os: parts[0], arch: parts[1]})
+			}
+			if len(targets) == 0 {
+				targets = []platform{{os: runtime.GOOS, arch: runtime.GOARCH}}
+			}
+
+			plugins, err := getMirrorPlugins(args[1:])
+			if err != nil {
+				return err
+			}
+
+			for _, plugin := range plugins {
+				for _, target := range targets {
+					label := fmt.Sprintf("[%s plugin %s %s-%s]", plugin.Kind, plugin, target.os, target.arch)
+					cmdutil.Diag().Infoerrf(diag.Message("", "%s mirroring"), label)
+					if err := workspace.MirrorPlugin(plugin, dir, target.os, target.arch); err != nil {
+						return errors.Wrapf(err, "%s mirroring", label)
+					}
+				}
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringArrayVar(&platforms,
+		"platform", nil, "A platform to download plugins for, in the form <os>-<arch>; may be repeated")
+
+	return cmd
+}
+
+// getMirrorPlugins returns the plugins required by the projects in the given directories, or by the current project
+// if no directories are given. Language plugins are skipped, as they are distributed with the CLI.
+func getMirrorPlugins(projectDirs []string) ([]workspace.PluginInfo, error) {
+	var required []workspace.PluginInfo
+	if len(projectDirs) == 0 {
+		plugins, err := getProjectPlugins(false /*includeLocal*/)
+		if err != nil {
+			return nil, err
+		}
+		required = plugins
+	}
+	for _, projectDir := range projectDirs {
+		projectDir, err := filepath.Abs(projectDir)
+		if err != nil {
+			return nil, err
+		}
+		proj, root, err := readProjectFrom(projectDir)
+		if err != nil {
+			return nil, err
+		}
+		plugins, err := getPluginsForProject(proj, root, false /*includeLocal*/)
+		if err != nil {
+			return nil, err
+		}
+		required = append(required, plugins...)
+	}
+
+	// Deduplicate the plugins shared by several projects.
+	seen := make(map[string]bool)
+	var results []workspace.PluginInfo
+	for _, plugin := range required {
+		if plugin.Kind == workspace.LanguagePlugin {
+			continue
+		}
+		if plugin.Version == nil {
+			cmdutil.Diag().Warningf(diag.Message("", "skipping %s plugin %s, which does not have a version"),
+				plugin.Kind, plugin.Name)
+			continue
+		}
+		key := fmt.Sprintf("%s-%s", plugin.Kind, plugin)
+		if !seen[key] {
+			seen[key] = true
+			results = append(results, plugin)
+		}
+	}
+	sort.Sort(workspace.SortedPluginInfo(results))
+	return results, nil
+}
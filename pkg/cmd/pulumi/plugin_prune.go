@@ -0,0 +1,154 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newPluginPruneCmd() *cobra.Command {
+	var days int
+	var maxSize string
+	var dryRun bool
+	var yes bool
+	var cmd = &cobra.Command{
+		Use:   "prune",
+		Args:  cmdutil.NoArgs,
+		Short: "Remove unused plugins from the download cache",
+		Long: "Remove unused plugins from the download cache.\n" +
+			"\n" +
+			"This command removes the plugins that have not been used in the given number of days and that\n" +
+			"are not required by any project deployed in that time. The plugins each project uses are\n" +
+			"recorded whenever it is previewed or updated.\n" +
+			"\n" +
+			"Pass `--max-size` to also remove the least recently used of the remaining plugins that are not\n" +
+			"required by a recently used project until the cache fits, e.g. `--max-size 10GB`. The cache\n" +
+			"size may be capped automatically after each deployment finishes by setting `maxCacheSize` in\n" +
+			"~/.pulumi/plugins.json or the PULUMI_PLUGIN_CACHE_MAX_SIZE environment variable.\n" +
+			"\n" +
+			"Pass `--dry-run` to list the plugins that would be removed and the space that would be\n" +
+			"reclaimed without removing them.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			yes = yes || skipConfirmations()
+			opts := display.Options{
+				Color: cmdutil.GetGlobalColorization(),
+			}
+
+			if days < 0 {
+				return errors.New("--days must not be negative")
+			}
+			var maxBytes uint64
+			if maxSize != "" {
+				b, err := humanize.ParseBytes(maxSize)
+				if err != nil {
+					return errors.Wrapf(err, "invalid --max-size")
+				}
+				maxBytes = b
+			}
+
+			installed, err := workspace.GetPluginsWithMetadata()
+			if err != nil {
+				return errors.Wrap(err, "loading plugins")
+			}
+			refs, err := workspace.GetPluginReferences()
+			if err != nil {
+				return errors.Wrap(err, "loading plugin references")
+			}
+			age := time.Duration(days) * 24 * time.Hour
+			prunes := workspace.SelectPluginsToPrune(installed, refs, time.Now(), workspace.PluginPruneOptions{
+				ProjectAge: age,
+				MaxAge:     age,
+				MaxSize:    maxBytes,
+			})
+
+			if len(prunes) == 0 {
+				cmdutil.Diag().Infof(
+					diag.Message("", "no plugins found to prune"))
+				return nil
+			}
+
+			var reclaimable uint64
+			for _, plugin := range prunes {
+				reclaimable += uint64(plugin.Size)
+			}
+			var suffix string
+			if len(prunes) != 1 {
+				suffix = "s"
+			}
+			verb := "This will remove"
+			if dryRun {
+				verb = "Would remove"
+			}
+			fmt.Print(
+				opts.Color.Colorize(
+					fmt.Sprintf("%s%s %d plugin%s from the cache, reclaiming %s:%s\n",
+						colors.SpecAttention, verb, len(prunes), suffix, humanize.Bytes(reclaimable), colors.Reset)))
+			for _, plugin := range prunes {
+				lastUsed := humanNeverTime
+				if !plugin.LastUsedTime.IsZero() {
+					lastUsed = humanize.Time(plugin.LastUsedTime)
+				}
+				fmt.Printf("    %s %s (%s, last used %s)\n",
+					plugin.Kind, plugin.String(), humanize.Bytes(uint64(plugin.Size)), lastUsed)
+			}
+			if dryRun {
+				return nil
+			}
+
+			if yes || confirmPrompt("", "yes", opts) {
+				var result error
+				for _, plugin := range prunes {
+					if err := plugin.Prune(); err != nil {
+						result = multierror.Append(
+							result, errors.Wrapf(err, "failed to delete %s plugin %s", plugin.Kind, plugin))
+					}
+				}
+				if result != nil {
+					return result
+				}
+			}
+
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().IntVar(
+		&days, "days", 30,
+		"Remove plugins not used in this many days that no project used in that time requires; "+
+			"0 only removes plugins to satisfy --max-size")
+	cmd.PersistentFlags().StringVar(
+		&maxSize, "max-size", "",
+		"Also remove the least recently used plugins until the cache is no larger than this size, e.g. 10GB")
+	cmd.PersistentFlags().BoolVar(
+		&dryRun, "dry-run", false,
+		"List the plugins that would be removed and the space reclaimed, without removing them")
+	cmd.PersistentFlags().BoolVarP(
+		&yes, "yes", "y", false,
+		"Skip confirmation prompts, and proceed with removal anyway")
+
+	return cmd
+}
@@ -26,6 +26,8 @@ func newPolicyCmd() *cobra.Command {
 		Args:  cmdutil.NoArgs,
 	}
 
+	cmd.AddCommand(newPolicyCheckCmd())
+	cmd.AddCommand(newPolicyConfigCmd())
 	cmd.AddCommand(newPolicyDisableCmd())
 	cmd.AddCommand(newPolicyEnableCmd())
 	cmd.AddCommand(newPolicyGroupCmd())
@@ -33,6 +35,7 @@ func newPolicyCmd() *cobra.Command {
 	cmd.AddCommand(newPolicyNewCmd())
 	cmd.AddCommand(newPolicyPublishCmd())
 	cmd.AddCommand(newPolicyRmCmd())
+	cmd.AddCommand(newPolicyTestCmd())
 	cmd.AddCommand(newPolicyValidateCmd())
 
 	return cmd
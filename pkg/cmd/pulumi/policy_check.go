@@ -0,0 +1,252 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func newPolicyCheckCmd() *cobra.Command {
+	var stack string
+	var format string
+	var policyPackPaths []string
+	var policyPackConfigPaths []string
+	var skipPublished bool
+
+	var cmd = &cobra.Command{
+		Use:   "check",
+		Args:  cmdutil.NoArgs,
+		Short: "Check a stack's existing resources against Policy Packs",
+		Long: "Check a stack's existing resources against Policy Packs.\n" +
+			"\n" +
+			"This command runs Policy Packs against the resources recorded in the latest checkpoint of a stack\n" +
+			"without running the program or modifying the stack. Each resource is analyzed using the inputs and\n" +
+			"outputs recorded in the checkpoint.\n" +
+			"\n" +
+			"Local Policy Packs are specified with the `--policy-pack` flag. The Policy Packs that the stack's\n" +
+			"backend enforces on the stack are also run unless `--skip-published` is passed.\n" +
+			"\n" +
+			"Violations are reported as text, JSON, or SARIF according to the `--format` flag. The command fails\n" +
+			"if any mandatory policy is violated. Violations that are waived by a policy exemption in the project or\n" +
+			"stack configuration are reported, but do not cause the command to fail.",
+		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
+			switch format {
+			case "text", "json", "sarif":
+			default:
+				return result.Errorf("unsupported format %q; expected one of text, json, or sarif", format)
+			}
+			if err := validatePolicyPackConfig(policyPackPaths, policyPackConfigPaths); err != nil {
+				return result.FromError(err)
+			}
+
+			opts := display.Options{Color: cmdutil.GetGlobalColorization()}
+
+			proj, root, err := readProject()
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			s, err := requireStack(stack, false, opts, false /*setCurrent*/)
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			sm, err := getStackSecretsManager(s)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "getting secrets manager"))
+			}
+			cfg, err := getStackConfiguration(s, sm)
+			if err != nil {
+				return result.FromError(errors.Wrap(err, "getting stack configuration"))
+			}
+			snap, err := s.Snapshot(commandContext())
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			exemptions, err := getPolicyExemptions(proj, s)
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			checkOpts := engine.PolicyCheckOptions{
+				LocalPolicyPacks: engine.MakeLocalPolicyPacks(policyPackPaths, policyPackConfigPaths),
+				Diag:             cmdutil.Diag(),
+				PolicyExemptions: exemptions,
+			}
+			if !skipPublished {
+				required, err := s.Backend().GetStackPolicyPacks(commandContext(), s.Ref())
+				if err != nil {
+					return result.FromError(errors.Wrap(err, "getting the stack's policy packs"))
+				}
+				checkOpts.RequiredPolicies = required
+			}
+			if len(checkOpts.LocalPolicyPacks) == 0 && len(checkOpts.RequiredPolicies) == 0 {
+				return result.Errorf("no policy packs to run; specify one or more with --policy-pack")
+			}
+
+			diagnostics, err := engine.CheckPolicies(proj, root, &deploy.Target{
+				Name:      s.Ref().Name(),
+				Config:    cfg.Config,
+				Decrypter: cfg.Decrypter,
+				Snapshot:  snap,
+			}, checkOpts)
+			if err != nil {
+				return result.FromError(err)
+			}
+			sortPolicyDiagnostics(diagnostics)
+
+			switch format {
+			case "json":
+				err = formatPolicyCheckJSON(diagnostics)
+			case "sarif":
+				err = display.WritePolicySARIF(os.Stdout, diagnostics)
+			default:
+				formatPolicyCheckConsole(os.Stdout, diagnostics, opts.Color)
+			}
+			if err != nil {
+				return result.FromError(err)
+			}
+
+			mandatory := 0
+			for _, d := range diagnostics {
+				if d.EnforcementLevel == apitype.Mandatory && d.Exemption == nil {
+					mandatory++
+				}
+			}
+			if mandatory != 0 {
+				return result.Errorf("%d mandatory policy violation(s) found", mandatory)
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVarP(
+		&stack, "stack", "s", "",
+		"The name of the stack to check")
+	cmd.PersistentFlags().StringVar(
+		&format, "format", "text",
+		"The format of the report: text, json, or sarif")
+	cmd.PersistentFlags().StringSliceVar(
+		&policyPackPaths, "policy-pack", []string{},
+		"Run one or more local policy packs")
+	cmd.PersistentFlags().StringSliceVar(
+		&policyPackConfigPaths, "policy-pack-config", []string{},
+		`Path to JSON file containing the config for the policy pack of the corresponding "--policy-pack" flag`)
+	cmd.PersistentFlags().BoolVar(
+		&skipPublished, "skip-published", false,
+		"Do not run the published policy packs that are enforced on the stack")
+
+	return cmd
+}
+
+// sortPolicyDiagnostics sorts policy diagnostics by policy pack name, policy pack version, enforcement level, policy
+// name, and finally the URN of the resource.
+func sortPolicyDiagnostics(diagnostics []engine.PolicyDiagnostic) {
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		di, dj := diagnostics[i], diagnostics[j]
+		if di.PolicyPackName != dj.PolicyPackName {
+			return di.PolicyPackName < dj.PolicyPackName
+		}
+		if di.PolicyPackVersion != dj.PolicyPackVersion {
+			return di.PolicyPackVersion < dj.PolicyPackVersion
+		}
+		if di.EnforcementLevel != dj.EnforcementLevel {
+			return di.EnforcementLevel < dj.EnforcementLevel
+		}
+		if di.PolicyName != dj.PolicyName {
+			return di.PolicyName < dj.PolicyName
+		}
+		return di.URN < dj.URN
+	})
+}
+
+func formatPolicyCheckConsole(w io.Writer, diagnostics []engine.PolicyDiagnostic, color colors.Colorization) {
+	if len(diagnostics) == 0 {
+		fmt.Fprintln(w, "No policy violations found.")
+		return
+	}
+
+	fmt.Fprintln(w, color.Colorize(colors.SpecHeadline+"Policy Violations:"+colors.Reset))
+	for _, d := range diagnostics {
+		c := colors.SpecImportant
+		if d.EnforcementLevel == apitype.Mandatory {
+			c = colors.SpecError
+		}
+		level := string(d.EnforcementLevel)
+		if d.Exemption != nil {
+			c, level = colors.SpecInfo, "exempted "+level
+		}
+		fmt.Fprintln(w, color.Colorize(fmt.Sprintf("    %s[%s]  %s v%s %s %s (%s: %s)",
+			c, level, d.PolicyPackName, d.PolicyPackVersion, colors.Reset,
+			d.PolicyName, d.URN.Type(), d.URN.Name())))
+
+		// The message may span multiple lines, so we massage it so it will be indented properly.
+		fmt.Fprintf(w, "    %s\n", strings.ReplaceAll(strings.TrimRight(d.Message, "\n"), "\n", "\n    "))
+		if d.Exemption != nil {
+			fmt.Fprintf(w, "    exempted until %s: %s\n", d.Exemption.Expires, d.Exemption.Justification)
+		}
+	}
+}
+
+// policyViolationJSON is the shape of each entry in the --format=json output of this command. While we can add
+// fields to this structure in the future, we should not change existing fields.
+type policyViolationJSON struct {
+	PolicyName        string   `json:"policyName"`
+	PolicyPackName    string   `json:"policyPackName"`
+	PolicyPackVersion string   `json:"policyPackVersion"`
+	Description       string   `json:"description,omitempty"`
+	Message           string   `json:"message"`
+	Tags              []string `json:"tags,omitempty"`
+	EnforcementLevel  string   `json:"enforcementLevel"`
+	URN               string   `json:"urn,omitempty"`
+
+	// Exemption is the policy exemption that waives the violation, if any.
+	Exemption *workspace.PolicyExemption `json:"exemption,omitempty"`
+}
+
+func formatPolicyCheckJSON(diagnostics []engine.PolicyDiagnostic) error {
+	output := make([]policyViolationJSON, len(diagnostics))
+	for i, d := range diagnostics {
+		output[i] = policyViolationJSON{
+			PolicyName:        d.PolicyName,
+			PolicyPackName:    d.PolicyPackName,
+			PolicyPackVersion: d.PolicyPackVersion,
+			Description:       d.Description,
+			Message:           d.Message,
+			Tags:              d.Tags,
+			EnforcementLevel:  string(d.EnforcementLevel),
+			URN:               string(d.URN),
+			Exemption:         d.Exemption,
+		}
+	}
+	return printJSON(output)
+}
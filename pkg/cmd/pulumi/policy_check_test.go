@@ -0,0 +1,82 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+func TestFormatPolicyCheckConsole(t *testing.T) {
+	diags := []engine.PolicyDiagnostic{
+		{AnalyzeDiagnostic: plugin.AnalyzeDiagnostic{
+			PolicyName:        "s3-encryption",
+			PolicyPackName:    "security",
+			PolicyPackVersion: "1.0.0",
+			Message:           "Bucket is not encrypted.\nEnable encryption.\n",
+			EnforcementLevel:  apitype.Mandatory,
+			URN:               "urn:pulumi:dev::proj::aws:s3/bucket:Bucket::logs",
+		}},
+		{AnalyzeDiagnostic: plugin.AnalyzeDiagnostic{
+			PolicyName:        "cost-tags",
+			PolicyPackName:    "cost",
+			PolicyPackVersion: "2.0.0",
+			Message:           "Missing cost tags.",
+			EnforcementLevel:  apitype.Advisory,
+			URN:               "urn:pulumi:dev::proj::aws:s3/bucket:Bucket::data",
+		}},
+		{
+			AnalyzeDiagnostic: plugin.AnalyzeDiagnostic{
+				PolicyName:        "s3-encryption",
+				PolicyPackName:    "security",
+				PolicyPackVersion: "1.0.0",
+				Message:           "Bucket is not encrypted.",
+				EnforcementLevel:  apitype.Mandatory,
+				URN:               "urn:pulumi:dev::proj::aws:s3/bucket:Bucket::legacy",
+			},
+			Exemption: &workspace.PolicyExemption{
+				Policy:        "s3-encryption",
+				URN:           "*legacy",
+				Justification: "Scheduled for deletion.",
+				Expires:       "2021-07-01",
+			},
+		},
+	}
+	sortPolicyDiagnostics(diags)
+
+	var buf bytes.Buffer
+	formatPolicyCheckConsole(&buf, diags, colors.Never)
+	assert.Equal(t, "Policy Violations:\n"+
+		"    [advisory]  cost v2.0.0  cost-tags (aws:s3/bucket:Bucket: data)\n"+
+		"    Missing cost tags.\n"+
+		"    [exempted mandatory]  security v1.0.0  s3-encryption (aws:s3/bucket:Bucket: legacy)\n"+
+		"    Bucket is not encrypted.\n"+
+		"    exempted until 2021-07-01: Scheduled for deletion.\n"+
+		"    [mandatory]  security v1.0.0  s3-encryption (aws:s3/bucket:Bucket: logs)\n"+
+		"    Bucket is not encrypted.\n"+
+		"    Enable encryption.\n", buf.String())
+
+	buf.Reset()
+	formatPolicyCheckConsole(&buf, nil, colors.Never)
+	assert.Equal(t, "No policy violations found.\n", buf.String())
+}
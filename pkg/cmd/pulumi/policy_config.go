@@ -0,0 +1,230 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	resourceanalyzer "github.com/pulumi/pulumi/pkg/v3/resource/analyzer"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/policytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+const defaultPolicyConfigFile = "policy-config.yaml"
+
+func newPolicyConfigCmd() *cobra.Command {
+	var policyPackPath string
+	var configFile string
+
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the configuration of a local Policy Pack",
+		Long: "Manage the configuration of a local Policy Pack.\n" +
+			"\n" +
+			"These commands create, edit, and validate the configuration files passed to `--policy-pack-config`\n" +
+			"and `pulumi policy enable --config`, using the configuration schema declared by each policy of the\n" +
+			"Policy Pack. Files with a .yaml or .yml extension are written as YAML; all others are written as JSON.",
+		Args: cmdutil.NoArgs,
+	}
+
+	cmd.PersistentFlags().StringVar(
+		&policyPackPath, "policy-pack", ".",
+		"The path to the Policy Pack")
+	cmd.PersistentFlags().StringVar(
+		&configFile, "config", defaultPolicyConfigFile,
+		"The file path for the Policy Pack configuration file")
+
+	cmd.AddCommand(newPolicyConfigInitCmd(&policyPackPath, &configFile))
+	cmd.AddCommand(newPolicyConfigSetCmd(&policyPackPath, &configFile))
+	cmd.AddCommand(newPolicyConfigValidateCmd(&policyPackPath, &configFile))
+
+	return cmd
+}
+
+func newPolicyConfigInitCmd(policyPackPath, configFile *string) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Args:  cmdutil.NoArgs,
+		Short: "Create a configuration file for a Policy Pack",
+		Long: "Create a configuration file for a Policy Pack.\n" +
+			"\n" +
+			"The file sets the enforcement level of each policy and the default value of each of its configuration\n" +
+			"properties. Required properties without a default must be added with `pulumi policy config set`.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			if _, err := os.Stat(*configFile); err == nil && !force {
+				return errors.Errorf("%s already exists; pass --force to overwrite it", *configFile)
+			}
+
+			info, err := getLocalPolicyPackInfo(*policyPackPath)
+			if err != nil {
+				return err
+			}
+			document := resourceanalyzer.NewPolicyPackConfigDocument(info.Policies, info.InitialConfig)
+			if err = resourceanalyzer.SavePolicyPackConfigDocument(*configFile, document); err != nil {
+				return err
+			}
+			fmt.Printf("Created %s with the configuration of %d policies.\n", *configFile, len(info.Policies))
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().BoolVarP(
+		&force, "force", "f", false,
+		"Overwrite the configuration file if it already exists")
+
+	return cmd
+}
+
+func newPolicyConfigSetCmd(policyPackPath, configFile *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <policy> <property> <value>",
+		Args:  cmdutil.ExactArgs(3),
+		Short: "Set a configuration property of a policy",
+		Long: "Set a configuration property of a policy.\n" +
+			"\n" +
+			"The value is parsed as JSON if possible, so numbers, booleans, arrays, and objects may be set;\n" +
+			"otherwise it is set as a string. Use the `enforcementLevel` property to set the enforcement level\n" +
+			"of the policy, or the policy name `all` to set the enforcement level of every policy.\n" +
+			"\n" +
+			"The edited configuration is validated against the configuration schema of the Policy Pack, and is\n" +
+			"not saved if it is invalid.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			document, err := resourceanalyzer.LoadPolicyPackConfigDocument(*configFile)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			if document == nil {
+				document = make(map[string]interface{})
+			}
+			setPolicyConfigValue(document, args[0], args[1], parsePolicyConfigValue(args[2]))
+
+			info, err := getLocalPolicyPackInfo(*policyPackPath)
+			if err != nil {
+				return err
+			}
+			if err = checkPolicyConfigDocument(info.Policies, document); err != nil {
+				return err
+			}
+			return resourceanalyzer.SavePolicyPackConfigDocument(*configFile, document)
+		}),
+	}
+	return cmd
+}
+
+func newPolicyConfigValidateCmd(policyPackPath, configFile *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Args:  cmdutil.NoArgs,
+		Short: "Validate a configuration file for a Policy Pack",
+		Long: "Validate a configuration file for a Policy Pack.\n" +
+			"\n" +
+			"In addition to validating the configuration of each policy against its schema, this command reports\n" +
+			"configuration for policies and properties that the Policy Pack does not declare.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			document, err := resourceanalyzer.LoadPolicyPackConfigDocument(*configFile)
+			if err != nil {
+				return err
+			}
+			info, err := getLocalPolicyPackInfo(*policyPackPath)
+			if err != nil {
+				return err
+			}
+			if err = checkPolicyConfigDocument(info.Policies, document); err != nil {
+				return err
+			}
+			fmt.Println("Policy Pack configuration is valid.")
+			return nil
+		}),
+	}
+	return cmd
+}
+
+// getLocalPolicyPackInfo boots the local policy pack at the given path and returns its metadata.
+func getLocalPolicyPackInfo(policyPackPath string) (plugin.AnalyzerInfo, error) {
+	h, err := policytest.Load(policyPackPath, plugin.PolicyAnalyzerOptions{}, cmdutil.Diag())
+	if err != nil {
+		return plugin.AnalyzerInfo{}, err
+	}
+	defer contract.IgnoreClose(h)
+
+	return h.Analyzer().GetAnalyzerInfo()
+}
+
+// parsePolicyConfigValue parses a value given on the command line as JSON, or returns it as a string if it is not
+// valid JSON.
+func parsePolicyConfigValue(value string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return value
+	}
+	return v
+}
+
+// setPolicyConfigValue sets a property of a policy in the given config, expanding the shorthand form that only sets
+// the policy's enforcement level if necessary.
+func setPolicyConfigValue(document map[string]interface{}, policy, property string, value interface{}) {
+	var props map[string]interface{}
+	switch existing := document[policy].(type) {
+	case map[string]interface{}:
+		props = existing
+	case string:
+		props = map[string]interface{}{"enforcementLevel": existing}
+	default:
+		props = make(map[string]interface{})
+	}
+	props[property] = value
+
+	// Keep the shorthand form if only the enforcement level is set.
+	if level, ok := props["enforcementLevel"]; ok && len(props) == 1 {
+		document[policy] = level
+		return
+	}
+	document[policy] = props
+}
+
+// checkPolicyConfigDocument validates the given config against the given policies, returning an error that lists
+// every problem that was found.
+func checkPolicyConfigDocument(policies []plugin.AnalyzerPolicyInfo, document map[string]interface{}) error {
+	b, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	config, err := resourceanalyzer.ParsePolicyPackConfig(b)
+	if err != nil {
+		return errors.Wrap(err, "invalid Policy Pack configuration")
+	}
+	if config == nil {
+		config = make(map[string]plugin.AnalyzerPolicyConfig)
+	}
+
+	validationErrors, err := resourceanalyzer.CheckPolicyPackConfig(policies, config)
+	if err != nil {
+		return err
+	}
+	if len(validationErrors) > 0 {
+		return errors.Errorf("invalid Policy Pack configuration:\n  %s", strings.Join(validationErrors, "\n  "))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+)
+
+func TestSetPolicyConfigValue(t *testing.T) {
+	policies := []plugin.AnalyzerPolicyInfo{{
+		Name:             "s3-encryption",
+		EnforcementLevel: "mandatory",
+		ConfigSchema: &plugin.AnalyzerPolicyConfigSchema{
+			Properties: map[string]plugin.JSONSchema{
+				"algorithm":  {"type": "string"},
+				"maxKeyAge":  {"type": "integer"},
+				"exemptTags": {"type": "array", "items": map[string]interface{}{"type": "string"}},
+			},
+		},
+	}}
+
+	document := map[string]interface{}{}
+	setPolicyConfigValue(document, "s3-encryption", "enforcementLevel", parsePolicyConfigValue("advisory"))
+	assert.Equal(t, map[string]interface{}{"s3-encryption": "advisory"}, document)
+
+	setPolicyConfigValue(document, "s3-encryption", "algorithm", parsePolicyConfigValue("AES256"))
+	setPolicyConfigValue(document, "s3-encryption", "maxKeyAge", parsePolicyConfigValue("90"))
+	setPolicyConfigValue(document, "s3-encryption", "exemptTags", parsePolicyConfigValue(`["legacy"]`))
+	assert.Equal(t, map[string]interface{}{
+		"s3-encryption": map[string]interface{}{
+			"enforcementLevel": "advisory",
+			"algorithm":        "AES256",
+			"maxKeyAge":        float64(90),
+			"exemptTags":       []interface{}{"legacy"},
+		},
+	}, document)
+	assert.NoError(t, checkPolicyConfigDocument(policies, document))
+
+	setPolicyConfigValue(document, "s3-encryption", "maxKeyAge", parsePolicyConfigValue("ninety"))
+	assert.EqualError(t, checkPolicyConfigDocument(policies, document),
+		"invalid Policy Pack configuration:\n  s3-encryption: maxKeyAge: Invalid type. Expected: integer, given: string")
+
+	setPolicyConfigValue(document, "s3-encryption", "enforcementLevel", parsePolicyConfigValue("required"))
+	assert.EqualError(t, checkPolicyConfigDocument(policies, document),
+		`invalid Policy Pack configuration: parsing enforcement level for "s3-encryption": `+
+			`"required" is not a valid enforcement level`)
+}
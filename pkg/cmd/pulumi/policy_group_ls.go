@@ -32,6 +32,8 @@ func newPolicyGroupCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(newPolicyGroupLsCmd())
+	cmd.AddCommand(newPolicyGroupAddStackCmd())
+	cmd.AddCommand(newPolicyGroupRemoveStackCmd())
 	return cmd
 }
 
@@ -0,0 +1,80 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/backend/display"
+	"github.com/pulumi/pulumi/pkg/v3/backend/filestate"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+)
+
+func newPolicyGroupAddStackCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "add-stack <policy-group> <stack>",
+		Args:  cmdutil.ExactArgs(2),
+		Short: "Add stacks to a Policy Group of a self-managed backend",
+		Long: "Add stacks to a Policy Group of a self-managed backend.\n" +
+			"\n" +
+			"The Policy Packs enabled for the Policy Group are run on every update and preview of the stacks\n" +
+			"that belong to it. The stack name may be a glob pattern, such as `prod-*`. Stacks that do not belong\n" +
+			"to any other Policy Group belong to the default Policy Group. The Policy Group is created if it\n" +
+			"does not exist.\n" +
+			"\n" +
+			"The stacks of Policy Groups in the Pulumi service are managed in the Pulumi Console.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			b, err := requireFilestateBackend()
+			if err != nil {
+				return err
+			}
+			return b.AddStackToPolicyGroup(commandContext(), args[0], args[1])
+		}),
+	}
+	return cmd
+}
+
+func newPolicyGroupRemoveStackCmd() *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "remove-stack <policy-group> <stack>",
+		Args:  cmdutil.ExactArgs(2),
+		Short: "Remove stacks from a Policy Group of a self-managed backend",
+		Long: "Remove stacks from a Policy Group of a self-managed backend.\n" +
+			"\n" +
+			"The stack name must match a name or pattern that was previously added with `add-stack`.",
+		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
+			b, err := requireFilestateBackend()
+			if err != nil {
+				return err
+			}
+			return b.RemoveStackFromPolicyGroup(commandContext(), args[0], args[1])
+		}),
+	}
+	return cmd
+}
+
+// requireFilestateBackend returns the current backend if it is a self-managed backend.
+func requireFilestateBackend() (filestate.Backend, error) {
+	b, err := currentBackend(display.Options{Color: cmdutil.GetGlobalColorization()})
+	if err != nil {
+		return nil, err
+	}
+	fb, ok := b.(filestate.Backend)
+	if !ok {
+		return nil, errors.New("the stacks of Policy Groups in the Pulumi service are managed in the Pulumi Console")
+	}
+	return fb, nil
+}
@@ -21,11 +21,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi/pkg/v3/backend"
 	"github.com/pulumi/pulumi/pkg/v3/backend/display"
-	"github.com/pulumi/pulumi/pkg/v3/backend/httpstate"
 	"github.com/pulumi/pulumi/pkg/v3/engine"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
-	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 	"github.com/spf13/cobra"
 )
 
@@ -36,7 +34,10 @@ func newPolicyPublishCmd() *cobra.Command {
 		Short: "Publish a Policy Pack to the Pulumi service",
 		Long: "Publish a Policy Pack to the Pulumi service\n" +
 			"\n" +
-			"If an organization name is not specified, the current user account is used.",
+			"If an organization name is not specified, the current user account is used.\n" +
+			"\n" +
+			"When logged in to a self-managed backend, the Policy Pack is stored in the backend's state bucket\n" +
+			"instead, and organization names are ignored.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, args []string) error {
 
 			var orgName string
@@ -57,7 +58,7 @@ func newPolicyPublishCmd() *cobra.Command {
 			policyPackRef := fmt.Sprintf("%s/", orgName)
 
 			//
-			// Obtain current PolicyPack, tied to the Pulumi service backend.
+			// Obtain current PolicyPack, tied to the current backend.
 			//
 
 			policyPack, err := requirePolicyPack(policyPackRef)
@@ -105,22 +106,17 @@ func newPolicyPublishCmd() *cobra.Command {
 
 func requirePolicyPack(policyPack string) (backend.PolicyPack, error) {
 	//
-	// Attempt to log into cloud backend.
+	// Attempt to log into the current backend. Policy Packs are managed by the Pulumi service, or stored in the
+	// state bucket of a self-managed backend.
 	//
 
-	cloudURL, err := workspace.GetCurrentCloudURL()
-	if err != nil {
-		return nil, errors.Wrap(err,
-			"`pulumi policy` command requires the user to be logged into the Pulumi service")
-	}
-
 	displayOptions := display.Options{
 		Color: cmdutil.GetGlobalColorization(),
 	}
 
-	b, err := httpstate.Login(commandContext(), cmdutil.Diag(), cloudURL, displayOptions)
+	b, err := currentBackend(displayOptions)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "`pulumi policy` command requires the user to be logged in")
 	}
 
 	//
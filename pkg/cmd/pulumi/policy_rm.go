@@ -35,7 +35,7 @@ func newPolicyRmCmd() *cobra.Command {
 			"The Policy Pack must be disabled from all Policy Groups before it can be removed.",
 		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
 			yes = yes || skipConfirmations()
-			// Obtain current PolicyPack, tied to the Pulumi service backend.
+			// Obtain current PolicyPack, tied to the current backend.
 			policyPack, err := requirePolicyPack(args[0])
 			if err != nil {
 				return result.FromError(err)
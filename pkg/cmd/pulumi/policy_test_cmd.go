@@ -0,0 +1,138 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/policytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
+)
+
+func newPolicyTestCmd() *cobra.Command {
+	var policyPackPath string
+	var project string
+	var stack string
+
+	var cmd = &cobra.Command{
+		Use:   "test <fixture>...",
+		Args:  cmdutil.MinimumNArgs(1),
+		Short: "Test a Policy Pack against fixture files",
+		Long: "Test a Policy Pack against fixture files.\n" +
+			"\n" +
+			"Each fixture is a JSON or YAML file that describes a set of synthetic resources, along with the\n" +
+			"policy violations that analyzing them is expected to report. The Policy Pack is run against the\n" +
+			"resources without running a Pulumi program, loading any resource providers, or accessing the cloud.\n" +
+			"Directories are searched recursively for fixture files.\n" +
+			"\n" +
+			"A fixture lists the resources to analyze, each with a type, a name, and optional properties, parent,\n" +
+			"provider, and dependencies that refer to other resources by name, and the expected violations, each\n" +
+			"with a policy name and an optional resource name, enforcement level, and message substring. A fixture\n" +
+			"may also configure the Policy Pack using the format accepted by `--policy-pack-config`:\n" +
+			"\n" +
+			"    config:\n" +
+			"      s3-encryption: mandatory\n" +
+			"    resources:\n" +
+			"      - type: pulumi:providers:aws\n" +
+			"        name: east\n" +
+			"      - type: aws:s3/bucket:Bucket\n" +
+			"        name: logs\n" +
+			"        provider: east\n" +
+			"        properties:\n" +
+			"          encrypted: false\n" +
+			"    expect:\n" +
+			"      - policy: s3-encryption\n" +
+			"        resource: logs\n" +
+			"\n" +
+			"A fixture fails if an expected violation is not reported, or if an unexpected violation is reported.",
+		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
+			fixtures, err := policytest.LoadFixtures(args...)
+			if err != nil {
+				return result.FromError(err)
+			}
+			if len(fixtures) == 0 {
+				return result.Errorf("no fixture files found")
+			}
+
+			h, err := policytest.Load(policyPackPath, plugin.PolicyAnalyzerOptions{
+				Project: project,
+				Stack:   stack,
+				DryRun:  true,
+			}, cmdutil.Diag())
+			if err != nil {
+				return result.FromError(err)
+			}
+			defer contract.IgnoreClose(h)
+
+			failed, err := runPolicyTests(os.Stdout, h, fixtures, cmdutil.GetGlobalColorization())
+			if err != nil {
+				return result.FromError(err)
+			}
+			if failed != 0 {
+				return result.Errorf("%d of %d policy test(s) failed", failed, len(fixtures))
+			}
+			return nil
+		}),
+	}
+
+	cmd.PersistentFlags().StringVar(
+		&policyPackPath, "policy-pack", ".",
+		"The path to the Policy Pack to test")
+	cmd.PersistentFlags().StringVar(
+		&project, "project", policytest.DefaultProject,
+		"The name of the project that contains the synthetic resources")
+	cmd.PersistentFlags().StringVar(
+		&stack, "stack", policytest.DefaultStack,
+		"The name of the stack that contains the synthetic resources")
+
+	return cmd
+}
+
+// runPolicyTests runs each fixture against the harness, reporting the results to the given writer, and returns the
+// number of fixtures that failed.
+func runPolicyTests(w io.Writer, h *policytest.Harness, fixtures []*policytest.Fixture,
+	color colors.Colorization) (int, error) {
+
+	failed := 0
+	for _, f := range fixtures {
+		res, err := h.RunFixture(f)
+		if err != nil {
+			return 0, errors.Wrap(err, f.Path)
+		}
+
+		if res.Passed() {
+			fmt.Fprintln(w, color.Colorize(fmt.Sprintf("%sPASS%s  %s", colors.SpecInfo, colors.Reset, f.Name)))
+			continue
+		}
+
+		failed++
+		fmt.Fprintln(w, color.Colorize(fmt.Sprintf("%sFAIL%s  %s (%s)", colors.SpecError, colors.Reset, f.Name, f.Path)))
+		for _, failure := range res.Failures {
+			fmt.Fprintf(w, "    %s\n", failure)
+		}
+	}
+
+	fmt.Fprintf(w, "\n%d passed, %d failed\n", len(fixtures)-failed, failed)
+	return failed, nil
+}
@@ -32,7 +32,7 @@ func newPolicyValidateCmd() *cobra.Command {
 		Short: "Validate a Policy Pack configuration",
 		Long:  "Validate a Policy Pack configuration against the configuration schema of the specified version.",
 		Run: cmdutil.RunFunc(func(cmd *cobra.Command, cliArgs []string) error {
-			// Obtain current PolicyPack, tied to the Pulumi service backend.
+			// Obtain current PolicyPack, tied to the current backend.
 			policyPack, err := requirePolicyPack(cliArgs[0])
 			if err != nil {
 				return err
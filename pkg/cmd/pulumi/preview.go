@@ -43,6 +43,7 @@ func newPreviewCmd() *cobra.Command {
 	var policyPackConfigPaths []string
 	var diffDisplay bool
 	var eventLogPath string
+	var policyReport string
 	var parallel int
 	var refresh bool
 	var showConfig bool
@@ -55,6 +56,7 @@ func newPreviewCmd() *cobra.Command {
 	var replaces []string
 	var targetReplaces []string
 	var targetDependents bool
+	var lockedPlugins bool
 
 	var cmd = &cobra.Command{
 		Use:        "preview",
@@ -74,6 +76,11 @@ func newPreviewCmd() *cobra.Command {
 			"`--cwd` flag to use a different directory.",
 		Args: cmdutil.NoArgs,
 		Run: cmdutil.RunResultFunc(func(cmd *cobra.Command, args []string) result.Result {
+			policyReportPath, err := parsePolicyReport(policyReport)
+			if err != nil {
+				return result.FromError(err)
+			}
+
 			var displayType = display.DisplayProgress
 			if diffDisplay {
 				displayType = display.DisplayDiff
@@ -90,6 +97,7 @@ func newPreviewCmd() *cobra.Command {
 				Type:                 displayType,
 				JSONDisplay:          jsonDisplay,
 				EventLogPath:         eventLogPath,
+				PolicyReportPath:     policyReportPath,
 				Debug:                debug,
 			}
 
@@ -160,9 +168,15 @@ func newPreviewCmd() *cobra.Command {
 				replaceURNs = append(replaceURNs, resource.URN(tr))
 			}
 
+			exemptions, err := getPolicyExemptions(proj, s)
+			if err != nil {
+				return result.FromError(err)
+			}
+
 			opts := backend.UpdateOptions{
 				Engine: engine.UpdateOptions{
 					LocalPolicyPacks:          engine.MakeLocalPolicyPacks(policyPackPaths, policyPackConfigPaths),
+					PolicyExemptions:          exemptions,
 					Parallel:                  parallel,
 					Debug:                     debug,
 					Refresh:                   refresh,
@@ -172,6 +186,7 @@ func newPreviewCmd() *cobra.Command {
 					DisableResourceReferences: disableResourceReferences(),
 					UpdateTargets:             targetURNs,
 					TargetDependents:          targetDependents,
+					LockedPlugins:             lockedPlugins,
 				},
 				Display: displayOpts,
 			}
@@ -238,6 +253,9 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&targetDependents, "target-dependents", false,
 		"Allows updating of dependent targets discovered but not specified in --target list")
+	cmd.PersistentFlags().BoolVar(
+		&lockedPlugins, "locked", false,
+		"Fail if the plugins the program requires do not match the project's Pulumi.lock file")
 
 	// Flags for engine.UpdateOptions.
 	cmd.PersistentFlags().StringSliceVar(
@@ -246,6 +264,9 @@ func newPreviewCmd() *cobra.Command {
 	cmd.PersistentFlags().StringSliceVar(
 		&policyPackConfigPaths, "policy-pack-config", []string{},
 		`Path to JSON file containing the config for the policy pack of the corresponding "--policy-pack" flag`)
+	cmd.PersistentFlags().StringVar(
+		&policyReport, "policy-report", "",
+		"Write a report of policy violations to a file, given as <format>=<path>. The only supported format is sarif")
 	cmd.PersistentFlags().BoolVar(
 		&diffDisplay, "diff", false,
 		"Display operation as a rich diff showing the overall change")
@@ -201,11 +201,13 @@ func NewPulumiCmd() *cobra.Command {
 	//     - Advanced Commands:
 	cmd.AddCommand(newCancelCmd())
 	cmd.AddCommand(newImportCmd())
+	cmd.AddCommand(newConvertCmd())
 	cmd.AddCommand(newRefreshCmd())
 	cmd.AddCommand(newStateCmd())
 	//     - Other Commands:
 	cmd.AddCommand(newLogsCmd())
 	cmd.AddCommand(newPluginCmd())
+	cmd.AddCommand(newPackageCmd())
 	cmd.AddCommand(newVersionCmd())
 	cmd.AddCommand(newConsoleCmd())
 
@@ -20,6 +20,7 @@ import (
 	"io/ioutil"
 	"math"
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi/pkg/v3/backend"
@@ -59,6 +60,7 @@ func newUpCmd() *cobra.Command {
 	var policyPackConfigPaths []string
 	var diffDisplay bool
 	var eventLogPath string
+	var policyReport string
 	var parallel int
 	var refresh bool
 	var showConfig bool
@@ -74,6 +76,7 @@ func newUpCmd() *cobra.Command {
 	var replaces []string
 	var targetReplaces []string
 	var targetDependents bool
+	var lockedPlugins bool
 
 	// up implementation used when the source of the Pulumi program is in the current working directory.
 	upWorkingDirectory := func(opts backend.UpdateOptions) result.Result {
@@ -122,8 +125,14 @@ func newUpCmd() *cobra.Command {
 			replaceURNs = append(replaceURNs, resource.URN(tr))
 		}
 
+		exemptions, err := getPolicyExemptions(proj, s)
+		if err != nil {
+			return result.FromError(err)
+		}
+
 		opts.Engine = engine.UpdateOptions{
 			LocalPolicyPacks:          engine.MakeLocalPolicyPacks(policyPackPaths, policyPackConfigPaths),
+			PolicyExemptions:          exemptions,
 			Parallel:                  parallel,
 			Debug:                     debug,
 			Refresh:                   refresh,
@@ -134,6 +143,7 @@ func newUpCmd() *cobra.Command {
 			DisableResourceReferences: disableResourceReferences(),
 			UpdateTargets:             targetURNs,
 			TargetDependents:          targetDependents,
+			LockedPlugins:             lockedPlugins,
 		}
 
 		changes, res := s.Update(commandContext(), backend.UpdateOperation{
@@ -286,11 +296,18 @@ func newUpCmd() *cobra.Command {
 			return result.FromError(errors.Wrap(err, "getting stack configuration"))
 		}
 
+		exemptions, err := getPolicyExemptions(proj, s)
+		if err != nil {
+			return result.FromError(err)
+		}
+
 		opts.Engine = engine.UpdateOptions{
 			LocalPolicyPacks: engine.MakeLocalPolicyPacks(policyPackPaths, policyPackConfigPaths),
+			PolicyExemptions: exemptions,
 			Parallel:         parallel,
 			Debug:            debug,
 			Refresh:          refresh,
+			LockedPlugins:    lockedPlugins,
 		}
 
 		// TODO for the URL case:
@@ -353,6 +370,11 @@ func newUpCmd() *cobra.Command {
 				return result.FromError(err)
 			}
 
+			policyReportPath, err := parsePolicyReport(policyReport)
+			if err != nil {
+				return result.FromError(err)
+			}
+
 			var displayType = display.DisplayProgress
 			if diffDisplay {
 				displayType = display.DisplayDiff
@@ -368,6 +390,7 @@ func newUpCmd() *cobra.Command {
 				IsInteractive:        interactive,
 				Type:                 displayType,
 				EventLogPath:         eventLogPath,
+				PolicyReportPath:     policyReportPath,
 				Debug:                debug,
 			}
 
@@ -443,6 +466,9 @@ func newUpCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(
 		&targetDependents, "target-dependents", false,
 		"Allows updating of dependent targets discovered but not specified in --target list")
+	cmd.PersistentFlags().BoolVar(
+		&lockedPlugins, "locked", false,
+		"Fail if the plugins the program requires do not match the project's Pulumi.lock file")
 
 	// Flags for engine.UpdateOptions.
 	cmd.PersistentFlags().StringSliceVar(
@@ -451,6 +477,9 @@ func newUpCmd() *cobra.Command {
 	cmd.PersistentFlags().StringSliceVar(
 		&policyPackConfigPaths, "policy-pack-config", []string{},
 		`Path to JSON file containing the config for the policy pack of the corresponding "--policy-pack" flag`)
+	cmd.PersistentFlags().StringVar(
+		&policyReport, "policy-report", "",
+		"Write a report of policy violations to a file, given as <format>=<path>. The only supported format is sarif")
 	cmd.PersistentFlags().BoolVar(
 		&diffDisplay, "diff", false,
 		"Display operation as a rich diff showing the overall change")
@@ -522,6 +551,22 @@ func validatePolicyPackConfig(policyPackPaths []string, policyPackConfigPaths []
 	return nil
 }
 
+// parsePolicyReport parses the value of the `--policy-report` flag, which has the form `<format>=<path>`, and returns
+// the path to which to write the report. SARIF is currently the only supported format.
+func parsePolicyReport(policyReport string) (string, error) {
+	if policyReport == "" {
+		return "", nil
+	}
+	parts := strings.SplitN(policyReport, "=", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", errors.Errorf("invalid policy report %q; expected <format>=<path>", policyReport)
+	}
+	if parts[0] != "sarif" {
+		return "", errors.Errorf("unsupported policy report format %q; expected sarif", parts[0])
+	}
+	return parts[1], nil
+}
+
 // handleConfig handles prompting for config values (as needed) and saving config.
 func handleConfig(
 	s backend.Stack,
@@ -85,3 +85,19 @@ func TestValidatePolicyPackConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePolicyReport(t *testing.T) {
+	path, err := parsePolicyReport("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", path)
+
+	path, err = parsePolicyReport("sarif=out/policy.sarif")
+	assert.NoError(t, err)
+	assert.Equal(t, "out/policy.sarif", path)
+
+	_, err = parsePolicyReport("policy.sarif")
+	assert.EqualError(t, err, `invalid policy report "policy.sarif"; expected <format>=<path>`)
+
+	_, err = parsePolicyReport("junit=policy.xml")
+	assert.EqualError(t, err, `unsupported policy report format "junit"; expected sarif`)
+}
@@ -450,7 +450,11 @@ func readProject() (*workspace.Project, string, error) {
 	if err != nil {
 		return nil, "", err
 	}
+	return readProjectFrom(pwd)
+}
 
+// readProjectFrom attempts to detect and read a Pulumi project, searching upwards from the given directory.
+func readProjectFrom(pwd string) (*workspace.Project, string, error) {
 	// Now that we got here, we have a path, so we will try to load it.
 	path, err := workspace.DetectProjectPathFrom(pwd)
 	if err != nil {
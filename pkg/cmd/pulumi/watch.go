@@ -113,8 +113,14 @@ func newWatchCmd() *cobra.Command {
 				return result.FromError(errors.Wrap(err, "getting stack configuration"))
 			}
 
+			exemptions, err := getPolicyExemptions(proj, s)
+			if err != nil {
+				return result.FromError(err)
+			}
+
 			opts.Engine = engine.UpdateOptions{
 				LocalPolicyPacks:          engine.MakeLocalPolicyPacks(policyPackPaths, policyPackConfigPaths),
+				PolicyExemptions:          exemptions,
 				Parallel:                  parallel,
 				Debug:                     debug,
 				Refresh:                   refresh,
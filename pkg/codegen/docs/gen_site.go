@@ -0,0 +1,865 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint: lll, goconst
+package docs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pgavlin/goldmark"
+	"github.com/pgavlin/goldmark/extension"
+	"github.com/pgavlin/goldmark/parser"
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// SiteFormat is the output format of a standalone documentation site.
+type SiteFormat string
+
+const (
+	// MarkdownSite renders each page of the site as plain Markdown.
+	MarkdownSite SiteFormat = "markdown"
+	// HTMLSite renders each page of the site as a standalone HTML document.
+	HTMLSite SiteFormat = "html"
+)
+
+// siteLanguages lists the languages whose signatures are shown on each page, in order.
+var siteLanguages = []struct {
+	lang    string // The language name used by the doc helpers.
+	fence   string // The info string for fenced code blocks.
+	display string // The display name of the language.
+}{
+	{"nodejs", "typescript", "TypeScript"},
+	{"python", "python", "Python"},
+	{"go", "go", "Go"},
+	{"csharp", "csharp", "C#"},
+}
+
+// siteExampleLanguages lists the languages of example snippets, in order, along with their display names.
+var siteExampleLanguages = []struct {
+	lang    string
+	display string
+}{
+	{"typescript", "TypeScript"},
+	{"python", "Python"},
+	{"go", "Go"},
+	{"csharp", "C#"},
+}
+
+// siteMarkdown renders the Markdown pages of an HTML site.
+var siteMarkdown = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithParserOptions(parser.WithAutoHeadingID()))
+
+// searchIndexEntry is an entry in the search index of a standalone documentation site.
+type searchIndexEntry struct {
+	Title   string `json:"title"`
+	Kind    string `json:"kind"`
+	Token   string `json:"token,omitempty"`
+	Path    string `json:"path"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// sitePage is a page of a standalone documentation site.
+type sitePage struct {
+	title    string
+	markdown string
+}
+
+type siteGenerator struct {
+	pkg *schema.Package
+	ext string
+
+	pages map[string]sitePage
+	index []searchIndexEntry
+
+	// paths records the lowercased path of every page, so that no two pages differ only in case.
+	paths map[string]bool
+	// resourcePages maps each resource in the package to the path of its page.
+	resourcePages map[*schema.Resource]string
+	// functionPages maps each function in the package to the path of its page.
+	functionPages map[*schema.Function]string
+	// typePages maps the token of each type in the package to the path of the first page that documents it.
+	typePages map[string]string
+}
+
+// GenerateSite generates a standalone documentation site for the given package. Unlike GeneratePackage, which emits
+// pages for the Pulumi registry's Hugo templates, the site consists of plain Markdown or HTML pages that can be
+// published by any static site host: an index page for the package and each of its modules, a page for each resource
+// and function with its per-language signatures, examples, properties and supporting types, and a search index
+// (search-index.json) that lists every page and type. HTML sites also include a search page (search.html).
+func GenerateSite(tool string, pkg *schema.Package, format SiteFormat) (map[string][]byte, error) {
+	var ext string
+	switch format {
+	case MarkdownSite:
+		ext = ".md"
+	case HTMLSite:
+		ext = ".html"
+	default:
+		return nil, errors.Errorf("unsupported site format %q; expected markdown or html", format)
+	}
+
+	modules := generateModulesFromSchemaPackage(tool, pkg)
+	modNames := make([]string, 0, len(modules))
+	for name, mod := range modules {
+		modNames = append(modNames, name)
+		sortModuleMembers(mod)
+	}
+	sort.Strings(modNames)
+
+	g := &siteGenerator{
+		pkg:           pkg,
+		ext:           ext,
+		pages:         map[string]sitePage{},
+		paths:         map[string]bool{},
+		resourcePages: map[*schema.Resource]string{},
+		functionPages: map[*schema.Function]string{},
+		typePages:     map[string]string{},
+	}
+	for _, name := range modNames {
+		g.uniquePath(g.modulePath(modules[name]))
+	}
+	for _, name := range modNames {
+		mod := modules[name]
+		for _, r := range mod.resources {
+			g.resourcePages[r] = g.uniquePath(g.resourcePath(mod, r))
+		}
+		for _, f := range mod.functions {
+			g.functionPages[f] = g.uniquePath(g.functionPath(mod, f))
+		}
+	}
+	for _, name := range modNames {
+		g.genModule(modules[name])
+	}
+
+	files := fs{}
+	for p, page := range g.pages {
+		switch format {
+		case MarkdownSite:
+			files.add(p, []byte(page.markdown))
+		case HTMLSite:
+			contents, err := g.renderHTML(p, page)
+			if err != nil {
+				return nil, errors.Wrapf(err, "rendering %s", p)
+			}
+			files.add(p, contents)
+		}
+	}
+
+	index, err := json.MarshalIndent(g.index, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files.add("search-index.json", append(index, '\n'))
+	if format == HTMLSite {
+		files.add("search.html", g.renderSearchPage())
+	}
+	return files, nil
+}
+
+// sortModuleMembers sorts the resources, functions and children of a module so that output is deterministic. Methods
+// are removed from the functions, as they are documented on the pages of their resources.
+func sortModuleMembers(mod *modContext) {
+	functions := mod.functions[:0]
+	for _, f := range mod.functions {
+		if !f.IsMethod {
+			functions = append(functions, f)
+		}
+	}
+	mod.functions = functions
+
+	sort.Slice(mod.resources, func(i, j int) bool {
+		return resourceName(mod.resources[i]) < resourceName(mod.resources[j])
+	})
+	sort.Slice(mod.functions, func(i, j int) bool {
+		return tokenToName(mod.functions[i].Token) < tokenToName(mod.functions[j].Token)
+	})
+	sort.Slice(mod.children, func(i, j int) bool {
+		return mod.children[i].getModuleFileName() < mod.children[j].getModuleFileName()
+	})
+}
+
+func (g *siteGenerator) modulePath(mod *modContext) string {
+	return path.Join(mod.getModuleFileName(), "index"+g.ext)
+}
+
+func (g *siteGenerator) resourcePath(mod *modContext, r *schema.Resource) string {
+	return path.Join(mod.getModuleFileName(), strings.ToLower(resourceName(r))+g.ext)
+}
+
+func (g *siteGenerator) functionPath(mod *modContext, f *schema.Function) string {
+	return path.Join(mod.getModuleFileName(), strings.ToLower(tokenToName(f.Token))+g.ext)
+}
+
+// uniquePath returns the given page path, or, if another page's path differs from it only in case, the path with a
+// numeric suffix that distinguishes it, so that the site can be published to case-insensitive file systems.
+func (g *siteGenerator) uniquePath(p string) string {
+	base := strings.TrimSuffix(p, g.ext)
+	for i := 2; g.paths[strings.ToLower(p)]; i++ {
+		p = fmt.Sprintf("%s-%d%s", base, i, g.ext)
+	}
+	g.paths[strings.ToLower(p)] = true
+	return p
+}
+
+func (g *siteGenerator) addPage(p, title, markdown string) {
+	_, has := g.pages[p]
+	contract.Assertf(!has, "duplicate page: %s", p)
+	g.pages[p] = sitePage{title: title, markdown: markdown}
+}
+
+// genModule generates the index page for a module and the pages for each of its resources and functions.
+func (g *siteGenerator) genModule(mod *modContext) {
+	p := g.modulePath(mod)
+
+	title, kind := mod.getModuleFileName(), "module"
+	if mod.mod == "" {
+		title, kind = formatTitleText(g.pkg.Name), "package"
+	}
+
+	w := &bytes.Buffer{}
+	fmt.Fprintf(w, "# %s\n\n", title)
+	if mod.mod == "" && g.pkg.Description != "" {
+		fmt.Fprintf(w, "%s\n\n", strings.TrimSpace(g.pkg.Description))
+	}
+
+	if len(mod.children) > 0 {
+		fmt.Fprintf(w, "## Modules\n\n")
+		for _, child := range mod.children {
+			parts := strings.Split(child.getModuleFileName(), "/")
+			fmt.Fprintf(w, "- [%s](%s)\n", parts[len(parts)-1], relativeLink(p, g.modulePath(child)))
+		}
+		fmt.Fprintf(w, "\n")
+	}
+
+	if len(mod.resources) > 0 {
+		fmt.Fprintf(w, "## Resources\n\n")
+		for _, r := range mod.resources {
+			g.writeIndexEntry(w, resourceName(r), relativeLink(p, g.resourcePages[r]), r.Comment)
+		}
+		fmt.Fprintf(w, "\n")
+	}
+
+	if len(mod.functions) > 0 {
+		fmt.Fprintf(w, "## Functions\n\n")
+		for _, f := range mod.functions {
+			g.writeIndexEntry(w, tokenToName(f.Token), relativeLink(p, g.functionPages[f]), f.Comment)
+		}
+		fmt.Fprintf(w, "\n")
+	}
+
+	if mod.mod == "" {
+		var details []string
+		if g.pkg.Repository != "" {
+			details = append(details, fmt.Sprintf("- **Repository:** %s", g.pkg.Repository))
+		}
+		if g.pkg.License != "" {
+			details = append(details, fmt.Sprintf("- **License:** %s", g.pkg.License))
+		}
+		if g.pkg.Version != nil {
+			details = append(details, fmt.Sprintf("- **Version:** %s", g.pkg.Version))
+		}
+		if g.pkg.Attribution != "" {
+			details = append(details, fmt.Sprintf("- **Notes:** %s", g.pkg.Attribution))
+		}
+		if len(details) > 0 {
+			fmt.Fprintf(w, "## Package Details\n\n%s\n\n", strings.Join(details, "\n"))
+		}
+	}
+
+	g.addPage(p, title, w.String())
+	entry := searchIndexEntry{Title: title, Kind: kind, Path: p}
+	if mod.mod == "" {
+		entry.Summary = summarizeDescription(g.pkg.Description)
+	}
+	g.index = append(g.index, entry)
+
+	for _, r := range mod.resources {
+		g.genResourcePage(mod, r)
+	}
+	for _, f := range mod.functions {
+		g.genFunctionPage(mod, f)
+	}
+}
+
+// writeIndexEntry writes a list item that links to a member of a module, followed by a summary of its description.
+func (g *siteGenerator) writeIndexEntry(w io.Writer, name, link, comment string) {
+	fmt.Fprintf(w, "- [%s](%s)", name, link)
+	if summary := summarizeDescription(decomposeDocstring(comment).description); summary != "" {
+		fmt.Fprintf(w, ": %s", summary)
+	}
+	fmt.Fprintf(w, "\n")
+}
+
+// genResourcePage generates the page for a resource.
+func (g *siteGenerator) genResourcePage(mod *modContext, r *schema.Resource) {
+	p := g.resourcePages[r]
+	name := resourceName(r)
+	docInfo := decomposeDocstring(r.Comment)
+
+	w := &bytes.Buffer{}
+	fmt.Fprintf(w, "# %s\n\n", name)
+	fmt.Fprintf(w, "**Token:** `%s`\n\n", r.Token)
+	writeDeprecationMessage(w, r.DeprecationMessage)
+	if description := strings.TrimSpace(docInfo.description); description != "" {
+		fmt.Fprintf(w, "%s\n\n", description)
+	}
+	writeExamples(w, docInfo.examples)
+
+	allOptionalInputs := true
+	for _, prop := range r.InputProperties {
+		if prop.IsRequired {
+			allOptionalInputs = false
+			break
+		}
+	}
+
+	fmt.Fprintf(w, "## Create a %s Resource\n\n", name)
+	for _, l := range siteLanguages {
+		var signature string
+		switch l.lang {
+		case "nodejs":
+			params := formatSiteParams(l.lang, mod.genConstructorTS(r, allOptionalInputs), "")
+			signature = fmt.Sprintf("new %s(%s);", name, params)
+		case "python":
+			def := fmt.Sprintf("def %s(", name)
+			indent := strings.Repeat(" ", len(def))
+			signature = fmt.Sprintf("@overload\n%s%s)\n@overload\n%s%s)", def,
+				formatSiteParams(l.lang, mod.genConstructorPython(r, allOptionalInputs, false), indent), def,
+				formatSiteParams(l.lang, mod.genConstructorPython(r, allOptionalInputs, true), indent))
+		case "go":
+			params := formatSiteParams(l.lang, mod.genConstructorGo(r, allOptionalInputs), "")
+			signature = fmt.Sprintf("func New%s(%s) (*%s, error)", name, params, name)
+		case "csharp":
+			params := formatSiteParams(l.lang, mod.genConstructorCS(r, allOptionalInputs), "")
+			signature = fmt.Sprintf("public %s(%s)", name, params)
+		}
+		fmt.Fprintf(w, "**%s**\n\n```%s\n%s\n```\n\n", l.display, l.fence, signature)
+	}
+
+	fmt.Fprintf(w, "## Inputs\n\n")
+	if len(r.InputProperties) == 0 {
+		fmt.Fprintf(w, "This resource has no input properties.\n\n")
+	} else {
+		g.writeProperties(w, p, r.InputProperties)
+	}
+
+	// Provider resources do not have output properties, so there won't be anything to filter.
+	var outputProps []*schema.Property
+	if !r.IsProvider {
+		outputProps = filterOutputProperties(r.InputProperties, r.Properties)
+	}
+	// All resources have an implicit `id` output property.
+	outputProps = append(outputProps, &schema.Property{
+		Name:       "id",
+		Comment:    "The provider-assigned unique ID for this managed resource.",
+		Type:       schema.StringType,
+		IsRequired: true,
+	})
+
+	fmt.Fprintf(w, "## Outputs\n\n")
+	fmt.Fprintf(w, "All input properties are implicitly available as output properties. Additionally, the %s resource "+
+		"produces the following output properties:\n\n", name)
+	g.writeProperties(w, p, outputProps)
+
+	if len(r.Methods) > 0 {
+		fmt.Fprintf(w, "## Methods\n\n")
+		for _, m := range r.Methods {
+			fmt.Fprintf(w, "- `%s`", m.Name)
+			if summary := summarizeDescription(decomposeDocstring(m.Function.Comment).description); summary != "" {
+				fmt.Fprintf(w, ": %s", summary)
+			}
+			fmt.Fprintf(w, "\n")
+		}
+		fmt.Fprintf(w, "\n")
+	}
+
+	if importDetails := strings.TrimSpace(docInfo.importDetails); importDetails != "" {
+		fmt.Fprintf(w, "## Import\n\n%s\n\n", importDetails)
+	}
+
+	g.writeSupportingTypes(w, p, r.InputProperties, r.Properties)
+
+	g.addPage(p, name, w.String())
+	g.index = append(g.index, searchIndexEntry{
+		Title:   name,
+		Kind:    "resource",
+		Token:   r.Token,
+		Path:    p,
+		Summary: summarizeDescription(docInfo.description),
+	})
+}
+
+// genFunctionPage generates the page for a function.
+func (g *siteGenerator) genFunctionPage(mod *modContext, f *schema.Function) {
+	p := g.functionPages[f]
+	name := tokenToName(f.Token)
+	docInfo := decomposeDocstring(f.Comment)
+
+	w := &bytes.Buffer{}
+	fmt.Fprintf(w, "# %s\n\n", name)
+	fmt.Fprintf(w, "**Token:** `%s`\n\n", f.Token)
+	writeDeprecationMessage(w, f.DeprecationMessage)
+	if description := strings.TrimSpace(docInfo.description); description != "" {
+		fmt.Fprintf(w, "%s\n\n", description)
+	}
+	writeExamples(w, docInfo.examples)
+
+	results := mod.getFunctionResourceInfo(f)
+
+	fmt.Fprintf(w, "## Using %s\n\n", name)
+	for _, l := range siteLanguages {
+		funcName := getLanguageDocHelper(l.lang).GetFunctionName(mod.mod, f)
+		result := results[l.lang].DisplayName
+
+		var signature string
+		switch l.lang {
+		case "nodejs":
+			if f.Outputs == nil {
+				result = "void"
+			}
+			params := formatSiteParams(l.lang, mod.genFunctionTS(f, funcName), "")
+			signature = fmt.Sprintf("function %s(%s): Promise<%s>", funcName, params, result)
+		case "python":
+			if f.Outputs == nil {
+				result = "None"
+			}
+			def := fmt.Sprintf("def %s(", funcName)
+			params := formatSiteParams(l.lang, mod.genFunctionPython(f, funcName), strings.Repeat(" ", len(def)))
+			signature = fmt.Sprintf("%s%s) -> %s", def, params, result)
+		case "go":
+			returns := "error"
+			if f.Outputs != nil {
+				returns = fmt.Sprintf("(*%s, error)", result)
+			}
+			params := formatSiteParams(l.lang, mod.genFunctionGo(f, funcName), "")
+			signature = fmt.Sprintf("func %s(%s) %s", funcName, params, returns)
+		case "csharp":
+			task := "Task"
+			if f.Outputs != nil {
+				task = fmt.Sprintf("Task<%s>", result)
+			}
+			params := formatSiteParams(l.lang, mod.genFunctionCS(f, funcName), "")
+			signature = fmt.Sprintf("public static class %s\n{\n    public static %s InvokeAsync(%s)\n}", funcName, task,
+				params)
+		}
+		fmt.Fprintf(w, "**%s**\n\n```%s\n%s\n```\n\n", l.display, l.fence, signature)
+	}
+
+	var inputProps, outputProps []*schema.Property
+	if f.Inputs != nil {
+		inputProps = f.Inputs.Properties
+	}
+	if f.Outputs != nil {
+		outputProps = f.Outputs.Properties
+	}
+
+	fmt.Fprintf(w, "## Inputs\n\n")
+	if len(inputProps) == 0 {
+		fmt.Fprintf(w, "This function has no input properties.\n\n")
+	} else {
+		g.writeProperties(w, p, inputProps)
+	}
+
+	fmt.Fprintf(w, "## Outputs\n\n")
+	if len(outputProps) == 0 {
+		fmt.Fprintf(w, "This function has no output properties.\n\n")
+	} else {
+		g.writeProperties(w, p, outputProps)
+	}
+
+	g.writeSupportingTypes(w, p, inputProps, outputProps)
+
+	g.addPage(p, name, w.String())
+	g.index = append(g.index, searchIndexEntry{
+		Title:   name,
+		Kind:    "function",
+		Token:   f.Token,
+		Path:    p,
+		Summary: summarizeDescription(docInfo.description),
+	})
+}
+
+// formatSiteParams formats the formal parameters of a constructor or function for the given language. Python
+// parameters are placed on separate lines, each indented by the given indent.
+func formatSiteParams(lang string, params []formalParam, indent string) string {
+	formatted := make([]string, len(params))
+	for i, p := range params {
+		switch lang {
+		case "nodejs":
+			formatted[i] = fmt.Sprintf("%s%s: %s", p.Name, p.OptionalFlag, p.Type.Name)
+		case "python":
+			formatted[i] = fmt.Sprintf("%s: %s%s", p.Name, p.Type.Name, p.DefaultValue)
+		case "go":
+			formatted[i] = fmt.Sprintf("%s %s%s", p.Name, p.OptionalFlag, p.Type.Name)
+		case "csharp":
+			formatted[i] = fmt.Sprintf("%s%s %s%s", p.Type.Name, p.OptionalFlag, p.Name, p.DefaultValue)
+		}
+	}
+
+	separator := ", "
+	if lang == "python" {
+		separator = ",\n" + indent
+	}
+	return strings.Join(formatted, separator)
+}
+
+func writeDeprecationMessage(w io.Writer, message string) {
+	if message != "" {
+		fmt.Fprintf(w, "> **Deprecated:** %s\n\n", strings.TrimSpace(message))
+	}
+}
+
+// writeExamples writes the examples extracted from a resource or function's description. Languages without a
+// snippet are omitted.
+func writeExamples(w io.Writer, examples []exampleSection) {
+	var sections []string
+	for _, example := range examples {
+		b := &bytes.Buffer{}
+		// Example titles are rendered level-3 headings.
+		if title := strings.TrimSpace(strings.TrimLeft(example.Title, "#")); title != "" {
+			fmt.Fprintf(b, "### %s\n\n", title)
+		}
+		hasSnippets := false
+		for _, l := range siteExampleLanguages {
+			snippet, ok := example.Snippets[l.lang]
+			if !ok || snippet == defaultMissingExampleSnippetPlaceholder {
+				continue
+			}
+			hasSnippets = true
+			fmt.Fprintf(b, "**%s**\n\n%s\n\n", l.display, strings.TrimSpace(snippet))
+		}
+		if hasSnippets {
+			sections = append(sections, b.String())
+		}
+	}
+	if len(sections) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "## Example Usage\n\n")
+	for _, section := range sections {
+		fmt.Fprintf(w, "%s", section)
+	}
+}
+
+// writeProperties writes a list of properties along with their types and descriptions. page is the path of the page
+// the list is written to, and is used to resolve links to other pages.
+func (g *siteGenerator) writeProperties(w io.Writer, page string, props []*schema.Property) {
+	for _, prop := range props {
+		required := ""
+		if prop.IsRequired {
+			required = ", required"
+		}
+		fmt.Fprintf(w, "- **%s** (%s%s)", prop.Name, g.typeString(page, prop.Type), required)
+		writeListItemDescription(w, prop.Comment, prop.DeprecationMessage)
+	}
+	fmt.Fprintf(w, "\n")
+}
+
+// writeListItemDescription completes a list item with the given description and deprecation message, if any.
+func writeListItemDescription(w io.Writer, comment, deprecationMessage string) {
+	comment = strings.TrimSpace(comment)
+	if comment != "" {
+		lines := strings.Split(comment, "\n")
+		fmt.Fprintf(w, ": %s", lines[0])
+		for _, line := range lines[1:] {
+			if line == "" {
+				fmt.Fprintf(w, "\n")
+			} else {
+				fmt.Fprintf(w, "\n  %s", line)
+			}
+		}
+	}
+	if deprecationMessage != "" {
+		fmt.Fprintf(w, "\n\n  **Deprecated:** %s", strings.TrimSpace(deprecationMessage))
+	}
+	fmt.Fprintf(w, "\n")
+}
+
+// writeSupportingTypes writes the object and enum types of the package that are referenced, directly or indirectly,
+// by the given properties.
+func (g *siteGenerator) writeSupportingTypes(w io.Writer, page string, properties ...[]*schema.Property) {
+	seen := codegen.NewStringSet()
+	var types []schema.Type
+	for _, props := range properties {
+		codegen.VisitTypeClosure(props, func(t codegen.Type) {
+			switch t := t.Type.(type) {
+			case *schema.ObjectType:
+				if t.Package == g.pkg && !seen.Has(t.Token) {
+					seen.Add(t.Token)
+					types = append(types, t)
+				}
+			case *schema.EnumType:
+				if g.isLocalToken(t.Token) && !seen.Has(t.Token) {
+					seen.Add(t.Token)
+					types = append(types, t)
+				}
+			}
+		})
+	}
+	if len(types) == 0 {
+		return
+	}
+
+	typeToken := func(t schema.Type) string {
+		if t, ok := t.(*schema.ObjectType); ok {
+			return t.Token
+		}
+		return t.(*schema.EnumType).Token
+	}
+	sort.Slice(types, func(i, j int) bool {
+		ti, tj := typeToken(types[i]), typeToken(types[j])
+		if ni, nj := tokenToName(ti), tokenToName(tj); ni != nj {
+			return ni < nj
+		}
+		return ti < tj
+	})
+
+	fmt.Fprintf(w, "## Supporting Types\n\n")
+	for _, t := range types {
+		token := typeToken(t)
+		name := tokenToName(token)
+		fmt.Fprintf(w, "### %s\n\n", name)
+
+		switch t := t.(type) {
+		case *schema.ObjectType:
+			if comment := strings.TrimSpace(t.Comment); comment != "" {
+				fmt.Fprintf(w, "%s\n\n", comment)
+			}
+			g.writeProperties(w, page, t.Properties)
+		case *schema.EnumType:
+			if comment := strings.TrimSpace(t.Comment); comment != "" {
+				fmt.Fprintf(w, "%s\n\n", comment)
+			}
+			for _, e := range t.Elements {
+				value := fmt.Sprintf("%v", e.Value)
+				if s, ok := e.Value.(string); ok {
+					value = fmt.Sprintf("%q", s)
+				}
+				elementName := e.Name
+				if elementName == "" {
+					elementName = fmt.Sprintf("%v", e.Value)
+				}
+				fmt.Fprintf(w, "- **%s** (`%s`)", elementName, value)
+				writeListItemDescription(w, e.Comment, e.DeprecationMessage)
+			}
+			fmt.Fprintf(w, "\n")
+		}
+
+		if _, ok := g.typePages[token]; !ok {
+			anchor := page + "#" + strings.ToLower(name)
+			g.typePages[token] = anchor
+			g.index = append(g.index, searchIndexEntry{
+				Title:   name,
+				Kind:    "type",
+				Token:   token,
+				Path:    anchor,
+				Summary: summarizeDescription(typeComment(t)),
+			})
+		}
+	}
+}
+
+func typeComment(t schema.Type) string {
+	switch t := t.(type) {
+	case *schema.ObjectType:
+		return t.Comment
+	case *schema.EnumType:
+		return t.Comment
+	default:
+		return ""
+	}
+}
+
+// isLocalToken returns true if the given type token belongs to the package being documented.
+func (g *siteGenerator) isLocalToken(token string) bool {
+	return strings.SplitN(token, ":", 2)[0] == g.pkg.Name
+}
+
+// typeString returns a language-neutral description of the given type. Object and enum types link to their
+// definitions under the page's supporting types, and resource types link to the resource's page.
+func (g *siteGenerator) typeString(page string, t schema.Type) string {
+	switch t := t.(type) {
+	case *schema.ArrayType:
+		return fmt.Sprintf("List\\<%s\\>", g.typeString(page, t.ElementType))
+	case *schema.MapType:
+		return fmt.Sprintf("Map\\<%s\\>", g.typeString(page, t.ElementType))
+	case *schema.ObjectType:
+		name := tokenToName(t.Token)
+		if t.Package != g.pkg {
+			return name
+		}
+		return fmt.Sprintf("[%s](#%s)", name, strings.ToLower(name))
+	case *schema.EnumType:
+		name := tokenToName(t.Token)
+		if !g.isLocalToken(t.Token) {
+			return name
+		}
+		return fmt.Sprintf("[%s](#%s)", name, strings.ToLower(name))
+	case *schema.ResourceType:
+		if t.Resource != nil {
+			if target, ok := g.resourcePages[t.Resource]; ok {
+				return fmt.Sprintf("[%s](%s)", resourceName(t.Resource), relativeLink(page, target))
+			}
+		}
+		return tokenToName(t.Token)
+	case *schema.UnionType:
+		elements := make([]string, len(t.ElementTypes))
+		for i, e := range t.ElementTypes {
+			elements[i] = g.typeString(page, e)
+		}
+		return strings.Join(elements, " | ")
+	case *schema.TokenType:
+		if t.UnderlyingType != nil {
+			return g.typeString(page, t.UnderlyingType)
+		}
+		return tokenToName(t.Token)
+	}
+
+	switch t {
+	case schema.ArchiveType:
+		return "Archive"
+	case schema.AssetType:
+		return "Asset"
+	case schema.JSONType:
+		return "Json"
+	case schema.AnyType:
+		return "any"
+	default:
+		return t.String()
+	}
+}
+
+// summarizeDescription returns the first paragraph of the given description on a single line.
+func summarizeDescription(description string) string {
+	description = strings.TrimSpace(description)
+	if i := strings.Index(description, "\n\n"); i != -1 {
+		description = description[:i]
+	}
+	return strings.Join(strings.Fields(description), " ")
+}
+
+// relativeLink returns the link from the page at the slash-separated path from to the page at the slash-separated
+// path to. Both paths are relative to the root of the site.
+func relativeLink(from, to string) string {
+	fromDir := strings.Split(path.Dir(from), "/")
+	if fromDir[0] == "." {
+		fromDir = nil
+	}
+	toParts := strings.Split(to, "/")
+
+	common := 0
+	for common < len(fromDir) && common < len(toParts)-1 && fromDir[common] == toParts[common] {
+		common++
+	}
+
+	var parts []string
+	for range fromDir[common:] {
+		parts = append(parts, "..")
+	}
+	parts = append(parts, toParts[common:]...)
+	return strings.Join(parts, "/")
+}
+
+// renderHTML renders a page of the site as a standalone HTML document.
+func (g *siteGenerator) renderHTML(p string, page sitePage) ([]byte, error) {
+	var body bytes.Buffer
+	if err := siteMarkdown.Convert([]byte(page.markdown), &body); err != nil {
+		return nil, err
+	}
+
+	w := &bytes.Buffer{}
+	g.writeHTMLHeader(w, p, page.title)
+	fmt.Fprintf(w, "%s", body.String())
+	g.writeHTMLFooter(w)
+	return w.Bytes(), nil
+}
+
+// renderSearchPage renders the search page of an HTML site. The search index is embedded in the page so that the
+// site can be browsed without a web server.
+func (g *siteGenerator) renderSearchPage() []byte {
+	// json.Marshal escapes HTML characters, so the index can be safely embedded in a script element.
+	index, err := json.Marshal(g.index)
+	if err != nil {
+		panic(err)
+	}
+
+	w := &bytes.Buffer{}
+	g.writeHTMLHeader(w, "search.html", "Search")
+	fmt.Fprintf(w, "<h1>Search</h1>\n")
+	fmt.Fprintf(w, "<input id=\"query\" type=\"search\" placeholder=\"Search resources, functions and types\" autofocus>\n")
+	fmt.Fprintf(w, "<ul id=\"results\"></ul>\n")
+	fmt.Fprintf(w, "<script>\n")
+	fmt.Fprintf(w, "const index = %s;\n", index)
+	fmt.Fprintf(w, "%s", searchScript)
+	fmt.Fprintf(w, "</script>\n")
+	g.writeHTMLFooter(w)
+	return w.Bytes()
+}
+
+const searchScript = `const query = document.getElementById("query");
+const results = document.getElementById("results");
+query.addEventListener("input", () => {
+    const q = query.value.trim().toLowerCase();
+    results.textContent = "";
+    if (q === "") {
+        return;
+    }
+    for (const entry of index) {
+        const text = [entry.title, entry.token, entry.summary].join(" ").toLowerCase();
+        if (!text.includes(q)) {
+            continue;
+        }
+        const item = document.createElement("li");
+        const link = document.createElement("a");
+        link.href = entry.path;
+        link.textContent = entry.title;
+        item.appendChild(link);
+        item.appendChild(document.createTextNode(" (" + entry.kind + ")" + (entry.summary ? ": " + entry.summary : "")));
+        results.appendChild(item);
+    }
+});
+`
+
+func (g *siteGenerator) writeHTMLHeader(w io.Writer, p, title string) {
+	pkgTitle := formatTitleText(g.pkg.Name)
+	fmt.Fprintf(w, "<!DOCTYPE html>\n")
+	fmt.Fprintf(w, "<html lang=\"en\">\n")
+	fmt.Fprintf(w, "<head>\n")
+	fmt.Fprintf(w, "<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(w, "<title>%s | %s</title>\n", html.EscapeString(title), html.EscapeString(pkgTitle))
+	fmt.Fprintf(w, "</head>\n")
+	fmt.Fprintf(w, "<body>\n")
+	fmt.Fprintf(w, "<nav><a href=\"%s\">%s</a> | <a href=\"%s\">Search</a></nav>\n",
+		relativeLink(p, "index.html"), html.EscapeString(pkgTitle), relativeLink(p, "search.html"))
+	fmt.Fprintf(w, "<main>\n")
+}
+
+func (g *siteGenerator) writeHTMLFooter(w io.Writer) {
+	fmt.Fprintf(w, "</main>\n")
+	fmt.Fprintf(w, "</body>\n")
+	fmt.Fprintf(w, "</html>\n")
+}
@@ -0,0 +1,250 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint: lll, goconst
+package docs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+func siteTestPackage(t *testing.T) *schema.Package {
+	spec := schema.PackageSpec{
+		Name:        "site",
+		Version:     "1.2.3",
+		Description: "A package used to test standalone docs.\n\nIt has a second paragraph.",
+		Repository:  "https://example.com/site",
+		Meta: &schema.MetadataSpec{
+			ModuleFormat: "(.*)",
+		},
+		Types: map[string]schema.ComplexTypeSpec{
+			"site:storage:Endpoint": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "An endpoint.",
+					Type:        "object",
+					Properties: map[string]schema.PropertySpec{
+						"host": {Description: "The host name.", TypeSpec: schema.TypeSpec{Type: "string"}},
+						"tier": {TypeSpec: schema.TypeSpec{Ref: "#/types/site:storage:Tier"}},
+					},
+					Required: []string{"host"},
+				},
+			},
+			"site:storage:Tier": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "The tier of an endpoint.",
+					Type:        "string",
+				},
+				Enum: []*schema.EnumValueSpec{
+					{Name: "Hot", Value: "hot", Description: "Frequently accessed."},
+					{Name: "Cold", Value: "cold"},
+				},
+			},
+		},
+		Resources: map[string]schema.ResourceSpec{
+			"site:storage:Bucket": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "A bucket.\n\n{{% examples %}}\n## Example Usage\n{{% example %}}\n### Basic\n\n" +
+						"```typescript\nconst bucket = new site.storage.Bucket(\"b\");\n```\n{{% /example %}}\n{{% /examples %}}\n",
+					Properties: map[string]schema.PropertySpec{
+						"endpoints": {
+							Description: "The bucket's endpoints.",
+							TypeSpec: schema.TypeSpec{
+								Type:  "array",
+								Items: &schema.TypeSpec{Ref: "#/types/site:storage:Endpoint"},
+							},
+						},
+						"size": {Description: "The size of the bucket.", TypeSpec: schema.TypeSpec{Type: "integer"}},
+					},
+				},
+				InputProperties: map[string]schema.PropertySpec{
+					"size": {Description: "The size of the bucket.", TypeSpec: schema.TypeSpec{Type: "integer"}},
+				},
+				RequiredInputs: []string{"size"},
+			},
+			"site:index:Owner": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Description: "The owner of buckets.",
+					Properties: map[string]schema.PropertySpec{
+						"bucket": {TypeSpec: schema.TypeSpec{Ref: "#/resources/site:storage:Bucket"}},
+					},
+				},
+			},
+		},
+		Functions: map[string]schema.FunctionSpec{
+			"site:storage:getBucket": {
+				Description: "Looks up a bucket.",
+				Inputs: &schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"name": {TypeSpec: schema.TypeSpec{Type: "string"}},
+					},
+					Required: []string{"name"},
+				},
+				Outputs: &schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"size": {TypeSpec: schema.TypeSpec{Type: "integer"}},
+					},
+				},
+			},
+		},
+	}
+
+	pkg, err := schema.ImportSpec(spec, nil)
+	require.NoError(t, err)
+	return pkg
+}
+
+func TestGenerateMarkdownSite(t *testing.T) {
+	files, err := GenerateSite(unitTestTool, siteTestPackage(t), MarkdownSite)
+	require.NoError(t, err)
+
+	for _, p := range []string{
+		"index.md", "owner.md", "provider.md", "storage/index.md", "storage/bucket.md", "storage/getbucket.md",
+		"search-index.json",
+	} {
+		assert.Contains(t, files, p)
+	}
+
+	index := string(files["index.md"])
+	assert.Contains(t, index, "# site\n\nA package used to test standalone docs.")
+	assert.Contains(t, index, "- [storage](storage/index.md)")
+	assert.Contains(t, index, "- [Owner](owner.md): The owner of buckets.")
+	assert.Contains(t, index, "- **Version:** 1.2.3")
+
+	storage := string(files["storage/index.md"])
+	assert.Contains(t, storage, "- [Bucket](bucket.md): A bucket.")
+	assert.Contains(t, storage, "- [getBucket](getbucket.md): Looks up a bucket.")
+
+	bucket := string(files["storage/bucket.md"])
+	assert.Contains(t, bucket, "**Token:** `site:storage:Bucket`")
+	assert.Contains(t, bucket, "## Example Usage\n\n### Basic\n\n**TypeScript**\n\n```typescript")
+	assert.NotContains(t, bucket, "Coming soon!")
+	assert.Contains(t, bucket, "new Bucket(name: string, args: BucketArgs, opts?: CustomResourceOptions);")
+	assert.Contains(t, bucket,
+		"func NewBucket(ctx *Context, name string, args BucketArgs, opts ...ResourceOption) (*Bucket, error)")
+	assert.Contains(t, bucket, "- **size** (integer, required): The size of the bucket.")
+	assert.Contains(t, bucket, "- **endpoints** (List\\<[Endpoint](#endpoint)\\>): The bucket's endpoints.")
+	assert.Contains(t, bucket, "### Endpoint\n\nAn endpoint.\n\n- **host** (string, required): The host name.")
+	assert.Contains(t, bucket, "- **tier** ([Tier](#tier))")
+	assert.Contains(t, bucket, "### Tier\n\nThe tier of an endpoint.\n\n- **Hot** (`\"hot\"`): Frequently accessed.")
+
+	owner := string(files["owner.md"])
+	assert.Contains(t, owner, "- **bucket** ([Bucket](storage/bucket.md))")
+
+	getBucket := string(files["storage/getbucket.md"])
+	assert.Contains(t, getBucket,
+		"function getBucket(args: GetBucketArgs, opts?: InvokeOptions): Promise<GetBucketResult>")
+	assert.Contains(t, getBucket, "- **name** (string, required)")
+
+	var entries []searchIndexEntry
+	require.NoError(t, json.Unmarshal(files["search-index.json"], &entries))
+	assert.Contains(t, entries, searchIndexEntry{
+		Title:   "site",
+		Kind:    "package",
+		Path:    "index.md",
+		Summary: "A package used to test standalone docs.",
+	})
+	assert.Contains(t, entries, searchIndexEntry{
+		Title:   "Bucket",
+		Kind:    "resource",
+		Token:   "site:storage:Bucket",
+		Path:    "storage/bucket.md",
+		Summary: "A bucket.",
+	})
+	assert.Contains(t, entries, searchIndexEntry{
+		Title:   "Endpoint",
+		Kind:    "type",
+		Token:   "site:storage:Endpoint",
+		Path:    "storage/bucket.md#endpoint",
+		Summary: "An endpoint.",
+	})
+}
+
+func TestGenerateSiteUniquePaths(t *testing.T) {
+	self := schema.PropertySpec{TypeSpec: schema.TypeSpec{Ref: "#/resources/site:index:Widget"}}
+	spec := schema.PackageSpec{
+		Name: "site",
+		Meta: &schema.MetadataSpec{ModuleFormat: "(.*)"},
+		Resources: map[string]schema.ResourceSpec{
+			"site:index:Widget": {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Description: "A widget."},
+				IsComponent:    true,
+				Methods:        map[string]string{"grow": "site:index:Widget/grow"},
+			},
+			"site:index:Index": {ObjectTypeSpec: schema.ObjectTypeSpec{Description: "An index."}},
+		},
+		Functions: map[string]schema.FunctionSpec{
+			"site:index:widget": {Description: "Looks up a widget."},
+			"site:index:Widget/grow": {
+				Description: "Grows the widget.",
+				Inputs: &schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{"__self__": self},
+					Required:   []string{"__self__"},
+				},
+			},
+		},
+	}
+	pkg, err := schema.ImportSpec(spec, nil)
+	require.NoError(t, err)
+
+	files, err := GenerateSite(unitTestTool, pkg, MarkdownSite)
+	require.NoError(t, err)
+
+	// Pages whose paths differ only in case from another page's are given a numeric suffix.
+	index := string(files["index.md"])
+	assert.Contains(t, index, "- [Index](index-2.md): An index.")
+	assert.Contains(t, index, "- [Widget](widget.md): A widget.")
+	assert.Contains(t, index, "- [widget](widget-2.md): Looks up a widget.")
+	assert.Contains(t, string(files["widget-2.md"]), "**Token:** `site:index:widget`")
+
+	// Methods are documented on their resource's page rather than on pages of their own.
+	assert.NotContains(t, index, "grow")
+	for p := range files {
+		assert.NotContains(t, p, "grow")
+	}
+	assert.Contains(t, string(files["widget.md"]), "## Methods\n\n- `grow`: Grows the widget.")
+}
+
+func TestGenerateHTMLSite(t *testing.T) {
+	files, err := GenerateSite(unitTestTool, siteTestPackage(t), HTMLSite)
+	require.NoError(t, err)
+
+	bucket := string(files["storage/bucket.html"])
+	assert.Contains(t, bucket, "<title>Bucket | site</title>")
+	assert.Contains(t, bucket, `<nav><a href="../index.html">site</a> | <a href="../search.html">Search</a></nav>`)
+	assert.Contains(t, bucket, `<h3 id="endpoint">Endpoint</h3>`)
+	assert.Contains(t, bucket, `<a href="#endpoint">Endpoint</a>`)
+
+	owner := string(files["owner.html"])
+	assert.Contains(t, owner, `<a href="storage/bucket.html">Bucket</a>`)
+
+	search := string(files["search.html"])
+	assert.Contains(t, search, `"path":"storage/bucket.html"`)
+
+	_, err = GenerateSite(unitTestTool, siteTestPackage(t), SiteFormat("pdf"))
+	assert.Error(t, err)
+}
+
+func TestRelativeLink(t *testing.T) {
+	assert.Equal(t, "storage/bucket.md", relativeLink("index.md", "storage/bucket.md"))
+	assert.Equal(t, "../index.md", relativeLink("storage/bucket.md", "index.md"))
+	assert.Equal(t, "getbucket.md", relativeLink("storage/bucket.md", "storage/getbucket.md"))
+	assert.Equal(t, "../../compute/vm.md", relativeLink("storage/v1/bucket.md", "compute/vm.md"))
+	assert.Equal(t, "../vm.md", relativeLink("storage/v1/bucket.md", "storage/vm.md"))
+}
@@ -140,6 +140,7 @@ type modContext struct {
 	namespaces             map[string]string
 	compatibility          string
 	dictionaryConstructors bool
+	generateMocks          bool
 }
 
 func (mod *modContext) propertyName(p *schema.Property) string {
@@ -967,6 +968,11 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 		fmt.Fprintf(w, "        }\n")
 	}
 
+	// Write the methods.
+	for _, method := range r.Methods {
+		mod.genMethod(w, className, method)
+	}
+
 	// Close the class.
 	fmt.Fprintf(w, "    }\n")
 
@@ -1011,12 +1017,93 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 		}
 	}
 
+	// Generate the args and result types of the methods, if any.
+	for _, method := range r.Methods {
+		methodName := Title(method.Name)
+		if args := methodArgs(method); len(args) > 0 {
+			args := &plainType{
+				mod:                   mod,
+				res:                   r,
+				name:                  className + methodName + "Args",
+				baseClass:             "CallArgs",
+				propertyTypeQualifier: "Inputs",
+				properties:            args,
+				args:                  true,
+			}
+			if err := args.genInputType(w, 1); err != nil {
+				return err
+			}
+		}
+		if method.Function.Outputs != nil {
+			res := &plainType{
+				mod:                   mod,
+				name:                  className + methodName + "Result",
+				propertyTypeQualifier: "Outputs",
+				properties:            method.Function.Outputs.Properties,
+			}
+			res.genOutputType(w, 1)
+		}
+	}
+
 	// Close the namespace.
 	fmt.Fprintf(w, "}\n")
 
 	return nil
 }
 
+// methodArgs returns the arguments of the given method, excluding the implicit `__self__` argument.
+func methodArgs(method *schema.Method) []*schema.Property {
+	if method.Function.Inputs == nil {
+		return nil
+	}
+	var args []*schema.Property
+	for _, arg := range method.Function.Inputs.Properties {
+		if arg.Name != "__self__" {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+func (mod *modContext) genMethod(w io.Writer, className string, method *schema.Method) {
+	fun := method.Function
+	methodName := Title(method.Name)
+
+	var typeParameter string
+	returnType := "void"
+	if fun.Outputs != nil {
+		typeParameter = fmt.Sprintf("<%s%sResult>", className, methodName)
+		returnType = "Output" + typeParameter
+	}
+
+	var argsParamDef string
+	argsParamRef := "CallArgs.Empty"
+	if args := methodArgs(method); len(args) > 0 {
+		allOptionalInputs := true
+		for _, arg := range args {
+			allOptionalInputs = allOptionalInputs && !arg.IsRequired
+		}
+
+		var argsDefault, sigil string
+		if allOptionalInputs {
+			// If the number of required input properties was zero, we can make the args object optional.
+			argsDefault, sigil = " = null", "?"
+		}
+
+		argsParamDef = fmt.Sprintf("%s%sArgs%s args%s", className, methodName, sigil, argsDefault)
+		argsParamRef = fmt.Sprintf("args ?? new %s%sArgs()", className, methodName)
+	}
+
+	fmt.Fprintf(w, "\n")
+	printComment(w, fun.Comment, "        ")
+	if fun.DeprecationMessage != "" {
+		fmt.Fprintf(w, "        [Obsolete(@\"%s\")]\n", strings.Replace(fun.DeprecationMessage, `"`, `""`, -1))
+	}
+	fmt.Fprintf(w, "        public %s %s(%s)\n", returnType, methodName, argsParamDef)
+	fmt.Fprintf(w, "            => Pulumi.Deployment.Instance.Call%s(\"%s\", %s, this);\n", typeParameter, fun.Token,
+		argsParamRef)
+}
+
 func (mod *modContext) genFunction(w io.Writer, fun *schema.Function) error {
 	className := tokenToFunctionName(fun.Token)
 
@@ -1356,6 +1443,14 @@ func (mod *modContext) getImports(member interface{}, imports map[string]codegen
 		for _, p := range member.InputProperties {
 			mod.getTypeImports(p.Type, false, imports, seen)
 		}
+		for _, method := range member.Methods {
+			for _, p := range methodArgs(method) {
+				mod.getTypeImports(p.Type, false, imports, seen)
+			}
+			if method.Function.Outputs != nil {
+				mod.getTypeImports(method.Function.Outputs, false, imports, seen)
+			}
+		}
 		return
 	case *schema.Function:
 		if member.Inputs != nil {
@@ -1683,6 +1778,14 @@ func (mod *modContext) gen(fs fs) error {
 
 		addFile("Enums.cs", buffer.String())
 	}
+
+	// Mocks
+	if mod.generateMocks && mod.hasMocks() {
+		buffer := &bytes.Buffer{}
+		mod.genHeader(buffer, []string{"System.Collections.Immutable", "Pulumi.Testing"})
+		mod.genMocks(buffer)
+		addFile("Mocks.cs", buffer.String())
+	}
 	return nil
 }
 
@@ -1815,6 +1918,7 @@ func generateModuleContextMap(tool string, pkg *schema.Package) (map[string]*mod
 				propertyNames:          propertyNames,
 				compatibility:          info.Compatibility,
 				dictionaryConstructors: info.DictionaryConstructors,
+				generateMocks:          info.GenerateMocks,
 			}
 
 			if modName != "" {
@@ -1883,7 +1987,9 @@ func generateModuleContextMap(tool string, pkg *schema.Package) (map[string]*mod
 	// Find input and output types referenced by functions.
 	for _, f := range pkg.Functions {
 		mod := getModFromToken(f.Token, pkg)
-		mod.functions = append(mod.functions, f)
+		if !f.IsMethod {
+			mod.functions = append(mod.functions, f)
+		}
 		if f.Inputs != nil {
 			visitObjectTypes(f.Inputs.Properties, func(t *schema.ObjectType, _ bool) {
 				details := getModFromToken(t.Token, t.Package).details(t)
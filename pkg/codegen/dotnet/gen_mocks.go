@@ -0,0 +1,172 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dotnet
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+// hasMocks returns true if the module has any resources or functions to generate mocks for.
+func (mod *modContext) hasMocks() bool {
+	if len(mod.resources) != 0 {
+		return true
+	}
+	for _, f := range mod.functions {
+		if f.Outputs != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// mockTypeString returns the C# type of the mock parameter for a property of the given type. Objects are passed as
+// dictionaries, as the generated output types can only be constructed by the Pulumi runtime.
+func mockTypeString(t schema.Type) string {
+	switch t := t.(type) {
+	case *schema.ArrayType:
+		return fmt.Sprintf("ImmutableArray<%s>", mockTypeString(t.ElementType))
+	case *schema.MapType:
+		return fmt.Sprintf("ImmutableDictionary<string, %s>", mockTypeString(t.ElementType))
+	case *schema.ObjectType:
+		return "ImmutableDictionary<string, object>"
+	case *schema.EnumType:
+		return mockTypeString(t.ElementType)
+	case *schema.TokenType:
+		if t.UnderlyingType != nil {
+			return mockTypeString(t.UnderlyingType)
+		}
+		return "object"
+	}
+
+	switch t {
+	case schema.BoolType:
+		return "bool"
+	case schema.IntType:
+		return "int"
+	case schema.NumberType:
+		return "double"
+	case schema.StringType:
+		return "string"
+	default:
+		return "object"
+	}
+}
+
+// mockParameterName returns the name of the mock parameter for the given property.
+func (mod *modContext) mockParameterName(p *schema.Property) string {
+	runes := []rune(mod.propertyName(p))
+	return csharpIdentifier(string(append([]rune{unicode.ToLower(runes[0])}, runes[1:]...)))
+}
+
+// genMockMethod generates a mock method with the given return type, leading parameters, and a nullable parameter for
+// each of the given properties. The body sets each property that is given in the named dictionary builder.
+func (mod *modContext) genMockMethod(w io.Writer, returnType, name string, params []string,
+	properties []*schema.Property, builder, initializer, result string) {
+
+	for _, p := range properties {
+		lines := strings.Split(strings.TrimSpace(docCommentEscaper.Replace(p.Comment)), "\n")
+		if lines[0] == "" {
+			continue
+		}
+		if len(lines) == 1 {
+			fmt.Fprintf(w, "        /// <param name=\"%s\">%s</param>\n", mod.mockParameterName(p), lines[0])
+			continue
+		}
+		fmt.Fprintf(w, "        /// <param name=\"%s\">\n", mod.mockParameterName(p))
+		for _, l := range lines {
+			fmt.Fprintf(w, "        /// %s\n", l)
+		}
+		fmt.Fprintf(w, "        /// </param>\n")
+	}
+
+	for _, p := range properties {
+		params = append(params, fmt.Sprintf("%s? %s = null", mockTypeString(p.Type), mod.mockParameterName(p)))
+	}
+	fmt.Fprintf(w, "        public static %s %s(", returnType, name)
+	for i, param := range params {
+		if i > 0 {
+			fmt.Fprintf(w, ",")
+		}
+		fmt.Fprintf(w, "\n            %s", param)
+	}
+	fmt.Fprintf(w, ")\n")
+
+	fmt.Fprintf(w, "        {\n")
+	fmt.Fprintf(w, "            var %s = %s;\n", builder, initializer)
+	for _, p := range properties {
+		fmt.Fprintf(w, "            if (%s != null)\n", mod.mockParameterName(p))
+		fmt.Fprintf(w, "            {\n")
+		fmt.Fprintf(w, "                %s[\"%s\"] = %s;\n", builder, p.Name, mod.mockParameterName(p))
+		fmt.Fprintf(w, "            }\n")
+	}
+	fmt.Fprintf(w, "            return %s;\n", result)
+	fmt.Fprintf(w, "        }\n")
+}
+
+// genMocks generates a typed mock for each resource and function in the module. Each resource mock returns the ID and
+// state of a mocked resource given the values of its output properties; each function mock returns the outputs of a
+// mocked call given the values of the function's outputs. Both are intended for use in implementations of IMocks.
+func (mod *modContext) genMocks(w io.Writer) {
+	fmt.Fprintf(w, "namespace %s\n", mod.namespaceName)
+	fmt.Fprintf(w, "{\n")
+	fmt.Fprintf(w, "    /// <summary>\n")
+	fmt.Fprintf(w, "    /// Typed mocks of the resources and functions in this namespace, for use in implementations of\n")
+	fmt.Fprintf(w, "    /// <see cref=\"IMocks\"/> in unit tests.\n")
+	fmt.Fprintf(w, "    /// </summary>\n")
+	fmt.Fprintf(w, "    public static class Mocks\n")
+	fmt.Fprintf(w, "    {\n")
+
+	for i, r := range mod.resources {
+		if i > 0 {
+			fmt.Fprintf(w, "\n")
+		}
+		name := resourceName(r)
+
+		fmt.Fprintf(w, "        /// <summary>\n")
+		fmt.Fprintf(w, "        /// Returns the ID and state of a mocked %s resource, for use in\n", name)
+		fmt.Fprintf(w, "        /// <see cref=\"IMocks.NewResourceAsync\"/>. Properties that are not given are taken from the resource's\n")
+		fmt.Fprintf(w, "        /// inputs. If no ID is given, one is derived from the resource's name.\n")
+		fmt.Fprintf(w, "        /// </summary>\n")
+		fmt.Fprintf(w, "        /// <param name=\"args\">The arguments of the mocked resource registration.</param>\n")
+		fmt.Fprintf(w, "        /// <param name=\"id\">The physical ID of the mocked resource.</param>\n")
+		mod.genMockMethod(w, "(string? id, object state)", "Mock"+name,
+			[]string{"MockResourceArgs args", "string? id = null"}, r.Properties,
+			"state", "args.Inputs.ToBuilder()", "(id ?? args.Id ?? $\"{args.Name}_id\", state.ToImmutable())")
+	}
+
+	for i, f := range mod.functions {
+		if f.Outputs == nil {
+			continue
+		}
+		if i > 0 || len(mod.resources) > 0 {
+			fmt.Fprintf(w, "\n")
+		}
+		name := tokenToFunctionName(f.Token)
+
+		fmt.Fprintf(w, "        /// <summary>\n")
+		fmt.Fprintf(w, "        /// Returns the outputs of a mocked call to %s, for use in <see cref=\"IMocks.CallAsync\"/>.\n", name)
+		fmt.Fprintf(w, "        /// </summary>\n")
+		mod.genMockMethod(w, "object", "Mock"+name, nil, f.Outputs.Properties,
+			"result", "ImmutableDictionary.CreateBuilder<string, object>()", "result.ToImmutable()")
+	}
+
+	fmt.Fprintf(w, "    }\n")
+	fmt.Fprintf(w, "}\n")
+}
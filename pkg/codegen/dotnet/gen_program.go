@@ -27,7 +27,9 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model/format"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
 type generator struct {
@@ -116,6 +118,62 @@ func GenerateProgram(program *hcl2.Program) (map[string][]byte, hcl.Diagnostics,
 	return files, g.diagnostics, nil
 }
 
+// GenerateProject generates a complete C# project for the given program: the program itself, the project's
+// Pulumi.yaml, the program's entry point, and the .csproj file necessary to build it.
+func GenerateProject(project workspace.Project, program *hcl2.Program) (map[string][]byte, hcl.Diagnostics, error) {
+	files, diags, err := GenerateProgram(program)
+	if err != nil {
+		return nil, diags, err
+	}
+
+	project.Runtime = workspace.NewProjectRuntimeInfo("dotnet", nil)
+	projectYAML, err := encoding.YAML.Marshal(&project)
+	if err != nil {
+		return nil, diags, err
+	}
+	files["Pulumi.yaml"] = projectYAML
+	files["Program.cs"] = []byte(programCS)
+
+	var csproj bytes.Buffer
+	fmt.Fprintf(&csproj, `<Project Sdk="Microsoft.NET.Sdk">
+
+  <PropertyGroup>
+    <OutputType>Exe</OutputType>
+    <TargetFramework>netcoreapp3.1</TargetFramework>
+    <Nullable>enable</Nullable>
+  </PropertyGroup>
+
+  <ItemGroup>
+    <PackageReference Include="Pulumi" Version="3.*" />
+`)
+	for _, p := range program.Packages() {
+		// GenerateProgram has already imported the C#-specific package info.
+		info, _ := p.Language["csharp"].(CSharpPackageInfo)
+		version := "*"
+		if p.Version != nil {
+			version = p.Version.String()
+		}
+		fmt.Fprintf(&csproj, "    <PackageReference Include=\"Pulumi.%s\" Version=\"%s\" />\n",
+			namespaceName(info.Namespaces, p.Name), version)
+	}
+	fmt.Fprintf(&csproj, `  </ItemGroup>
+
+</Project>
+`)
+	files[project.Name.String()+".csproj"] = csproj.Bytes()
+
+	return files, diags, nil
+}
+
+const programCS = `using System.Threading.Tasks;
+using Pulumi;
+
+class Program
+{
+    static Task<int> Main() => Deployment.RunAsync<MyStack>();
+}
+`
+
 // genTrivia generates the list of trivia associated with a given token.
 func (g *generator) genTrivia(w io.Writer, token syntax.Token) {
 	for _, t := range token.LeadingTrivia {
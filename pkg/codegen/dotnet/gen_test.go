@@ -54,6 +54,20 @@ func TestGeneratePackage(t *testing.T) {
 				"Component.cs",
 			},
 		},
+		{
+			"Simple schema with methods",
+			"simple-methods-schema",
+			[]string{
+				"Foo.cs",
+			},
+		},
+		{
+			"Simple schema with mocks",
+			"simple-mocks-schema",
+			[]string{
+				"Mocks.cs",
+			},
+		},
 	}
 	testDir := filepath.Join("..", "internal", "test", "testdata")
 	for _, tt := range tests {
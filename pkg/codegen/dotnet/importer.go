@@ -31,6 +31,8 @@ type CSharpPackageInfo struct {
 	Namespaces             map[string]string `json:"namespaces,omitempty"`
 	Compatibility          string            `json:"compatibility,omitempty"`
 	DictionaryConstructors bool              `json:"dictionaryConstructors,omitempty"`
+	// Generate typed mocks for each resource and function, for use in implementations of IMocks in unit tests.
+	GenerateMocks bool `json:"generateMocks,omitempty"`
 }
 
 // Importer implements schema.Language for .NET.
@@ -1264,6 +1264,11 @@ func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource, generateReso
 	fmt.Fprintf(w, "\treturn reflect.TypeOf((*%sArgs)(nil)).Elem()\n", camel(name))
 	fmt.Fprintf(w, "}\n\n")
 
+	// Emit the methods.
+	for _, method := range r.Methods {
+		pkg.genMethod(w, name, method)
+	}
+
 	// Emit the resource input type.
 	fmt.Fprintf(w, "type %sInput interface {\n", name)
 	fmt.Fprintf(w, "\tpulumi.Input\n\n")
@@ -1343,6 +1348,11 @@ func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource, generateReso
 	// Register all output types
 	fmt.Fprintf(w, "func init() {\n")
 	fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sOutput{})\n", name)
+	for _, method := range r.Methods {
+		if method.Function.Outputs != nil {
+			fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%s%sResultOutput{})\n", name, Title(method.Name))
+		}
+	}
 
 	if generateResourceContainerTypes {
 		fmt.Fprintf(w, "\tpulumi.RegisterOutputType(%sPtrOutput{})\n", name)
@@ -1356,6 +1366,92 @@ func (pkg *pkgContext) genResource(w io.Writer, r *schema.Resource, generateReso
 	return nil
 }
 
+// genMethod emits a method on the resource type along with the types of its arguments and result. The result of a
+// method is returned as an output, as it may depend on resources registered by the method's implementation.
+func (pkg *pkgContext) genMethod(w io.Writer, resourceName string, method *schema.Method) {
+	f := method.Function
+	methodName := Title(method.Name)
+	typeName := resourceName + methodName
+
+	var args []*schema.Property
+	if f.Inputs != nil {
+		for _, p := range f.Inputs.Properties {
+			if p.Name != "__self__" {
+				args = append(args, p)
+			}
+		}
+	}
+
+	printCommentWithDeprecationMessage(w, f.Comment, f.DeprecationMessage, false)
+
+	argsig, argsVar := "ctx *pulumi.Context", "nil"
+	if len(args) != 0 {
+		argsig, argsVar = fmt.Sprintf("%s, args *%sArgs", argsig, typeName), "args"
+	}
+	if f.Outputs == nil {
+		fmt.Fprintf(w, "func (r *%s) %s(%s) error {\n", resourceName, methodName, argsig)
+		fmt.Fprintf(w, "\t_, err := ctx.Call(%q, %s, pulumi.AnyOutput{}, r)\n", f.Token, argsVar)
+		fmt.Fprintf(w, "\treturn err\n")
+		fmt.Fprintf(w, "}\n\n")
+	} else {
+		resultType := typeName + "ResultOutput"
+		fmt.Fprintf(w, "func (r *%s) %s(%s) (%s, error) {\n", resourceName, methodName, argsig, resultType)
+		fmt.Fprintf(w, "\tout, err := ctx.Call(%q, %s, %s{}, r)\n", f.Token, argsVar, resultType)
+		fmt.Fprintf(w, "\tif err != nil {\n")
+		fmt.Fprintf(w, "\t\treturn %s{}, err\n", resultType)
+		fmt.Fprintf(w, "\t}\n")
+		fmt.Fprintf(w, "\treturn out.(%s), nil\n", resultType)
+		fmt.Fprintf(w, "}\n\n")
+	}
+
+	if len(args) != 0 {
+		fmt.Fprintf(w, "type %sArgs struct {\n", camel(typeName))
+		for _, p := range args {
+			printCommentWithDeprecationMessage(w, p.Comment, p.DeprecationMessage, true)
+			fmt.Fprintf(w, "\t%s %s `pulumi:\"%s\"`\n", Title(p.Name), pkg.plainType(p.Type, !p.IsRequired), p.Name)
+		}
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "// The set of arguments for the %s method of the %s resource.\n", methodName, resourceName)
+		fmt.Fprintf(w, "type %sArgs struct {\n", typeName)
+		for _, p := range args {
+			printCommentWithDeprecationMessage(w, p.Comment, p.DeprecationMessage, true)
+			fmt.Fprintf(w, "\t%s %s\n", Title(p.Name), pkg.inputType(p.Type, !p.IsRequired))
+		}
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "func (%sArgs) ElementType() reflect.Type {\n", typeName)
+		fmt.Fprintf(w, "\treturn reflect.TypeOf((*%sArgs)(nil)).Elem()\n", camel(typeName))
+		fmt.Fprintf(w, "}\n\n")
+	}
+
+	if f.Outputs != nil {
+		pkg.genPlainType(w, typeName+"Result", f.Outputs.Comment, "", f.Outputs.Properties)
+		fmt.Fprintf(w, "\n")
+
+		fmt.Fprintf(w, "type %sResultOutput struct{ *pulumi.OutputState }\n\n", typeName)
+
+		fmt.Fprintf(w, "func (%sResultOutput) ElementType() reflect.Type {\n", typeName)
+		fmt.Fprintf(w, "\treturn reflect.TypeOf((*%sResult)(nil)).Elem()\n", typeName)
+		fmt.Fprintf(w, "}\n\n")
+
+		for _, p := range f.Outputs.Properties {
+			printCommentWithDeprecationMessage(w, p.Comment, p.DeprecationMessage, false)
+			outputType, applyType := pkg.outputType(p.Type, !p.IsRequired), pkg.plainType(p.Type, !p.IsRequired)
+
+			propName := Title(p.Name)
+			switch strings.ToLower(p.Name) {
+			case "elementtype", "issecret":
+				propName = "Get" + propName
+			}
+			fmt.Fprintf(w, "func (o %sResultOutput) %s() %s {\n", typeName, propName, outputType)
+			fmt.Fprintf(w, "\treturn o.ApplyT(func (v %sResult) %s { return v.%s }).(%s)\n", typeName, applyType,
+				Title(p.Name), outputType)
+			fmt.Fprintf(w, "}\n\n")
+		}
+	}
+}
+
 func (pkg *pkgContext) genFunction(w io.Writer, f *schema.Function) {
 	// If the function starts with New or Get, it will conflict; so rename them.
 	name := pkg.functionNames[f]
@@ -1677,6 +1773,18 @@ func (pkg *pkgContext) getImports(member interface{}, importsAndAliases map[stri
 				importsAndAliases["github.com/pkg/errors"] = ""
 			}
 		}
+		for _, method := range member.Methods {
+			if method.Function.Inputs != nil {
+				for _, p := range method.Function.Inputs.Properties {
+					if p.Name != "__self__" {
+						pkg.getTypeImports(p.Type, false, importsAndAliases, seen)
+					}
+				}
+			}
+			if method.Function.Outputs != nil {
+				pkg.getTypeImports(method.Function.Outputs, true, importsAndAliases, seen)
+			}
+		}
 	case *schema.Function:
 		if member.Inputs != nil {
 			pkg.getTypeImports(member.Inputs, true, importsAndAliases, seen)
@@ -2033,6 +2141,13 @@ func generatePackageContextMap(tool string, pkg *schema.Package, goInfo GoPackag
 		pkg.names.Add(resourceName(r) + "Args")
 		pkg.names.Add(camel(resourceName(r)) + "Args")
 		pkg.names.Add("New" + resourceName(r))
+		for _, method := range r.Methods {
+			methodName := resourceName(r) + Title(method.Name)
+			pkg.names.Add(methodName + "Args")
+			pkg.names.Add(camel(methodName) + "Args")
+			pkg.names.Add(methodName + "Result")
+			pkg.names.Add(methodName + "ResultOutput")
+		}
 		if !r.IsProvider && !r.IsComponent {
 			pkg.names.Add(resourceName(r) + "State")
 			pkg.names.Add(camel(resourceName(r)) + "State")
@@ -2049,6 +2164,11 @@ func generatePackageContextMap(tool string, pkg *schema.Package, goInfo GoPackag
 	}
 
 	for _, f := range pkg.Functions {
+		// Methods are generated along with the resources they belong to.
+		if f.IsMethod {
+			continue
+		}
+
 		pkg := getPkgFromToken(f.Token)
 		pkg.functions = append(pkg.functions, f)
 
@@ -2307,6 +2427,17 @@ func GeneratePackage(tool string, pkg *schema.Package) (map[string][]byte, error
 			setFile(path.Join(mod, "pulumiUtilities.go"), buffer.String())
 		}
 
+		// Mocks
+		if goPkgInfo.GenerateMocks && pkg.hasMocks() {
+			buffer := &bytes.Buffer{}
+			pkg.genMocks(buffer)
+			setFile(path.Join(mod, "pulumiMocks.go"), buffer.String())
+
+			buffer = &bytes.Buffer{}
+			pkg.genMocksTest(buffer)
+			setFile(path.Join(mod, "pulumiMocks_test.go"), buffer.String())
+		}
+
 		// If there are resources in this module, register the module with the runtime.
 		if len(pkg.resources) != 0 {
 			buffer := &bytes.Buffer{}
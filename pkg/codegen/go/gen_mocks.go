@@ -0,0 +1,150 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+// hasMocks returns true if the package has any resources or functions to generate mocks for.
+func (pkg *pkgContext) hasMocks() bool {
+	if len(pkg.resources) != 0 {
+		return true
+	}
+	for _, f := range pkg.functions {
+		if f.Outputs != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// genMocks generates a typed mock for each resource and function in the package. Resource mocks describe the output
+// properties reported for a mocked resource; function mocks describe the result of a mocked call. Both may be used
+// to implement a pulumi.MockResourceMonitor.
+func (pkg *pkgContext) genMocks(w io.Writer) {
+	importsAndAliases := map[string]string{
+		"github.com/pulumi/pulumi/sdk/v3/go/common/resource": "",
+	}
+	for _, r := range pkg.resources {
+		pkg.getImports(r.Properties, importsAndAliases)
+	}
+	importsAndAliases["github.com/pulumi/pulumi/sdk/v3/go/pulumi"] = ""
+	pkg.genHeader(w, nil, importsAndAliases)
+
+	for _, r := range pkg.resources {
+		name := resourceName(r)
+
+		fmt.Fprintf(w, "// Mock%s describes the state of a mocked %s resource. Its NewResource method may be used to\n", name, name)
+		fmt.Fprintf(w, "// implement the NewResource method of a pulumi.MockResourceMonitor.\n")
+		fmt.Fprintf(w, "type Mock%s struct {\n", name)
+		fmt.Fprintf(w, "\t// ID is the physical ID of the mocked resource. If empty, an ID is derived from the resource's name.\n")
+		fmt.Fprintf(w, "\tID string\n")
+		for _, p := range r.Properties {
+			printCommentWithDeprecationMessage(w, p.Comment, p.DeprecationMessage, true)
+			fmt.Fprintf(w, "\t%s %s `pulumi:\"%s\"`\n", Title(p.Name), pkg.plainType(p.Type, true), p.Name)
+		}
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "// NewResource returns the ID and output properties of a mocked %s resource. Output properties that\n", name)
+		fmt.Fprintf(w, "// are not set on the mock are taken from the resource's inputs.\n")
+		fmt.Fprintf(w, "func (m Mock%s) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {\n", name)
+		fmt.Fprintf(w, "\treturn pulumi.MockResourceState(args, m.ID, m)\n")
+		fmt.Fprintf(w, "}\n\n")
+	}
+
+	for _, f := range pkg.functions {
+		if f.Outputs == nil {
+			continue
+		}
+		name := pkg.functionNames[f]
+
+		fmt.Fprintf(w, "// Mock%s describes the result of a mocked call to %s. Its Call method may be used to\n", name, name)
+		fmt.Fprintf(w, "// implement the Call method of a pulumi.MockResourceMonitor.\n")
+		fmt.Fprintf(w, "type Mock%[1]s %[1]sResult\n\n", name)
+
+		fmt.Fprintf(w, "// Call returns the outputs of a mocked call to %s.\n", name)
+		fmt.Fprintf(w, "func (m Mock%s) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {\n", name)
+		fmt.Fprintf(w, "\treturn pulumi.MockCallResult(m)\n")
+		fmt.Fprintf(w, "}\n\n")
+	}
+}
+
+// genMocksTest generates an example test that uses the package's mocks with pulumi.WithMocks. Each resource that can
+// be constructed without arguments is registered against the mocks.
+func (pkg *pkgContext) genMocksTest(w io.Writer) {
+	pkg.genHeader(w, []string{"testing"}, map[string]string{
+		"github.com/pulumi/pulumi/sdk/v3/go/common/resource": "",
+		"github.com/pulumi/pulumi/sdk/v3/go/pulumi":          "",
+	})
+
+	fmt.Fprintf(w, "// mocks dispatches resource registrations and function calls to the typed mocks in this package.\n")
+	fmt.Fprintf(w, "type mocks struct{}\n\n")
+
+	fmt.Fprintf(w, "func (mocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {\n")
+	fmt.Fprintf(w, "\tswitch args.TypeToken {\n")
+	for _, r := range pkg.resources {
+		fmt.Fprintf(w, "\tcase %q:\n", r.Token)
+		fmt.Fprintf(w, "\t\treturn Mock%s{}.NewResource(args)\n", resourceName(r))
+	}
+	fmt.Fprintf(w, "\tdefault:\n")
+	fmt.Fprintf(w, "\t\treturn args.Name + \"_id\", args.Inputs, nil\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "func (mocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {\n")
+	fmt.Fprintf(w, "\tswitch args.Token {\n")
+	for _, f := range pkg.functions {
+		if f.Outputs == nil {
+			continue
+		}
+		fmt.Fprintf(w, "\tcase %q:\n", f.Token)
+		fmt.Fprintf(w, "\t\treturn Mock%s{}.Call(args)\n", pkg.functionNames[f])
+	}
+	fmt.Fprintf(w, "\tdefault:\n")
+	fmt.Fprintf(w, "\t\treturn args.Args, nil\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "func TestMocks(t *testing.T) {\n")
+	fmt.Fprintf(w, "\terr := pulumi.RunErr(func(ctx *pulumi.Context) error {\n")
+	for _, r := range pkg.resources {
+		if hasRequiredInputs(r) {
+			continue
+		}
+		name := resourceName(r)
+		fmt.Fprintf(w, "\t\tif _, err := New%[1]s(ctx, %[2]q, &%[1]sArgs{}); err != nil {\n", name, camel(name))
+		fmt.Fprintf(w, "\t\t\treturn err\n")
+		fmt.Fprintf(w, "\t\t}\n")
+	}
+	fmt.Fprintf(w, "\t\treturn nil\n")
+	fmt.Fprintf(w, "\t}, pulumi.WithMocks(\"project\", \"stack\", mocks{}))\n")
+	fmt.Fprintf(w, "\tif err != nil {\n")
+	fmt.Fprintf(w, "\t\tt.Fatal(err)\n")
+	fmt.Fprintf(w, "\t}\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+func hasRequiredInputs(r *schema.Resource) bool {
+	for _, p := range r.InputProperties {
+		if p.IsRequired {
+			return true
+		}
+	}
+	return false
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model/format"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
 type generator struct {
@@ -98,6 +100,57 @@ func GenerateProgram(program *hcl2.Program) (map[string][]byte, hcl.Diagnostics,
 	return files, g.diagnostics, nil
 }
 
+// GenerateProject generates a complete Go project for the given program: the program itself, the project's
+// Pulumi.yaml, and the go.mod file necessary to build it.
+func GenerateProject(project workspace.Project, program *hcl2.Program) (map[string][]byte, hcl.Diagnostics, error) {
+	files, diags, err := GenerateProgram(program)
+	if err != nil {
+		return nil, diags, err
+	}
+
+	project.Runtime = workspace.NewProjectRuntimeInfo("go", nil)
+	projectYAML, err := encoding.YAML.Marshal(&project)
+	if err != nil {
+		return nil, diags, err
+	}
+	files["Pulumi.yaml"] = projectYAML
+
+	var gomod bytes.Buffer
+	fmt.Fprintf(&gomod, "module %s\n\n", project.Name)
+	fmt.Fprintf(&gomod, "go 1.14\n\n")
+	fmt.Fprintf(&gomod, "require (\n")
+	fmt.Fprintf(&gomod, "\tgithub.com/pulumi/pulumi/sdk/v3 v3.0.0\n")
+	for _, p := range program.Packages() {
+		// Go modules require an exact version, so packages without version information are left for `go mod tidy`
+		// to resolve.
+		if p.Version == nil {
+			continue
+		}
+		fmt.Fprintf(&gomod, "\t%s v%s\n", goModulePath(p), p.Version)
+	}
+	fmt.Fprintf(&gomod, ")\n")
+	files["go.mod"] = gomod.Bytes()
+
+	return files, diags, nil
+}
+
+// goModulePath returns the path of the Go module that contains the SDK for the given package.
+func goModulePath(pkg *schema.Package) string {
+	if info, ok := pkg.Language["go"].(GoPackageInfo); ok && info.ImportBasePath != "" {
+		// Import paths have the form <module>/go/<package>, so trim everything after the module path.
+		if i := strings.LastIndex(info.ImportBasePath, "/go/"); i != -1 {
+			return info.ImportBasePath[:i]
+		}
+		return info.ImportBasePath
+	}
+
+	vPath := ""
+	if pkg.Version != nil && pkg.Version.Major > 1 {
+		vPath = fmt.Sprintf("/v%d", pkg.Version.Major)
+	}
+	return fmt.Sprintf("github.com/pulumi/pulumi-%s/sdk%s", pkg.Name, vPath)
+}
+
 func getPackages(tool string, pkg *schema.Package) map[string]*pkgContext {
 	if err := pkg.ImportLanguages(map[string]schema.Language{"go": Importer}); err != nil {
 		return nil
@@ -13,6 +13,7 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model/format"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/internal/test"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
 var testdataPath = filepath.Join("..", "internal", "test", "testdata")
@@ -83,6 +84,25 @@ func TestCollectImports(t *testing.T) {
 	assert.Equal(t, "\"github.com/pulumi/pulumi-aws/sdk/v2/go/aws/s3\"", pulumiVals[0])
 }
 
+func TestGenerateProject(t *testing.T) {
+	g := newTestGenerator(t, "aws-s3-logging.pp")
+	files, diags, err := GenerateProject(workspace.Project{Name: "logging"}, g.program)
+	assert.NoError(t, err)
+	assert.False(t, diags.HasErrors())
+
+	assert.Contains(t, files, "main.go")
+	assert.Contains(t, string(files["Pulumi.yaml"]), "runtime: go")
+	assert.Equal(t, `module logging
+
+go 1.14
+
+require (
+	github.com/pulumi/pulumi/sdk/v3 v3.0.0
+	github.com/pulumi/pulumi-aws/sdk/v2 v2.10.0
+)
+`, string(files["go.mod"]))
+}
+
 func newTestGenerator(t *testing.T, testFile string) *generator {
 	files, err := ioutil.ReadDir(testdataPath)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pulumi/pulumi/pkg/v3/codegen/internal/test"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/internal/test/testdata/simple-enum-schema/go/plant"
+	example "github.com/pulumi/pulumi/pkg/v3/codegen/internal/test/testdata/simple-mocks-schema/go/example"
 	tree "github.com/pulumi/pulumi/pkg/v3/codegen/internal/test/testdata/simple-enum-schema/go/plant/tree/v1"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
@@ -122,6 +123,34 @@ func TestGeneratePackage(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"Simple schema with methods",
+			"simple-methods-schema",
+			[]string{
+				filepath.Join("example", "doc.go"),
+				filepath.Join("example", "init.go"),
+				filepath.Join("example", "foo.go"),
+				filepath.Join("example", "provider.go"),
+				filepath.Join("example", "pulumiUtilities.go"),
+			},
+			false,
+		},
+		{
+			"Simple schema with mocks",
+			"simple-mocks-schema",
+			[]string{
+				filepath.Join("example", "doc.go"),
+				filepath.Join("example", "getWidgetCount.go"),
+				filepath.Join("example", "init.go"),
+				filepath.Join("example", "provider.go"),
+				filepath.Join("example", "pulumiMocks.go"),
+				filepath.Join("example", "pulumiMocks_test.go"),
+				filepath.Join("example", "pulumiTypes.go"),
+				filepath.Join("example", "pulumiUtilities.go"),
+				filepath.Join("example", "widget.go"),
+			},
+			false,
+		},
 		{
 			"Simple schema with root package set",
 			"simple-plain-schema-with-root-package",
@@ -282,3 +311,44 @@ func TestEnumUsage(t *testing.T) {
 		}, pulumi.WithMocks("project", "stack", mocks(1))))
 	})
 }
+
+type typedMocks int
+
+func (typedMocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	port := 8080
+	return example.MockWidget{
+		ID:       "widget-1234",
+		Endpoint: &example.Endpoint{Host: "localhost", Port: &port},
+	}.NewResource(args)
+}
+
+func (typedMocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	return example.MockGetWidgetCount{Count: 3}.Call(args)
+}
+
+func TestMockUsage(t *testing.T) {
+	require.NoError(t, pulumi.RunErr(func(ctx *pulumi.Context) error {
+		widget, err := example.NewWidget(ctx, "widget", &example.WidgetArgs{
+			WidgetName: pulumi.String("gizmo"),
+		})
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		pulumi.All(widget.ID(), widget.WidgetName, widget.Endpoint.Host(), widget.Endpoint.Port()).ApplyT(
+			func(all []interface{}) error {
+				assert.Equal(t, pulumi.ID("widget-1234"), all[0])
+				assert.Equal(t, "gizmo", all[1])
+				assert.Equal(t, "localhost", all[2])
+				assert.Equal(t, 8080, *all[3].(*int))
+				wg.Done()
+				return nil
+			})
+		wg.Wait()
+
+		result, err := example.GetWidgetCount(ctx, &example.GetWidgetCountArgs{})
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Count)
+		return nil
+	}, pulumi.WithMocks("project", "stack", typedMocks(0))))
+}
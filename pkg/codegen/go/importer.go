@@ -45,6 +45,10 @@ type GoPackageInfo struct {
 	// Generate container types (arrays, maps, pointer output types etc.) for each resource.
 	// These are typically used to support external references.
 	GenerateResourceContainerTypes bool `json:"generateResourceContainerTypes,omitempty"`
+
+	// Generate typed mocks for each resource and function, for use with pulumi.WithMocks in unit tests, along with an
+	// example test for each package. Example tests are only generated for Go SDKs.
+	GenerateMocks bool `json:"generateMocks,omitempty"`
 }
 
 // Importer implements schema.Language for Go.
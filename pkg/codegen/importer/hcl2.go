@@ -36,6 +36,14 @@ var Null = &model.Variable{
 
 // GenerateHCL2Definition generates a Pulumi HCL2 definition for a given resource.
 func GenerateHCL2Definition(loader schema.Loader, state *resource.State, names NameTable) (*model.Block, error) {
+	return generateHCL2Definition(loader, state, names, nil)
+}
+
+// generateHCL2Definition generates a Pulumi HCL2 definition for a given resource. If refs is non-nil, input values that
+// are the IDs or outputs of other resources are generated as references to those resources.
+func generateHCL2Definition(loader schema.Loader, state *resource.State, names NameTable,
+	refs *resourceReferences) (*model.Block, error) {
+
 	// TODO: pull the package version from the resource's provider
 	pkg, err := loader.LoadPackage(string(state.Type.Package()), nil)
 	if err != nil {
@@ -49,7 +57,7 @@ func GenerateHCL2Definition(loader schema.Loader, state *resource.State, names N
 
 	var items []model.BodyItem
 	for _, p := range r.InputProperties {
-		x, err := generatePropertyValue(p, state.Inputs[resource.PropertyKey(p.Name)])
+		x, err := generatePropertyValue(p, state.Inputs[resource.PropertyKey(p.Name)], refs)
 		if err != nil {
 			return nil, err
 		}
@@ -61,7 +69,7 @@ func GenerateHCL2Definition(loader schema.Loader, state *resource.State, names N
 		}
 	}
 
-	resourceOptions, err := makeResourceOptions(state, names)
+	resourceOptions, err := makeResourceOptions(state, names, refs)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +111,7 @@ func appendResourceOption(block *model.Block, name string, value model.Expressio
 	return block
 }
 
-func makeResourceOptions(state *resource.State, names NameTable) (*model.Block, error) {
+func makeResourceOptions(state *resource.State, names NameTable, refs *resourceReferences) (*model.Block, error) {
 	var resourceOptions *model.Block
 	if state.Parent != "" && state.Parent.Type() != resource.RootStackType {
 		name, ok := names[state.Parent]
@@ -125,15 +133,19 @@ func makeResourceOptions(state *resource.State, names NameTable) (*model.Block,
 			resourceOptions = appendResourceOption(resourceOptions, "provider", newVariableReference(name))
 		}
 	}
-	if len(state.Dependencies) != 0 {
-		deps := make([]model.Expression, len(state.Dependencies))
-		for i, d := range state.Dependencies {
-			name, ok := names[d]
-			if !ok {
-				return nil, fmt.Errorf("no name for resource %v", d)
-			}
-			deps[i] = newVariableReference(name)
+	// Dependencies that are implied by references to other resources need not be listed explicitly.
+	var deps []model.Expression
+	for _, d := range state.Dependencies {
+		if refs.isReferenced(d) {
+			continue
 		}
+		name, ok := names[d]
+		if !ok {
+			return nil, fmt.Errorf("no name for resource %v", d)
+		}
+		deps = append(deps, newVariableReference(name))
+	}
+	if len(deps) != 0 {
 		resourceOptions = appendResourceOption(resourceOptions, "dependsOn", &model.TupleConsExpression{
 			Tokens:      syntax.NewTupleConsTokens(len(deps)),
 			Expressions: deps,
@@ -316,15 +328,15 @@ func zeroValue(t schema.Type) model.Expression {
 	}
 	switch t {
 	case schema.BoolType:
-		x, err := generateValue(t, resource.NewBoolProperty(false))
+		x, err := generateValue(t, resource.NewBoolProperty(false), nil)
 		contract.IgnoreError(err)
 		return x
 	case schema.IntType, schema.NumberType:
-		x, err := generateValue(t, resource.NewNumberProperty(0))
+		x, err := generateValue(t, resource.NewNumberProperty(0), nil)
 		contract.IgnoreError(err)
 		return x
 	case schema.StringType:
-		x, err := generateValue(t, resource.NewStringProperty(""))
+		x, err := generateValue(t, resource.NewStringProperty(""), nil)
 		contract.IgnoreError(err)
 		return x
 	case schema.ArchiveType, schema.AssetType:
@@ -340,7 +352,8 @@ func zeroValue(t schema.Type) model.Expression {
 // generatePropertyValue generates the value for the given property. If the value is absent and the property is
 // required, a zero value for the property's type is generated. If the value is absent and the property is not
 // required, no value is generated (i.e. this function returns nil).
-func generatePropertyValue(property *schema.Property, value resource.PropertyValue) (model.Expression, error) {
+func generatePropertyValue(property *schema.Property, value resource.PropertyValue,
+	refs *resourceReferences) (model.Expression, error) {
 	if !value.HasValue() {
 		if !property.IsRequired {
 			return nil, nil
@@ -348,12 +361,12 @@ func generatePropertyValue(property *schema.Property, value resource.PropertyVal
 		return zeroValue(property.Type), nil
 	}
 
-	return generateValue(property.Type, value)
+	return generateValue(property.Type, value, refs)
 }
 
 // generateValue generates a value from the given property value. The given type may or may not match the shape of the
-// given value.
-func generateValue(typ schema.Type, value resource.PropertyValue) (model.Expression, error) {
+// given value. If refs is non-nil, strings that are the IDs or outputs of other resources are generated as references.
+func generateValue(typ schema.Type, value resource.PropertyValue, refs *resourceReferences) (model.Expression, error) {
 	switch {
 	case value.IsArchive():
 		return nil, fmt.Errorf("NYI: archives")
@@ -366,7 +379,7 @@ func generateValue(typ schema.Type, value resource.PropertyValue) (model.Express
 		arr := value.ArrayValue()
 		exprs := make([]model.Expression, len(arr))
 		for i, v := range arr {
-			x, err := generateValue(elementType, v)
+			x, err := generateValue(elementType, v, refs)
 			if err != nil {
 				return nil, err
 			}
@@ -396,7 +409,7 @@ func generateValue(typ schema.Type, value resource.PropertyValue) (model.Express
 
 		if objectType, ok := typ.(*schema.ObjectType); ok {
 			for _, p := range objectType.Properties {
-				x, err := generatePropertyValue(p, obj[resource.PropertyKey(p.Name)])
+				x, err := generatePropertyValue(p, obj[resource.PropertyKey(p.Name)], refs)
 				if err != nil {
 					return nil, err
 				}
@@ -421,7 +434,7 @@ func generateValue(typ schema.Type, value resource.PropertyValue) (model.Express
 					continue
 				}
 
-				x, err := generateValue(elementType, obj[k])
+				x, err := generateValue(elementType, obj[k], refs)
 				if err != nil {
 					return nil, err
 				}
@@ -446,7 +459,7 @@ func generateValue(typ schema.Type, value resource.PropertyValue) (model.Express
 			Items:  items,
 		}, nil
 	case value.IsSecret():
-		arg, err := generateValue(typ, value.SecretValue().Element)
+		arg, err := generateValue(typ, value.SecretValue().Element, refs)
 		if err != nil {
 			return nil, err
 		}
@@ -462,6 +475,9 @@ func generateValue(typ schema.Type, value resource.PropertyValue) (model.Express
 			Args: []model.Expression{arg},
 		}, nil
 	case value.IsString():
+		if x, ok := refs.reference(value.StringValue()); ok {
+			return x, nil
+		}
 		return &model.TemplateExpression{
 			Parts: []model.Expression{
 				&model.LiteralValueExpression{
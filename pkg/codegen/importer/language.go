@@ -61,12 +61,22 @@ func (e *DiagnosticsError) String() string {
 }
 
 // GenerateLanguageDefintions generates a list of resource definitions from the given resource states.
+//
+// Input values that are the ID or an output of another resource in the given set are generated as references to that
+// resource, which implies a dependency upon it.
 func GenerateLanguageDefinitions(w io.Writer, loader schema.Loader, gen LanguageGenerator, states []*resource.State,
 	names NameTable) error {
 
+	// Values that are the IDs or outputs of other resources in the set are generated as references to those
+	// resources rather than as literals.
+	refs, err := newReferenceTable(loader, states)
+	if err != nil {
+		return err
+	}
+
 	var hcl2Text bytes.Buffer
 	for i, state := range states {
-		hcl2Def, err := GenerateHCL2Definition(loader, state, names)
+		hcl2Def, err := generateHCL2Definition(loader, state, names, refs.references(state.URN))
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,211 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// A reference identifies the ID or output property of a resource in a set of imported resources.
+type reference struct {
+	urn      resource.URN // the URN of the referenced resource.
+	name     string       // the name of the referenced resource's definition.
+	property string       // the referenced property, or "id" for the resource's ID.
+}
+
+// expression returns an expression that refers to the referenced value.
+func (r reference) expression() model.Expression {
+	return &model.ScopeTraversalExpression{
+		RootName:  r.name,
+		Traversal: hcl.Traversal{hcl.TraverseRoot{Name: r.name}, hcl.TraverseAttr{Name: r.property}},
+		Parts: []model.Traversable{
+			&model.Variable{Name: r.name, VariableType: model.DynamicType},
+			model.DynamicType,
+		},
+	}
+}
+
+// A referenceTable records the string values in a set of imported resources that are the IDs or outputs of other
+// resources in the set, so that these values can be generated as references rather than literals.
+//
+// A value is only replaced by a reference if it identifies a single resource: IDs take precedence over outputs, and
+// values that are the ID or output of several resources are left as literals. References that would introduce a cycle
+// between resources are also left as literals.
+type referenceTable struct {
+	ids     map[string][]reference
+	outputs map[string][]reference
+
+	// allowed records the references that each resource may make without introducing a cycle.
+	allowed map[resource.URN]map[resource.URN]bool
+}
+
+// newReferenceTable builds a reference table for the given set of resource states.
+func newReferenceTable(loader schema.Loader, states []*resource.State) (*referenceTable, error) {
+	table := &referenceTable{
+		ids:     map[string][]reference{},
+		outputs: map[string][]reference{},
+		allowed: map[resource.URN]map[resource.URN]bool{},
+	}
+
+	inSet := map[resource.URN]bool{}
+	for _, state := range states {
+		inSet[state.URN] = true
+	}
+
+	for _, state := range states {
+		name := string(state.URN.Name())
+		if state.ID != "" {
+			id := string(state.ID)
+			table.ids[id] = append(table.ids[id], reference{urn: state.URN, name: name, property: "id"})
+		}
+
+		pkg, err := loader.LoadPackage(string(state.Type.Package()), nil)
+		if err != nil {
+			return nil, err
+		}
+		r, ok := pkg.GetResource(string(state.Type))
+		if !ok {
+			continue
+		}
+		for _, p := range r.Properties {
+			v, ok := state.Outputs[resource.PropertyKey(p.Name)]
+			if !ok || !v.IsString() || v.StringValue() == "" || v.StringValue() == string(state.ID) {
+				continue
+			}
+			s := v.StringValue()
+			table.outputs[s] = append(table.outputs[s], reference{urn: state.URN, name: name, property: p.Name})
+		}
+	}
+
+	// Seed the dependency graph with each resource's parent and explicit dependencies, then admit references in order
+	// as long as they do not introduce a cycle.
+	edges := map[resource.URN]map[resource.URN]bool{}
+	addEdge := func(from, to resource.URN) {
+		if edges[from] == nil {
+			edges[from] = map[resource.URN]bool{}
+		}
+		edges[from][to] = true
+	}
+	for _, state := range states {
+		if inSet[state.Parent] {
+			addEdge(state.URN, state.Parent)
+		}
+		for _, dep := range state.Dependencies {
+			if inSet[dep] {
+				addEdge(state.URN, dep)
+			}
+		}
+	}
+
+	var reachable func(from, to resource.URN, visited map[resource.URN]bool) bool
+	reachable = func(from, to resource.URN, visited map[resource.URN]bool) bool {
+		if from == to {
+			return true
+		}
+		visited[from] = true
+		for next := range edges[from] {
+			if !visited[next] && reachable(next, to, visited) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, state := range states {
+		allowed := map[resource.URN]bool{}
+		walkStrings(resource.NewObjectProperty(state.Inputs), func(s string) {
+			ref, ok := table.lookup(s)
+			if !ok || ref.urn == state.URN || allowed[ref.urn] {
+				return
+			}
+			if reachable(ref.urn, state.URN, map[resource.URN]bool{}) {
+				return
+			}
+			addEdge(state.URN, ref.urn)
+			allowed[ref.urn] = true
+		})
+		table.allowed[state.URN] = allowed
+	}
+
+	return table, nil
+}
+
+// lookup returns the unambiguous reference for the given value, if any.
+func (t *referenceTable) lookup(value string) (reference, bool) {
+	if refs := t.ids[value]; len(refs) != 0 {
+		return refs[0], len(refs) == 1
+	}
+	if refs := t.outputs[value]; len(refs) == 1 {
+		return refs[0], true
+	}
+	return reference{}, false
+}
+
+// references returns the view of the table for the resource with the given URN.
+func (t *referenceTable) references(urn resource.URN) *resourceReferences {
+	if t == nil {
+		return nil
+	}
+	return &resourceReferences{table: t, urn: urn, used: map[resource.URN]bool{}}
+}
+
+// resourceReferences is the view of a reference table for a single resource. It records the resources that are
+// referenced by the resource's definition.
+type resourceReferences struct {
+	table *referenceTable
+	urn   resource.URN
+	used  map[resource.URN]bool
+}
+
+// reference returns an expression that refers to the given value, if the value may be replaced by a reference.
+func (r *resourceReferences) reference(value string) (model.Expression, bool) {
+	if r == nil {
+		return nil, false
+	}
+	ref, ok := r.table.lookup(value)
+	if !ok || !r.table.allowed[r.urn][ref.urn] {
+		return nil, false
+	}
+	r.used[ref.urn] = true
+	return ref.expression(), true
+}
+
+// isReferenced returns true if the resource's definition refers to the resource with the given URN.
+func (r *resourceReferences) isReferenced(urn resource.URN) bool {
+	return r != nil && r.used[urn]
+}
+
+// walkStrings calls visit for each string in the given property value, including those in secrets.
+func walkStrings(v resource.PropertyValue, visit func(s string)) {
+	switch {
+	case v.IsString():
+		visit(v.StringValue())
+	case v.IsArray():
+		for _, e := range v.ArrayValue() {
+			walkStrings(e, visit)
+		}
+	case v.IsObject():
+		obj := v.ObjectValue()
+		for _, k := range obj.StableKeys() {
+			walkStrings(obj[k], visit)
+		}
+	case v.IsSecret():
+		walkStrings(v.SecretValue().Element, visit)
+	}
+}
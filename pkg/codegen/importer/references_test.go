@@ -0,0 +1,134 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/internal/test"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+func referenceTestStates() []*resource.State {
+	newState := func(typ tokens.Type, name tokens.QName, id resource.ID, inputs, outputs resource.PropertyMap,
+		deps ...resource.URN) *resource.State {
+		return &resource.State{
+			Type:         typ,
+			URN:          resource.NewURN("stack", "project", "", typ, name),
+			Custom:       true,
+			ID:           id,
+			Inputs:       inputs,
+			Outputs:      outputs,
+			Dependencies: deps,
+		}
+	}
+
+	vpcURN := resource.NewURN("stack", "project", "", "aws:ec2/vpc:Vpc", "vpc")
+	return []*resource.State{
+		newState("aws:ec2/vpc:Vpc", "vpc", "vpc-1", resource.PropertyMap{
+			"cidrBlock": resource.NewStringProperty("10.0.0.0/16"),
+			"tags": resource.NewObjectProperty(resource.PropertyMap{
+				"subnet": resource.NewStringProperty("subnet-1"),
+			}),
+		}, resource.PropertyMap{
+			"arn": resource.NewStringProperty("arn:aws:ec2:us-west-2:123456789012:vpc/vpc-1"),
+		}),
+		newState("aws:ec2/subnet:Subnet", "subnet", "subnet-1", resource.PropertyMap{
+			"cidrBlock": resource.NewStringProperty("10.0.1.0/24"),
+			"vpcId":     resource.NewStringProperty("vpc-1"),
+		}, resource.PropertyMap{
+			"arn": resource.NewStringProperty("arn:aws:ec2:us-west-2:123456789012:subnet/subnet-1"),
+		}, vpcURN),
+		newState("aws:ec2/securityGroup:SecurityGroup", "group", "sg-1", resource.PropertyMap{
+			"description": resource.NewStringProperty("arn:aws:ec2:us-west-2:123456789012:vpc/vpc-1"),
+			"vpcId":       resource.MakeSecret(resource.NewStringProperty("vpc-1")),
+			"name":        resource.NewStringProperty("sg-2"),
+		}, nil),
+		newState("aws:ec2/securityGroup:SecurityGroup", "group2", "sg-2", resource.PropertyMap{
+			"name": resource.NewStringProperty("sg-1"),
+		}, nil),
+	}
+}
+
+func TestGenerateHCL2DefinitionReferences(t *testing.T) {
+	loader := schema.NewPluginLoader(test.NewHost(testdataPath))
+	states := referenceTestStates()
+
+	refs, err := newReferenceTable(loader, states)
+	require.NoError(t, err)
+
+	generate := func(state *resource.State) string {
+		block, err := generateHCL2Definition(loader, state, NameTable{}, refs.references(state.URN))
+		require.NoError(t, err)
+		return fmt.Sprintf("%v", block)
+	}
+
+	// The subnet's explicit dependency upon the VPC means that the VPC may not refer to the subnet. The subnet's
+	// reference to the VPC implies its dependency, so no dependsOn option is generated.
+	vpc := generate(states[0])
+	assert.Contains(t, vpc, `subnet = "subnet-1"`)
+	assert.Contains(t, vpc, `cidrBlock = "10.0.0.0/16"`)
+
+	subnet := generate(states[1])
+	assert.Contains(t, subnet, "vpcId = vpc.id")
+	assert.NotContains(t, subnet, "dependsOn")
+
+	// Outputs may be referenced as well as IDs, including from within secrets.
+	group := generate(states[2])
+	assert.Contains(t, group, "description = vpc.arn")
+	assert.Contains(t, group, "vpcId = secret(vpc.id)")
+
+	// References between resources of the same type are fine, but not if they form a cycle.
+	assert.Contains(t, group, "name = group2.id")
+	assert.Contains(t, generate(states[3]), `name = "sg-1"`)
+}
+
+func TestGenerateLanguageDefinitionsReferences(t *testing.T) {
+	loader := schema.NewPluginLoader(test.NewHost(testdataPath))
+	states := referenceTestStates()
+
+	err := GenerateLanguageDefinitions(ioutil.Discard, loader, func(_ io.Writer, p *hcl2.Program) error {
+		resources := map[string]*hcl2.Resource{}
+		order := map[string]int{}
+		for i, n := range hcl2.Linearize(p) {
+			if r, ok := n.(*hcl2.Resource); ok {
+				resources[r.Name()], order[r.Name()] = r, i
+			}
+		}
+		require.Len(t, resources, 4)
+
+		// The subnet's reference to the VPC implies its dependency, so no dependsOn option is generated.
+		subnet := resources["subnet"]
+		assert.Less(t, order["vpc"], order["subnet"])
+		assert.True(t, subnet.Options == nil || subnet.Options.DependsOn == nil)
+		for _, attr := range subnet.Inputs {
+			if attr.Name == "vpcId" {
+				assert.Equal(t, "vpc.id", strings.TrimSpace(fmt.Sprintf("%v", attr.Value)))
+			}
+		}
+		return nil
+	}, states, NameTable{})
+	assert.NoError(t, err)
+}
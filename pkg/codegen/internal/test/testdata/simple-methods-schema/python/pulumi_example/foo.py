@@ -0,0 +1,132 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['FooArgs', 'Foo']
+
+@pulumi.input_type
+class FooArgs:
+    def __init__(__self__, *,
+                 name: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a Foo resource.
+        """
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+
+class Foo(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        A component with methods.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[FooArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A component with methods.
+
+        :param str resource_name: The name of the resource.
+        :param FooArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(FooArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = FooArgs.__new__(FooArgs)
+
+            __props__.__dict__["name"] = name
+        super(Foo, __self__).__init__(
+            'example::Foo',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[Optional[str]]:
+        return pulumi.get(self, "name")
+
+    @pulumi.output_type
+    class BarResult(dict):
+        """
+        The results of the Foo.bar method.
+        """
+        def __init__(__self__, *,
+                     some_value: str):
+            """
+            The results of the Foo.bar method.
+            """
+            pulumi.set(__self__, "some_value", some_value)
+
+        @property
+        @pulumi.getter(name="someValue")
+        def some_value(self) -> str:
+            return pulumi.get(self, "some_value")
+
+    def bar(__self__, *,
+            string_value: pulumi.Input[str],
+            bool_value: Optional[pulumi.Input[bool]] = None) -> pulumi.Output['Foo.BarResult']:
+        """
+        Returns a value computed from the component.
+        """
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        __args__['stringValue'] = string_value
+        __args__['boolValue'] = bool_value
+        __result__ = pulumi.runtime.call('example::Foo/bar', __args__, res=__self__, typ=Foo.BarResult)
+        return __result__
+
+    def baz(__self__) -> None:
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        pulumi.runtime.call('example::Foo/baz', __args__, res=__self__)
+
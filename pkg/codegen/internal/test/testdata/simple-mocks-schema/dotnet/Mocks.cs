@@ -0,0 +1,76 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System.Collections.Immutable;
+using Pulumi.Testing;
+
+namespace Pulumi.Example
+{
+    /// <summary>
+    /// Typed mocks of the resources and functions in this namespace, for use in implementations of
+    /// <see cref="IMocks"/> in unit tests.
+    /// </summary>
+    public static class Mocks
+    {
+        /// <summary>
+        /// Returns the ID and state of a mocked Provider resource, for use in
+        /// <see cref="IMocks.NewResourceAsync"/>. Properties that are not given are taken from the resource's
+        /// inputs. If no ID is given, one is derived from the resource's name.
+        /// </summary>
+        /// <param name="args">The arguments of the mocked resource registration.</param>
+        /// <param name="id">The physical ID of the mocked resource.</param>
+        public static (string? id, object state) MockProvider(
+            MockResourceArgs args,
+            string? id = null)
+        {
+            var state = args.Inputs.ToBuilder();
+            return (id ?? args.Id ?? $"{args.Name}_id", state.ToImmutable());
+        }
+
+        /// <summary>
+        /// Returns the ID and state of a mocked Widget resource, for use in
+        /// <see cref="IMocks.NewResourceAsync"/>. Properties that are not given are taken from the resource's
+        /// inputs. If no ID is given, one is derived from the resource's name.
+        /// </summary>
+        /// <param name="args">The arguments of the mocked resource registration.</param>
+        /// <param name="id">The physical ID of the mocked resource.</param>
+        /// <param name="endpoint">The endpoint at which the widget is served.</param>
+        /// <param name="widgetName">The name of the widget.</param>
+        public static (string? id, object state) MockWidget(
+            MockResourceArgs args,
+            string? id = null,
+            ImmutableDictionary<string, object>? endpoint = null,
+            ImmutableDictionary<string, string>? tags = null,
+            string? widgetName = null)
+        {
+            var state = args.Inputs.ToBuilder();
+            if (endpoint != null)
+            {
+                state["endpoint"] = endpoint;
+            }
+            if (tags != null)
+            {
+                state["tags"] = tags;
+            }
+            if (widgetName != null)
+            {
+                state["widgetName"] = widgetName;
+            }
+            return (id ?? args.Id ?? $"{args.Name}_id", state.ToImmutable());
+        }
+
+        /// <summary>
+        /// Returns the outputs of a mocked call to GetWidgetCount, for use in <see cref="IMocks.CallAsync"/>.
+        /// </summary>
+        public static object MockGetWidgetCount(
+            int? count = null)
+        {
+            var result = ImmutableDictionary.CreateBuilder<string, object>();
+            if (count != null)
+            {
+                result["count"] = count;
+            }
+            return result.ToImmutable();
+        }
+    }
+}
@@ -0,0 +1,25 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package example
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func GetWidgetCount(ctx *pulumi.Context, args *GetWidgetCountArgs, opts ...pulumi.InvokeOption) (*GetWidgetCountResult, error) {
+	var rv GetWidgetCountResult
+	err := ctx.Invoke("example::getWidgetCount", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetWidgetCountArgs struct {
+	Prefix *string `pulumi:"prefix"`
+}
+
+type GetWidgetCountResult struct {
+	Count int `pulumi:"count"`
+}
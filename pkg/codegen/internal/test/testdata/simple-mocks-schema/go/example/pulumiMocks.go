@@ -0,0 +1,49 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package example
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// MockProvider describes the state of a mocked Provider resource. Its NewResource method may be used to
+// implement the NewResource method of a pulumi.MockResourceMonitor.
+type MockProvider struct {
+	// ID is the physical ID of the mocked resource. If empty, an ID is derived from the resource's name.
+	ID string
+}
+
+// NewResource returns the ID and output properties of a mocked Provider resource. Output properties that
+// are not set on the mock are taken from the resource's inputs.
+func (m MockProvider) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	return pulumi.MockResourceState(args, m.ID, m)
+}
+
+// MockWidget describes the state of a mocked Widget resource. Its NewResource method may be used to
+// implement the NewResource method of a pulumi.MockResourceMonitor.
+type MockWidget struct {
+	// ID is the physical ID of the mocked resource. If empty, an ID is derived from the resource's name.
+	ID string
+	// The endpoint at which the widget is served.
+	Endpoint *Endpoint         `pulumi:"endpoint"`
+	Tags     map[string]string `pulumi:"tags"`
+	// The name of the widget.
+	WidgetName *string `pulumi:"widgetName"`
+}
+
+// NewResource returns the ID and output properties of a mocked Widget resource. Output properties that
+// are not set on the mock are taken from the resource's inputs.
+func (m MockWidget) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	return pulumi.MockResourceState(args, m.ID, m)
+}
+
+// MockGetWidgetCount describes the result of a mocked call to GetWidgetCount. Its Call method may be used to
+// implement the Call method of a pulumi.MockResourceMonitor.
+type MockGetWidgetCount GetWidgetCountResult
+
+// Call returns the outputs of a mocked call to GetWidgetCount.
+func (m MockGetWidgetCount) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	return pulumi.MockCallResult(m)
+}
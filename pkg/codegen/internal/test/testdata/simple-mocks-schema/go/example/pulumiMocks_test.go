@@ -0,0 +1,49 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package example
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// mocks dispatches resource registrations and function calls to the typed mocks in this package.
+type mocks struct{}
+
+func (mocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	switch args.TypeToken {
+	case "pulumi:providers:example":
+		return MockProvider{}.NewResource(args)
+	case "example::Widget":
+		return MockWidget{}.NewResource(args)
+	default:
+		return args.Name + "_id", args.Inputs, nil
+	}
+}
+
+func (mocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	switch args.Token {
+	case "example::getWidgetCount":
+		return MockGetWidgetCount{}.Call(args)
+	default:
+		return args.Args, nil
+	}
+}
+
+func TestMocks(t *testing.T) {
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		if _, err := NewProvider(ctx, "provider", &ProviderArgs{}); err != nil {
+			return err
+		}
+		if _, err := NewWidget(ctx, "widget", &WidgetArgs{}); err != nil {
+			return err
+		}
+		return nil
+	}, pulumi.WithMocks("project", "stack", mocks{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,157 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package example
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type Endpoint struct {
+	Host string `pulumi:"host"`
+	Port *int   `pulumi:"port"`
+}
+
+// EndpointInput is an input type that accepts EndpointArgs and EndpointOutput values.
+// You can construct a concrete instance of `EndpointInput` via:
+//
+//	EndpointArgs{...}
+type EndpointInput interface {
+	pulumi.Input
+
+	ToEndpointOutput() EndpointOutput
+	ToEndpointOutputWithContext(context.Context) EndpointOutput
+}
+
+type EndpointArgs struct {
+	Host pulumi.StringInput `pulumi:"host"`
+	Port pulumi.IntPtrInput `pulumi:"port"`
+}
+
+func (EndpointArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Endpoint)(nil)).Elem()
+}
+
+func (i EndpointArgs) ToEndpointOutput() EndpointOutput {
+	return i.ToEndpointOutputWithContext(context.Background())
+}
+
+func (i EndpointArgs) ToEndpointOutputWithContext(ctx context.Context) EndpointOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EndpointOutput)
+}
+
+func (i EndpointArgs) ToEndpointPtrOutput() EndpointPtrOutput {
+	return i.ToEndpointPtrOutputWithContext(context.Background())
+}
+
+func (i EndpointArgs) ToEndpointPtrOutputWithContext(ctx context.Context) EndpointPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EndpointOutput).ToEndpointPtrOutputWithContext(ctx)
+}
+
+// EndpointPtrInput is an input type that accepts EndpointArgs, EndpointPtr and EndpointPtrOutput values.
+// You can construct a concrete instance of `EndpointPtrInput` via:
+//
+//	        EndpointArgs{...}
+//
+//	or:
+//
+//	        nil
+type EndpointPtrInput interface {
+	pulumi.Input
+
+	ToEndpointPtrOutput() EndpointPtrOutput
+	ToEndpointPtrOutputWithContext(context.Context) EndpointPtrOutput
+}
+
+type endpointPtrType EndpointArgs
+
+func EndpointPtr(v *EndpointArgs) EndpointPtrInput {
+	return (*endpointPtrType)(v)
+}
+
+func (*endpointPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Endpoint)(nil)).Elem()
+}
+
+func (i *endpointPtrType) ToEndpointPtrOutput() EndpointPtrOutput {
+	return i.ToEndpointPtrOutputWithContext(context.Background())
+}
+
+func (i *endpointPtrType) ToEndpointPtrOutputWithContext(ctx context.Context) EndpointPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EndpointPtrOutput)
+}
+
+type EndpointOutput struct{ *pulumi.OutputState }
+
+func (EndpointOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Endpoint)(nil)).Elem()
+}
+
+func (o EndpointOutput) ToEndpointOutput() EndpointOutput {
+	return o
+}
+
+func (o EndpointOutput) ToEndpointOutputWithContext(ctx context.Context) EndpointOutput {
+	return o
+}
+
+func (o EndpointOutput) ToEndpointPtrOutput() EndpointPtrOutput {
+	return o.ToEndpointPtrOutputWithContext(context.Background())
+}
+
+func (o EndpointOutput) ToEndpointPtrOutputWithContext(ctx context.Context) EndpointPtrOutput {
+	return o.ApplyT(func(v Endpoint) *Endpoint {
+		return &v
+	}).(EndpointPtrOutput)
+}
+func (o EndpointOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v Endpoint) string { return v.Host }).(pulumi.StringOutput)
+}
+
+func (o EndpointOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Endpoint) *int { return v.Port }).(pulumi.IntPtrOutput)
+}
+
+type EndpointPtrOutput struct{ *pulumi.OutputState }
+
+func (EndpointPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Endpoint)(nil)).Elem()
+}
+
+func (o EndpointPtrOutput) ToEndpointPtrOutput() EndpointPtrOutput {
+	return o
+}
+
+func (o EndpointPtrOutput) ToEndpointPtrOutputWithContext(ctx context.Context) EndpointPtrOutput {
+	return o
+}
+
+func (o EndpointPtrOutput) Elem() EndpointOutput {
+	return o.ApplyT(func(v *Endpoint) Endpoint { return *v }).(EndpointOutput)
+}
+
+func (o EndpointPtrOutput) Host() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Host
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o EndpointPtrOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Endpoint) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Port
+	}).(pulumi.IntPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(EndpointOutput{})
+	pulumi.RegisterOutputType(EndpointPtrOutput{})
+}
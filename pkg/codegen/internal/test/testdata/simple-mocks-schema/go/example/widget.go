@@ -0,0 +1,123 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package example
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type Widget struct {
+	pulumi.CustomResourceState
+
+	// The endpoint at which the widget is served.
+	Endpoint EndpointOutput         `pulumi:"endpoint"`
+	Tags     pulumi.StringMapOutput `pulumi:"tags"`
+	// The name of the widget.
+	WidgetName pulumi.StringOutput `pulumi:"widgetName"`
+}
+
+// NewWidget registers a new resource with the given unique name, arguments, and options.
+func NewWidget(ctx *pulumi.Context,
+	name string, args *WidgetArgs, opts ...pulumi.ResourceOption) (*Widget, error) {
+	if args == nil {
+		args = &WidgetArgs{}
+	}
+
+	var resource Widget
+	err := ctx.RegisterResource("example::Widget", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetWidget gets an existing Widget resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetWidget(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *WidgetState, opts ...pulumi.ResourceOption) (*Widget, error) {
+	var resource Widget
+	err := ctx.ReadResource("example::Widget", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Widget resources.
+type widgetState struct {
+	// The endpoint at which the widget is served.
+	Endpoint *Endpoint         `pulumi:"endpoint"`
+	Tags     map[string]string `pulumi:"tags"`
+	// The name of the widget.
+	WidgetName *string `pulumi:"widgetName"`
+}
+
+type WidgetState struct {
+	// The endpoint at which the widget is served.
+	Endpoint EndpointPtrInput
+	Tags     pulumi.StringMapInput
+	// The name of the widget.
+	WidgetName pulumi.StringPtrInput
+}
+
+func (WidgetState) ElementType() reflect.Type {
+	return reflect.TypeOf((*widgetState)(nil)).Elem()
+}
+
+type widgetArgs struct {
+	Tags       map[string]string `pulumi:"tags"`
+	WidgetName *string           `pulumi:"widgetName"`
+}
+
+// The set of arguments for constructing a Widget resource.
+type WidgetArgs struct {
+	Tags       pulumi.StringMapInput
+	WidgetName pulumi.StringPtrInput
+}
+
+func (WidgetArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*widgetArgs)(nil)).Elem()
+}
+
+type WidgetInput interface {
+	pulumi.Input
+
+	ToWidgetOutput() WidgetOutput
+	ToWidgetOutputWithContext(ctx context.Context) WidgetOutput
+}
+
+func (*Widget) ElementType() reflect.Type {
+	return reflect.TypeOf((*Widget)(nil))
+}
+
+func (i *Widget) ToWidgetOutput() WidgetOutput {
+	return i.ToWidgetOutputWithContext(context.Background())
+}
+
+func (i *Widget) ToWidgetOutputWithContext(ctx context.Context) WidgetOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WidgetOutput)
+}
+
+type WidgetOutput struct {
+	*pulumi.OutputState
+}
+
+func (WidgetOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Widget)(nil))
+}
+
+func (o WidgetOutput) ToWidgetOutput() WidgetOutput {
+	return o
+}
+
+func (o WidgetOutput) ToWidgetOutputWithContext(ctx context.Context) WidgetOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterOutputType(WidgetOutput{})
+}
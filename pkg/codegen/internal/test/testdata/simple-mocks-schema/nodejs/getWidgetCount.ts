@@ -0,0 +1,28 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+import * as utilities from "./utilities";
+
+export function getWidgetCount(args?: GetWidgetCountArgs, opts?: pulumi.InvokeOptions): Promise<GetWidgetCountResult> {
+    args = args || {};
+    if (!opts) {
+        opts = {}
+    }
+
+    if (!opts.version) {
+        opts.version = utilities.getVersion();
+    }
+    return pulumi.runtime.invoke("example::getWidgetCount", {
+        "prefix": args.prefix,
+    }, opts);
+}
+
+export interface GetWidgetCountArgs {
+    prefix?: string;
+}
+
+export interface GetWidgetCountResult {
+    readonly count: number;
+}
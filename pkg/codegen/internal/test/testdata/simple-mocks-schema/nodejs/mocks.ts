@@ -0,0 +1,60 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+
+import {GetWidgetCountResult} from "./getWidgetCount";
+
+/**
+ * The state of a mocked Provider resource.
+ */
+export interface ProviderMockState {
+}
+
+/**
+ * Returns the ID and state of a mocked Provider resource, for use in the `newResource` method of a
+ * `pulumi.runtime.Mocks` implementation. Properties that are not set in the given state are taken from the
+ * resource's inputs. If no ID is given, one is derived from the resource's name.
+ */
+export function mockProvider(args: pulumi.runtime.MockResourceArgs, state?: ProviderMockState, id?: string): { id: string, state: Record<string, any> } {
+    return {
+        id: id || args.id || `${args.name}_id`,
+        state: { ...args.inputs, ...state },
+    };
+}
+
+/**
+ * The state of a mocked Widget resource.
+ */
+export interface WidgetMockState {
+    /**
+     * The endpoint at which the widget is served.
+     */
+    readonly endpoint?: outputs.Endpoint;
+    readonly tags?: {[key: string]: string};
+    /**
+     * The name of the widget.
+     */
+    readonly widgetName?: string;
+}
+
+/**
+ * Returns the ID and state of a mocked Widget resource, for use in the `newResource` method of a
+ * `pulumi.runtime.Mocks` implementation. Properties that are not set in the given state are taken from the
+ * resource's inputs. If no ID is given, one is derived from the resource's name.
+ */
+export function mockWidget(args: pulumi.runtime.MockResourceArgs, state?: WidgetMockState, id?: string): { id: string, state: Record<string, any> } {
+    return {
+        id: id || args.id || `${args.name}_id`,
+        state: { ...args.inputs, ...state },
+    };
+}
+
+/**
+ * Returns the outputs of a mocked call to getWidgetCount, for use in the `call` method of a `pulumi.runtime.Mocks`
+ * implementation.
+ */
+export function mockGetWidgetCount(result: GetWidgetCountResult): Record<string, any> {
+    return result;
+}
@@ -0,0 +1,77 @@
+// *** WARNING: this file was generated by test. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs } from "./types";
+import * as utilities from "./utilities";
+
+export class Widget extends pulumi.CustomResource {
+    /**
+     * Get an existing Widget resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Widget {
+        return new Widget(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'example::Widget';
+
+    /**
+     * Returns true if the given object is an instance of Widget.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Widget {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Widget.__pulumiType;
+    }
+
+    /**
+     * The endpoint at which the widget is served.
+     */
+    public /*out*/ readonly endpoint!: pulumi.Output<outputs.Endpoint>;
+    public readonly tags!: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * The name of the widget.
+     */
+    public readonly widgetName!: pulumi.Output<string>;
+
+    /**
+     * Create a Widget resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: WidgetArgs, opts?: pulumi.CustomResourceOptions) {
+        let inputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            inputs["tags"] = args ? args.tags : undefined;
+            inputs["widgetName"] = args ? args.widgetName : undefined;
+            inputs["endpoint"] = undefined /*out*/;
+        } else {
+            inputs["endpoint"] = undefined /*out*/;
+            inputs["tags"] = undefined /*out*/;
+            inputs["widgetName"] = undefined /*out*/;
+        }
+        if (!opts.version) {
+            opts = pulumi.mergeOptions(opts, { version: utilities.getVersion()});
+        }
+        super(Widget.__pulumiType, name, inputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Widget resource.
+ */
+export interface WidgetArgs {
+    tags?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    widgetName?: pulumi.Input<string>;
+}
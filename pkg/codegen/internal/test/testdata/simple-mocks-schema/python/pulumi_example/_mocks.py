@@ -0,0 +1,71 @@
+# coding=utf-8
+# *** WARNING: this file was generated by test. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+
+from typing import Tuple
+
+__all__ = [
+    'mock_provider',
+    'mock_widget',
+    'mock_get_widget_count',
+]
+
+
+def mock_provider(args: pulumi.runtime.MockResourceArgs,
+                  *,
+                  id: Optional[str] = None) -> Tuple[str, dict]:
+    """
+    Returns the ID and state of a mocked Provider resource, for use in the `new_resource` method of a
+    `pulumi.runtime.Mocks` implementation. Properties that are not given are taken from the resource's
+    inputs. If no ID is given, one is derived from the resource's name.
+
+    :param pulumi.runtime.MockResourceArgs args: The arguments of the mocked resource registration.
+    :param str id: The physical ID of the mocked resource.
+    """
+    state = dict(args.inputs)
+    return id or args.resource_id or f"{args.name}_id", state
+
+
+def mock_widget(args: pulumi.runtime.MockResourceArgs,
+                *,
+                id: Optional[str] = None,
+                endpoint: Optional['outputs.Endpoint'] = None,
+                tags: Optional[Mapping[str, str]] = None,
+                widget_name: Optional[str] = None) -> Tuple[str, dict]:
+    """
+    Returns the ID and state of a mocked Widget resource, for use in the `new_resource` method of a
+    `pulumi.runtime.Mocks` implementation. Properties that are not given are taken from the resource's
+    inputs. If no ID is given, one is derived from the resource's name.
+
+    :param pulumi.runtime.MockResourceArgs args: The arguments of the mocked resource registration.
+    :param str id: The physical ID of the mocked resource.
+    :param 'outputs.Endpoint' endpoint: The endpoint at which the widget is served.
+    :param str widget_name: The name of the widget.
+    """
+    state = dict(args.inputs)
+    if endpoint is not None:
+        state["endpoint"] = endpoint
+    if tags is not None:
+        state["tags"] = tags
+    if widget_name is not None:
+        state["widgetName"] = widget_name
+    return id or args.resource_id or f"{args.name}_id", state
+
+
+def mock_get_widget_count(*,
+                          count: Optional[int] = None) -> dict:
+    """
+    Returns the outputs of a mocked call to get_widget_count, for use in the `call` method of a
+    `pulumi.runtime.Mocks` implementation.
+    """
+    result = dict()
+    if count is not None:
+        result["count"] = count
+    return result
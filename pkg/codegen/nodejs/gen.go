@@ -84,6 +84,7 @@ type modContext struct {
 	modToPkg                map[string]string // Module name -> package name
 	compatibility           string            // Toggle compatibility mode for a specified target.
 	disableUnionOutputTypes bool              // Disable unions in output types.
+	generateMocks           bool              // Generate typed mocks for resources and functions.
 }
 
 func (mod *modContext) String() string {
@@ -202,6 +203,14 @@ func (mod *modContext) resourceFileName(r *schema.Resource) string {
 	return fileName
 }
 
+func (mod *modContext) functionFileName(f *schema.Function) string {
+	fileName := camel(tokenToName(f.Token)) + ".ts"
+	if mod.isReservedSourceFileName(fileName) {
+		fileName = camel(tokenToName(f.Token)) + "_.ts"
+	}
+	return fileName
+}
+
 func tokenToFunctionName(tok string) string {
 	return camel(tokenToName(tok))
 }
@@ -707,8 +716,14 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 		fmt.Fprintf(w, "        super(%s.__pulumiType, name, inputs, opts);\n", name)
 	}
 
-	// Finish the class.
 	fmt.Fprintf(w, "    }\n")
+
+	// Emit the methods.
+	for _, method := range r.Methods {
+		mod.genMethod(w, name, method)
+	}
+
+	// Finish the class.
 	fmt.Fprintf(w, "}\n")
 
 	// Emit the state type for get methods.
@@ -722,9 +737,94 @@ func (mod *modContext) genResource(w io.Writer, r *schema.Resource) error {
 	argsComment := fmt.Sprintf("The set of arguments for constructing a %s resource.", name)
 	mod.genPlainType(w, argsType, argsComment, r.InputProperties, true, true, false, 0)
 
+	// Emit the argument and result types of the methods.
+	var methodTypes []string
+	for _, method := range r.Methods {
+		methodName := title(method.Name)
+		if args := methodArgs(method); len(args) > 0 {
+			var buf bytes.Buffer
+			comment := fmt.Sprintf("The set of arguments for the %s.%s method.", name, method.Name)
+			mod.genPlainType(&buf, methodName+"Args", comment, args, true, true, false, 1)
+			methodTypes = append(methodTypes, buf.String())
+		}
+		if method.Function.Outputs != nil {
+			var buf bytes.Buffer
+			comment := fmt.Sprintf("The results of the %s.%s method.", name, method.Name)
+			mod.genPlainType(&buf, methodName+"Result", comment, method.Function.Outputs.Properties, false, false,
+				true, 1)
+			methodTypes = append(methodTypes, buf.String())
+		}
+	}
+	if len(methodTypes) > 0 {
+		fmt.Fprintf(w, "\n")
+		fmt.Fprintf(w, "export namespace %s {\n", name)
+		fmt.Fprintf(w, "%s", strings.Join(methodTypes, "\n"))
+		fmt.Fprintf(w, "}\n")
+	}
+
 	return nil
 }
 
+// methodArgs returns the arguments of a method, excluding the `__self__` argument.
+func methodArgs(method *schema.Method) []*schema.Property {
+	var args []*schema.Property
+	if method.Function.Inputs != nil {
+		for _, p := range method.Function.Inputs.Properties {
+			if p.Name != "__self__" {
+				args = append(args, p)
+			}
+		}
+	}
+	return args
+}
+
+// genMethod emits a method of a resource class. The method calls the provider with the resource as its `__self__`
+// argument and returns the result as an output.
+func (mod *modContext) genMethod(w io.Writer, resourceName string, method *schema.Method) {
+	fun, methodName := method.Function, title(method.Name)
+	args := methodArgs(method)
+
+	fmt.Fprintf(w, "\n")
+	printComment(w, codegen.FilterExamples(fun.Comment, "typescript"), fun.DeprecationMessage, "    ")
+
+	argsOptional := true
+	for _, p := range args {
+		if p.IsRequired {
+			argsOptional = false
+		}
+	}
+
+	var argsig string
+	if len(args) > 0 {
+		optFlag := ""
+		if argsOptional {
+			optFlag = "?"
+		}
+		argsig = fmt.Sprintf("args%s: %s.%sArgs", optFlag, resourceName, methodName)
+	}
+
+	retty := "void"
+	if fun.Outputs != nil {
+		retty = fmt.Sprintf("%s.%sResult", resourceName, methodName)
+	}
+
+	fmt.Fprintf(w, "    %s(%s): pulumi.Output<%s> {\n", method.Name, argsig, retty)
+	if fun.DeprecationMessage != "" && mod.compatibility != kubernetes20 {
+		fmt.Fprintf(w, "        pulumi.log.warn(\"%s.%s is deprecated: %s\")\n", resourceName, method.Name,
+			fun.DeprecationMessage)
+	}
+	if len(args) > 0 && argsOptional {
+		fmt.Fprintf(w, "        args = args || {};\n")
+	}
+	fmt.Fprintf(w, "        return pulumi.runtime.call(\"%s\", {\n", fun.Token)
+	fmt.Fprintf(w, "            \"__self__\": this,\n")
+	for _, p := range args {
+		fmt.Fprintf(w, "            \"%[1]s\": args.%[1]s,\n", p.Name)
+	}
+	fmt.Fprintf(w, "        }, this);\n")
+	fmt.Fprintf(w, "    }\n")
+}
+
 func (mod *modContext) genFunction(w io.Writer, fun *schema.Function) {
 	name := tokenToFunctionName(fun.Token)
 
@@ -940,6 +1040,16 @@ func (mod *modContext) getImports(member interface{}, externalImports codegen.St
 		for _, p := range member.InputProperties {
 			needsTypes = mod.getTypeImports(p.Type, false, externalImports, imports, seen) || needsTypes
 		}
+		for _, method := range member.Methods {
+			for _, p := range methodArgs(method) {
+				needsTypes = mod.getTypeImports(p.Type, false, externalImports, imports, seen) || needsTypes
+			}
+			if method.Function.Outputs != nil {
+				for _, p := range method.Function.Outputs.Properties {
+					needsTypes = mod.getTypeImports(p.Type, false, externalImports, imports, seen) || needsTypes
+				}
+			}
+		}
 		return needsTypes
 	case *schema.Function:
 		needsTypes := false
@@ -1221,6 +1331,8 @@ func (mod *modContext) isReservedSourceFileName(name string) bool {
 		return mod.mod == ""
 	case "vars.ts":
 		return len(mod.pkg.Config) > 0
+	case "mocks.ts":
+		return mod.generateMocks && mod.hasMocks()
 	default:
 		return false
 	}
@@ -1299,11 +1411,14 @@ func (mod *modContext) gen(fs fs) error {
 
 		mod.genFunction(buffer, f)
 
-		fileName := camel(tokenToName(f.Token)) + ".ts"
-		if mod.isReservedSourceFileName(fileName) {
-			fileName = camel(tokenToName(f.Token)) + "_.ts"
-		}
-		addFile(fileName, buffer.String())
+		addFile(mod.functionFileName(f), buffer.String())
+	}
+
+	// Mocks
+	if mod.generateMocks && mod.hasMocks() {
+		buffer := &bytes.Buffer{}
+		mod.genMocks(buffer)
+		addFile("mocks.ts", buffer.String())
 	}
 
 	if mod.hasEnums() {
@@ -1726,6 +1841,7 @@ func generateModuleContextMap(tool string, pkg *schema.Package, info NodePackage
 				compatibility:           info.Compatibility,
 				modToPkg:                info.ModuleToPackage,
 				disableUnionOutputTypes: info.DisableUnionOutputTypes,
+				generateMocks:           info.GenerateMocks,
 			}
 
 			if modName != "" {
@@ -1792,8 +1908,11 @@ func generateModuleContextMap(tool string, pkg *schema.Package, info NodePackage
 	// Clear the input and outputs sets: we want the visitors below to touch the transitive closure of types reachable
 	// from function inputs and outputs, including types that have already been visited.
 	for _, f := range pkg.Functions {
-		mod := getModFromToken(f.Token)
-		mod.functions = append(mod.functions, f)
+		// Methods are generated along with the resources they belong to.
+		if !f.IsMethod {
+			mod := getModFromToken(f.Token)
+			mod.functions = append(mod.functions, f)
+		}
 		if f.Inputs != nil {
 			visitObjectTypes(f.Inputs.Properties, func(t *schema.ObjectType, _ bool) {
 				types.details(t).inputType = true
@@ -0,0 +1,101 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodejs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen"
+)
+
+// hasMocks returns true if the module has any resources or functions to generate mocks for.
+func (mod *modContext) hasMocks() bool {
+	if len(mod.resources) != 0 {
+		return true
+	}
+	for _, f := range mod.functions {
+		if f.Outputs != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// genMocks generates a typed mock for each resource and function in the module. Each resource mock returns the ID and
+// state of a mocked resource given the state's typed properties; each function mock returns the outputs of a mocked
+// call given the function's typed result. Both are intended for use in implementations of pulumi.runtime.Mocks.
+func (mod *modContext) genMocks(w io.Writer) {
+	externalImports, imports := codegen.NewStringSet(), map[string]codegen.StringSet{}
+	referencesNestedTypes := false
+	for _, r := range mod.resources {
+		referencesNestedTypes = mod.getImports(r.Properties, externalImports, imports) || referencesNestedTypes
+	}
+	for _, f := range mod.functions {
+		if f.Outputs != nil {
+			module := "./" + strings.TrimSuffix(mod.functionFileName(f), ".ts")
+			imports[module] = codegen.NewStringSet(title(tokenToFunctionName(f.Token)) + "Result")
+		}
+	}
+	mod.genHeader(w, mod.sdkImports(referencesNestedTypes, false), externalImports, imports)
+
+	for i, r := range mod.resources {
+		if i > 0 {
+			fmt.Fprintf(w, "\n")
+		}
+		name := resourceName(r)
+
+		fmt.Fprintf(w, "/**\n")
+		fmt.Fprintf(w, " * The state of a mocked %s resource.\n", name)
+		fmt.Fprintf(w, " */\n")
+		fmt.Fprintf(w, "export interface %sMockState {\n", name)
+		for _, p := range r.Properties {
+			printComment(w, p.Comment, p.DeprecationMessage, "    ")
+			fmt.Fprintf(w, "    readonly %s?: %s;\n", p.Name, mod.typeString(p.Type, false, false, false, false, p.ConstValue))
+		}
+		fmt.Fprintf(w, "}\n\n")
+
+		fmt.Fprintf(w, "/**\n")
+		fmt.Fprintf(w, " * Returns the ID and state of a mocked %s resource, for use in the `newResource` method of a\n", name)
+		fmt.Fprintf(w, " * `pulumi.runtime.Mocks` implementation. Properties that are not set in the given state are taken from the\n")
+		fmt.Fprintf(w, " * resource's inputs. If no ID is given, one is derived from the resource's name.\n")
+		fmt.Fprintf(w, " */\n")
+		fmt.Fprintf(w, "export function mock%[1]s(args: pulumi.runtime.MockResourceArgs, state?: %[1]sMockState, id?: string): { id: string, state: Record<string, any> } {\n", name)
+		fmt.Fprintf(w, "    return {\n")
+		fmt.Fprintf(w, "        id: id || args.id || `${args.name}_id`,\n")
+		fmt.Fprintf(w, "        state: { ...args.inputs, ...state },\n")
+		fmt.Fprintf(w, "    };\n")
+		fmt.Fprintf(w, "}\n")
+	}
+
+	for i, f := range mod.functions {
+		if f.Outputs == nil {
+			continue
+		}
+		if i > 0 || len(mod.resources) > 0 {
+			fmt.Fprintf(w, "\n")
+		}
+		name := tokenToFunctionName(f.Token)
+
+		fmt.Fprintf(w, "/**\n")
+		fmt.Fprintf(w, " * Returns the outputs of a mocked call to %s, for use in the `call` method of a `pulumi.runtime.Mocks`\n", name)
+		fmt.Fprintf(w, " * implementation.\n")
+		fmt.Fprintf(w, " */\n")
+		fmt.Fprintf(w, "export function mock%[1]s(result: %[1]sResult): Record<string, any> {\n", title(name))
+		fmt.Fprintf(w, "    return result;\n")
+		fmt.Fprintf(w, "}\n")
+	}
+}
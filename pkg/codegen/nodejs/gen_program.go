@@ -16,6 +16,7 @@ package nodejs
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"path"
@@ -29,7 +30,9 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model/format"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 	"github.com/zclconf/go-cty/cty"
 )
 
@@ -44,6 +47,72 @@ type generator struct {
 	configCreated bool
 }
 
+// GenerateProject generates a complete TypeScript project for the given program: the program itself, the project's
+// Pulumi.yaml, and the package.json and tsconfig.json files necessary to install its dependencies and run it.
+func GenerateProject(project workspace.Project, program *hcl2.Program) (map[string][]byte, hcl.Diagnostics, error) {
+	files, diags, err := GenerateProgram(program)
+	if err != nil {
+		return nil, diags, err
+	}
+
+	project.Runtime = workspace.NewProjectRuntimeInfo("nodejs", nil)
+	projectYAML, err := encoding.YAML.Marshal(&project)
+	if err != nil {
+		return nil, diags, err
+	}
+	files["Pulumi.yaml"] = projectYAML
+
+	dependencies := map[string]string{
+		"@pulumi/pulumi": "^3.0.0",
+	}
+	for _, p := range program.Packages() {
+		packageName := "@pulumi/" + p.Name
+		if info, ok := p.Language["nodejs"].(NodePackageInfo); ok && info.PackageName != "" {
+			packageName = info.PackageName
+		}
+		version := "latest"
+		if p.Version != nil {
+			version = "^" + p.Version.String()
+		}
+		dependencies[packageName] = version
+	}
+
+	packageJSON, err := json.MarshalIndent(map[string]interface{}{
+		"name": project.Name.String(),
+		"devDependencies": map[string]string{
+			"@types/node": "^14",
+		},
+		"dependencies": dependencies,
+	}, "", "    ")
+	if err != nil {
+		return nil, diags, err
+	}
+	files["package.json"] = append(packageJSON, '\n')
+	files["tsconfig.json"] = []byte(tsconfigJSON)
+
+	return files, diags, nil
+}
+
+const tsconfigJSON = `{
+    "compilerOptions": {
+        "strict": true,
+        "outDir": "bin",
+        "target": "es2016",
+        "module": "commonjs",
+        "moduleResolution": "node",
+        "sourceMap": true,
+        "experimentalDecorators": true,
+        "pretty": true,
+        "noFallthroughCasesInSwitch": true,
+        "noImplicitReturns": true,
+        "forceConsistentCasingInFileNames": true
+    },
+    "files": [
+        "index.ts"
+    ]
+}
+`
+
 func GenerateProgram(program *hcl2.Program) (map[string][]byte, hcl.Diagnostics, error) {
 	// Linearize the nodes into an order appropriate for procedural code generation.
 	nodes := hcl2.Linearize(program)
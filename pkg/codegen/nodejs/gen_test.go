@@ -55,6 +55,26 @@ func TestGeneratePackage(t *testing.T) {
 				"types/output.ts",
 			},
 		},
+		{
+			"Simple schema with methods",
+			"simple-methods-schema",
+			[]string{
+				"foo.ts",
+				"index.ts",
+			},
+		},
+		{
+			"Simple schema with mocks",
+			"simple-mocks-schema",
+			[]string{
+				"index.ts",
+				"getWidgetCount.ts",
+				"mocks.ts",
+				"widget.ts",
+				"types/input.ts",
+				"types/output.ts",
+			},
+		},
 		{
 			"Simple schema with plain properties",
 			"simple-plain-schema",
@@ -52,6 +52,8 @@ type NodePackageInfo struct {
 	DisableUnionOutputTypes bool `json:"disableUnionOutputTypes,omitempty"`
 	// An indicator for whether the package contains enums.
 	ContainsEnums bool `json:"containsEnums,omitempty"`
+	// Generate typed mocks for each resource and function, for use with pulumi.runtime.setMocks in unit tests.
+	GenerateMocks bool `json:"generateMocks,omitempty"`
 }
 
 // NodeObjectInfo contains NodeJS-specific information for an object.
@@ -109,6 +109,7 @@ type modContext struct {
 	// Name overrides set in PackageInfo
 	modNameOverrides map[string]string // Optional overrides for Pulumi module names
 	compatibility    string            // Toggle compatibility mode for a specified target.
+	generateMocks    bool              // Generate typed mocks for resources and functions.
 }
 
 func (mod *modContext) isTopLevel() bool {
@@ -465,6 +466,11 @@ func (mod *modContext) gen(fs fs) error {
 		addFile(PyName(tokenToName(f.Token))+".py", fun)
 	}
 
+	// Mocks
+	if mod.generateMocks && mod.hasMocks() {
+		addFile("_mocks.py", mod.genMocks())
+	}
+
 	// Nested types
 	if len(mod.types) > 0 {
 		if err := mod.genTypes(dir, fs); err != nil {
@@ -919,6 +925,12 @@ func (mod *modContext) genResource(res *schema.Resource) (string, error) {
 	if res.StateInputs != nil {
 		mod.collectImports(res.StateInputs.Properties, imports, true /*input*/)
 	}
+	for _, method := range res.Methods {
+		mod.collectImports(methodArgs(method), imports, true /*input*/)
+		if method.Function.Outputs != nil {
+			mod.collectImports(method.Function.Outputs.Properties, imports, false /*input*/)
+		}
+	}
 
 	mod.genHeader(w, true /*needsSDK*/, imports)
 
@@ -1207,9 +1219,124 @@ func (mod *modContext) genResource(res *schema.Resource) (string, error) {
 		return fmt.Sprintf("pulumi.Output[%s]", ty)
 	})
 
+	// Write out methods.
+	for _, method := range res.Methods {
+		if err := mod.genMethod(w, name, method); err != nil {
+			return "", err
+		}
+	}
+
 	return w.String(), nil
 }
 
+// methodArgs returns the arguments of the given method, excluding the implicit `__self__` argument.
+func methodArgs(method *schema.Method) []*schema.Property {
+	if method.Function.Inputs == nil {
+		return nil
+	}
+	var args []*schema.Property
+	for _, arg := range method.Function.Inputs.Properties {
+		if arg.Name != "__self__" {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+func (mod *modContext) genMethod(w io.Writer, className string, method *schema.Method) error {
+	fun := method.Function
+	methodName := PyName(method.Name)
+
+	// If there is a return type, emit it as a class nested within the resource class.
+	var retTypeName string
+	if fun.Outputs != nil {
+		retTypeName = fmt.Sprintf("%sResult", pyClassName(title(method.Name)))
+		comment := fmt.Sprintf("The results of the %s.%s method.", className, method.Name)
+
+		buf := &bytes.Buffer{}
+		if err := mod.genType(buf, retTypeName, comment, fun.Outputs.Properties, false /*plainType*/, false, /*input*/
+			false /*args*/, false /*resourceOutput*/); err != nil {
+			return err
+		}
+		for _, line := range strings.SplitAfter(strings.TrimRight(buf.String(), "\n"), "\n") {
+			if line != "\n" {
+				line = "    " + line
+			}
+			fmt.Fprint(w, line)
+		}
+		fmt.Fprintf(w, "\n\n")
+	}
+
+	// Sort required args first.
+	args := methodArgs(method)
+	sort.SliceStable(args, func(i, j int) bool {
+		return args[i].IsRequired && !args[j].IsRequired
+	})
+
+	// Write out the method signature.
+	def := fmt.Sprintf("    def %s(", methodName)
+	indent := strings.Repeat(" ", len(def))
+	fmt.Fprintf(w, "%s__self__", def)
+	if len(args) > 0 {
+		fmt.Fprintf(w, ", *")
+	}
+	for _, arg := range args {
+		ty := mod.typeString(arg.Type, true, !arg.IsPlain /*wrapInput*/, !arg.IsPlain /*args*/, !arg.IsRequired,
+			true /*acceptMapping*/)
+		var defaultValue string
+		if !arg.IsRequired {
+			defaultValue = " = None"
+		}
+		fmt.Fprintf(w, ",\n%s%s: %s%s", indent, PyName(arg.Name), ty, defaultValue)
+	}
+	if retTypeName != "" {
+		fmt.Fprintf(w, ") -> pulumi.Output['%s.%s']:\n", className, retTypeName)
+	} else {
+		fmt.Fprintf(w, ") -> None:\n")
+	}
+
+	// Write out the docstring.
+	docs := &bytes.Buffer{}
+	if fun.Comment != "" {
+		fmt.Fprintln(docs, codegen.FilterExamples(fun.Comment, "python"))
+	}
+	if len(args) > 0 {
+		if docs.Len() > 0 {
+			fmt.Fprintln(docs, "")
+		}
+		for _, arg := range args {
+			mod.genPropDocstring(docs, PyName(arg.Name), arg, !arg.IsPlain /*wrapInputs*/, true /*acceptMapping*/)
+		}
+	}
+	if docs.Len() > 0 {
+		printComment(w, docs.String(), "        ")
+	}
+
+	if fun.DeprecationMessage != "" {
+		fmt.Fprintf(w, "        pulumi.log.warn(\"\"\"%s is deprecated: %s\"\"\")\n", methodName,
+			fun.DeprecationMessage)
+	}
+
+	// Copy the method arguments into a dictionary.
+	fmt.Fprintf(w, "        __args__ = dict()\n")
+	fmt.Fprintf(w, "        __args__['__self__'] = __self__\n")
+	for _, arg := range args {
+		fmt.Fprintf(w, "        __args__['%s'] = %s\n", arg.Name, PyName(arg.Name))
+	}
+
+	// Now simply call the runtime function with the arguments.
+	if retTypeName != "" {
+		fmt.Fprintf(w, "        __result__ = pulumi.runtime.call('%s', __args__, res=__self__, typ=%s.%s)\n",
+			fun.Token, className, retTypeName)
+		fmt.Fprintf(w, "        return __result__\n")
+	} else {
+		fmt.Fprintf(w, "        pulumi.runtime.call('%s', __args__, res=__self__)\n", fun.Token)
+	}
+	fmt.Fprintf(w, "\n")
+
+	return nil
+}
+
 func (mod *modContext) genProperties(w io.Writer, properties []*schema.Property, setters bool,
 	propType func(prop *schema.Property) string) {
 	// Write out Python properties for each property. If there is a property named "property", it will
@@ -2258,6 +2385,7 @@ func generateModuleContextMap(tool string, pkg *schema.Package, info PackageInfo
 				camelCaseToSnakeCase: camelCaseToSnakeCase,
 				modNameOverrides:     info.ModuleNameOverrides,
 				compatibility:        info.Compatibility,
+				generateMocks:        info.GenerateMocks,
 			}
 
 			if modName != "" && p == pkg {
@@ -2342,7 +2470,9 @@ func generateModuleContextMap(tool string, pkg *schema.Package, info PackageInfo
 	// Find input and output types referenced by functions.
 	for _, f := range pkg.Functions {
 		mod := getModFromToken(f.Token, f.Package)
-		mod.functions = append(mod.functions, f)
+		if !f.IsMethod {
+			mod.functions = append(mod.functions, f)
+		}
 		if f.Inputs != nil {
 			visitObjectTypes(f.Inputs.Properties, func(t schema.Type, _ bool) {
 				switch T := t.(type) {
@@ -0,0 +1,174 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package python
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+// hasMocks returns true if the module has any resources or functions to generate mocks for.
+func (mod *modContext) hasMocks() bool {
+	if len(mod.resources) != 0 {
+		return true
+	}
+	for _, f := range mod.functions {
+		if f.Outputs != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// genMocks generates a typed mock for each resource and function in the module. Each resource mock returns the ID and
+// state of a mocked resource given the state's properties as keyword arguments; each function mock returns the outputs
+// of a mocked call given the result's properties as keyword arguments. Both are intended for use in implementations of
+// pulumi.runtime.Mocks.
+func (mod *modContext) genMocks() string {
+	w := &bytes.Buffer{}
+
+	imports := imports{}
+	for _, r := range mod.resources {
+		mod.collectImports(r.Properties, imports, false)
+	}
+	for _, f := range mod.functions {
+		if f.Outputs != nil {
+			mod.collectImports(f.Outputs.Properties, imports, false)
+		}
+	}
+	mod.genHeader(w, true /*needsSDK*/, imports)
+	fmt.Fprintf(w, "from typing import Tuple\n\n")
+
+	var names []string
+	for _, r := range mod.resources {
+		names = append(names, mockResourceFuncName(r))
+	}
+	for _, f := range mod.functions {
+		if f.Outputs != nil {
+			names = append(names, "mock_"+PyName(tokenToName(f.Token)))
+		}
+	}
+
+	// Export only the symbols we want exported.
+	fmt.Fprintf(w, "__all__ = [\n")
+	for _, name := range names {
+		fmt.Fprintf(w, "    '%s',\n", name)
+	}
+	fmt.Fprintf(w, "]\n")
+
+	for _, r := range mod.resources {
+		name := tokenToName(r.Token)
+		if r.IsProvider {
+			name = "Provider"
+		}
+
+		fmt.Fprintf(w, "\n\n")
+		def := fmt.Sprintf("def %s(", mockResourceFuncName(r))
+		indent := strings.Repeat(" ", len(def))
+		fmt.Fprintf(w, "%sargs: pulumi.runtime.MockResourceArgs,\n", def)
+		fmt.Fprintf(w, "%s*,\n", indent)
+		fmt.Fprintf(w, "%sid: Optional[str] = None", indent)
+		for _, p := range r.Properties {
+			fmt.Fprintf(w, ",\n%s%s: %s = None", indent, PyName(p.Name), mod.mockTypeString(p))
+		}
+		fmt.Fprintf(w, ") -> Tuple[str, dict]:\n")
+
+		docs := &bytes.Buffer{}
+		fmt.Fprintf(docs, "Returns the ID and state of a mocked %s resource, for use in the `new_resource` method of a\n", name)
+		fmt.Fprintf(docs, "`pulumi.runtime.Mocks` implementation. Properties that are not given are taken from the resource's\n")
+		fmt.Fprintf(docs, "inputs. If no ID is given, one is derived from the resource's name.\n")
+		fmt.Fprintf(docs, "\n")
+		fmt.Fprintf(docs, ":param pulumi.runtime.MockResourceArgs args: The arguments of the mocked resource registration.\n")
+		fmt.Fprintf(docs, ":param str id: The physical ID of the mocked resource.\n")
+		for _, p := range r.Properties {
+			mod.genMockPropDocstring(docs, p)
+		}
+		printComment(w, docs.String(), "    ")
+
+		fmt.Fprintf(w, "    state = dict(args.inputs)\n")
+		mod.genMockProperties(w, "state", r.Properties)
+		fmt.Fprintf(w, "    return id or args.resource_id or f\"{args.name}_id\", state\n")
+	}
+
+	for _, f := range mod.functions {
+		if f.Outputs == nil {
+			continue
+		}
+		name := PyName(tokenToName(f.Token))
+
+		fmt.Fprintf(w, "\n\n")
+		def := fmt.Sprintf("def mock_%s(", name)
+		indent := strings.Repeat(" ", len(def))
+		fmt.Fprintf(w, "%s*", def)
+		for _, p := range f.Outputs.Properties {
+			fmt.Fprintf(w, ",\n%s%s: %s = None", indent, PyName(p.Name), mod.mockTypeString(p))
+		}
+		fmt.Fprintf(w, ") -> dict:\n")
+
+		docs := &bytes.Buffer{}
+		fmt.Fprintf(docs, "Returns the outputs of a mocked call to %s, for use in the `call` method of a\n", name)
+		fmt.Fprintf(docs, "`pulumi.runtime.Mocks` implementation.\n")
+		if len(f.Outputs.Properties) > 0 {
+			fmt.Fprintf(docs, "\n")
+			for _, p := range f.Outputs.Properties {
+				mod.genMockPropDocstring(docs, p)
+			}
+		}
+		printComment(w, docs.String(), "    ")
+
+		fmt.Fprintf(w, "    result = dict()\n")
+		mod.genMockProperties(w, "result", f.Outputs.Properties)
+		fmt.Fprintf(w, "    return result\n")
+	}
+
+	return w.String()
+}
+
+// mockResourceFuncName returns the name of the mock function for the given resource.
+func mockResourceFuncName(r *schema.Resource) string {
+	if r.IsProvider {
+		return "mock_provider"
+	}
+	return "mock_" + PyName(tokenToName(r.Token))
+}
+
+// mockTypeString returns the type of the keyword argument for the given property of a mock.
+func (mod *modContext) mockTypeString(p *schema.Property) string {
+	return mod.typeString(p.Type, false /*input*/, false /*wrapInput*/, false /*args*/, true /*optional*/,
+		false /*acceptMapping*/)
+}
+
+// genMockPropDocstring emits the docstring for the given property of a mock, if it has one.
+func (mod *modContext) genMockPropDocstring(w io.Writer, p *schema.Property) {
+	if p.Comment == "" {
+		return
+	}
+	ty := mod.typeString(p.Type, false, false, false, false /*optional*/, false)
+	fmt.Fprintf(w, ":param %s %s: %s\n", ty, PyName(p.Name), strings.Split(strings.TrimSpace(p.Comment), "\n")[0])
+}
+
+// genMockProperties emits code that sets each of the given properties in the dictionary named dict, keyed by its
+// schema name, if its keyword argument was given.
+func (mod *modContext) genMockProperties(w io.Writer, dict string, properties []*schema.Property) {
+	for _, p := range properties {
+		pname := PyName(p.Name)
+		fmt.Fprintf(w, "    if %s is not None:\n", pname)
+		fmt.Fprintf(w, "        %s[%q] = %s\n", dict, p.Name, pname)
+	}
+}
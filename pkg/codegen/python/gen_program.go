@@ -27,7 +27,9 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/model/format"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/hcl2/syntax"
 	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
 type generator struct {
@@ -68,6 +70,41 @@ func GenerateProgram(program *hcl2.Program) (map[string][]byte, hcl.Diagnostics,
 	return files, g.diagnostics, nil
 }
 
+// GenerateProject generates a complete Python project for the given program: the program itself, the project's
+// Pulumi.yaml, and the requirements.txt file necessary to install its dependencies.
+func GenerateProject(project workspace.Project, program *hcl2.Program) (map[string][]byte, hcl.Diagnostics, error) {
+	files, diags, err := GenerateProgram(program)
+	if err != nil {
+		return nil, diags, err
+	}
+
+	project.Runtime = workspace.NewProjectRuntimeInfo("python", map[string]interface{}{
+		"virtualenv": "venv",
+	})
+	projectYAML, err := encoding.YAML.Marshal(&project)
+	if err != nil {
+		return nil, diags, err
+	}
+	files["Pulumi.yaml"] = projectYAML
+
+	var requirements bytes.Buffer
+	fmt.Fprintf(&requirements, "pulumi>=3.0.0,<4.0.0\n")
+	for _, p := range program.Packages() {
+		packageName := "pulumi-" + p.Name
+		if info, ok := p.Language["python"].(PackageInfo); ok && info.PackageName != "" {
+			packageName = strings.ReplaceAll(info.PackageName, "_", "-")
+		}
+		if p.Version != nil {
+			fmt.Fprintf(&requirements, "%s>=%s,<%d.0.0\n", packageName, p.Version, p.Version.Major+1)
+		} else {
+			fmt.Fprintf(&requirements, "%s\n", packageName)
+		}
+	}
+	files["requirements.txt"] = requirements.Bytes()
+
+	return files, diags, nil
+}
+
 func newGenerator(program *hcl2.Program) (*generator, error) {
 	// Import Python-specific schema info.
 	casingTables := map[string]map[string]string{}
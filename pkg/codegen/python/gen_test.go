@@ -92,6 +92,21 @@ func TestGeneratePackage(t *testing.T) {
 				filepath.Join("pulumi_example", "outputs.py"),
 			},
 		},
+		{
+			"Simple schema with mocks",
+			"simple-mocks-schema",
+			[]string{
+				filepath.Join("pulumi_example", "__init__.py"),
+				filepath.Join("pulumi_example", "_mocks.py"),
+			},
+		},
+		{
+			"Simple schema with methods",
+			"simple-methods-schema",
+			[]string{
+				filepath.Join("pulumi_example", "foo.py"),
+			},
+		},
 	}
 
 	testDir := filepath.Join("..", "internal", "test", "testdata")
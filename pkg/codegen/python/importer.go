@@ -49,6 +49,8 @@ type PackageInfo struct {
 	UsesIOClasses bool `json:"usesIOClasses,omitempty"`
 	// Indicates whether the pulumiplugin.json file should be generated.
 	EmitPulumiPluginFile bool `json:"emitPulumiPluginFile,omitempty"`
+	// Generate typed mocks for each resource and function, for use with pulumi.runtime.set_mocks in unit tests.
+	GenerateMocks bool `json:"generateMocks,omitempty"`
 }
 
 // Importer implements schema.Language for Python.
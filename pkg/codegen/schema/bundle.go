@@ -0,0 +1,253 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
+)
+
+// BundleSpecDocument reads the package schema in the file at the given path and returns it as a single JSON document.
+//
+// Schema files may be written in either JSON or YAML; files with a `.yaml` or `.yml` extension are parsed as YAML.
+// A schema may also be split across multiple files: any object whose `$ref` is a relative path to a JSON or YAML file,
+// optionally followed by a JSON pointer (e.g. `./types.yaml#/example:index:Widget`), is replaced by the value it
+// refers to. Relative paths are resolved against the directory of the file that contains the reference. Any other
+// properties of the referencing object are merged over the referenced value. References to the types of the package
+// itself (`#/types/...`), to other packages, and to the builtin `pulumi.json` types are left as-is.
+func BundleSpecDocument(path string) ([]byte, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &specBundler{documents: map[string]interface{}{}}
+	document, err := b.load(path)
+	if err != nil {
+		return nil, err
+	}
+	bundled, err := b.resolve(path, document)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(bundled)
+}
+
+// ReadPackageSpecFile reads the package schema in the file at the given path. The schema may be written in YAML and
+// split across multiple files; see BundleSpecDocument for details.
+func ReadPackageSpecFile(path string) (*PackageSpec, error) {
+	schemaBytes, err := BundleSpecDocument(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec PackageSpec
+	if err := json.Unmarshal(schemaBytes, &spec); err != nil {
+		return nil, errors.Wrapf(err, "unmarshaling schema %s", path)
+	}
+	return &spec, nil
+}
+
+// MarshalSpecDocument marshals a package schema as indented JSON. This is the canonical form of a schema document.
+func MarshalSpecDocument(spec *PackageSpec) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(spec); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type specBundler struct {
+	// documents caches parsed documents by absolute path.
+	documents map[string]interface{}
+	// active holds the references that are currently being resolved, and is used to detect cycles.
+	active []string
+}
+
+// load reads and parses the document at the given absolute path.
+func (b *specBundler) load(path string) (interface{}, error) {
+	if document, ok := b.documents[path]; ok {
+		return document, nil
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var document interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		var raw interface{}
+		if err := yaml.Unmarshal(contents, &raw); err != nil {
+			return nil, errors.Wrapf(err, "parsing %s", path)
+		}
+		document = encoding.YAMLToJSON(raw)
+	default:
+		decoder := json.NewDecoder(bytes.NewReader(contents))
+		decoder.UseNumber()
+		if err := decoder.Decode(&document); err != nil {
+			return nil, errors.Wrapf(err, "parsing %s", path)
+		}
+	}
+
+	b.documents[path] = document
+	return document, nil
+}
+
+// resolve returns a copy of the given value with all file references replaced by the values they refer to. path is the
+// absolute path of the file that contains the value.
+func (b *specBundler) resolve(path string, value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := value["$ref"].(string); ok && isFileRef(ref) {
+			return b.resolveRef(path, ref, value)
+		}
+
+		result := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			resolved, err := b.resolve(path, v)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = resolved
+		}
+		return result, nil
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, v := range value {
+			resolved, err := b.resolve(path, v)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = resolved
+		}
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+func (b *specBundler) resolveRef(path, ref string, value map[string]interface{}) (interface{}, error) {
+	file, pointer := ref, ""
+	if hash := strings.Index(ref, "#"); hash != -1 {
+		file, pointer = ref[:hash], ref[hash+1:]
+	}
+	target := filepath.Join(filepath.Dir(path), filepath.FromSlash(file))
+
+	key := target + "#" + pointer
+	for _, active := range b.active {
+		if active == key {
+			return nil, errors.Errorf("%s: circular reference to %s", path, ref)
+		}
+	}
+	b.active = append(b.active, key)
+	defer func() { b.active = b.active[:len(b.active)-1] }()
+
+	document, err := b.load(target)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s: resolving reference %s", path, ref)
+	}
+	referent, err := evalJSONPointer(document, pointer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s: resolving reference %s", path, ref)
+	}
+	resolved, err := b.resolve(target, referent)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 1 {
+		return resolved, nil
+	}
+
+	// Merge the other properties of the referencing object over the referenced value.
+	object, ok := resolved.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("%s: reference %s has sibling properties but does not refer to an object", path, ref)
+	}
+	for k, v := range value {
+		if k == "$ref" {
+			continue
+		}
+		if object[k], err = b.resolve(path, v); err != nil {
+			return nil, err
+		}
+	}
+	return object, nil
+}
+
+// isFileRef returns true if the given `$ref` refers to a JSON or YAML file by relative path.
+func isFileRef(ref string) bool {
+	if strings.HasPrefix(ref, "#") || strings.HasPrefix(ref, "/") || strings.HasPrefix(ref, "pulumi.json#") ||
+		strings.Contains(ref, "://") {
+		return false
+	}
+
+	file := ref
+	if hash := strings.Index(ref, "#"); hash != -1 {
+		file = ref[:hash]
+	}
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// evalJSONPointer returns the value in document referred to by the given JSON pointer.
+func evalJSONPointer(document interface{}, pointer string) (interface{}, error) {
+	if pointer == "" || pointer == "/" {
+		return document, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, errors.Errorf("invalid JSON pointer %q", pointer)
+	}
+
+	value := document
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			elem, ok := v[token]
+			if !ok {
+				return nil, errors.Errorf("%q does not exist", pointer)
+			}
+			value = elem
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, errors.Errorf("%q does not exist", pointer)
+			}
+			value = v[index]
+		default:
+			return nil, errors.Errorf("%q does not exist", pointer)
+		}
+	}
+	return value, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeSchemaFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "schema-bundle-test")
+	assert.NoError(t, err)
+	for name, contents := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	}
+	return dir
+}
+
+func TestReadPackageSpecFileSplitYAML(t *testing.T) {
+	dir := writeSchemaFiles(t, map[string]string{
+		"schema.yaml": `
+name: example
+version: 0.0.1
+resources:
+  $ref: ./resources/index.yaml
+types:
+  example:index:Widget:
+    $ref: ./types.json#/widget
+    description: A widget.
+`,
+		"resources/index.yaml": `
+example:index:Thing:
+  $ref: ./thing.yaml
+`,
+		"resources/thing.yaml": `
+properties:
+  widget:
+    $ref: "#/types/example:index:Widget"
+  archive:
+    $ref: pulumi.json#/Archive
+inputProperties:
+  size:
+    type: integer
+`,
+		"types.json": `{
+  "widget": {
+    "type": "object",
+    "properties": {
+      "size": {"type": "integer"}
+    }
+  }
+}`,
+	})
+	defer os.RemoveAll(dir)
+
+	spec, err := ReadPackageSpecFile(filepath.Join(dir, "schema.yaml"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "example", spec.Name)
+	assert.Equal(t, "0.0.1", spec.Version)
+
+	thing, ok := spec.Resources["example:index:Thing"]
+	if assert.True(t, ok) {
+		assert.Equal(t, "#/types/example:index:Widget", thing.Properties["widget"].Ref)
+		assert.Equal(t, "pulumi.json#/Archive", thing.Properties["archive"].Ref)
+		assert.Equal(t, "integer", thing.InputProperties["size"].Type)
+	}
+
+	widget, ok := spec.Types["example:index:Widget"]
+	if assert.True(t, ok) {
+		assert.Equal(t, "object", widget.Type)
+		assert.Equal(t, "A widget.", widget.Description)
+		assert.Equal(t, "integer", widget.Properties["size"].Type)
+	}
+
+	_, err = ImportSpec(*spec, nil)
+	assert.NoError(t, err)
+}
+
+func TestBundleSpecDocumentErrors(t *testing.T) {
+	cases := map[string]map[string]string{
+		"missing file": {
+			"schema.yaml": "resources:\n  $ref: ./missing.yaml\n",
+		},
+		"missing pointer": {
+			"schema.yaml": "types:\n  $ref: ./types.yaml#/nope\n",
+			"types.yaml":  "widget: {}\n",
+		},
+		"cycle": {
+			"schema.yaml": "types:\n  $ref: ./a.yaml\n",
+			"a.yaml":      "$ref: ./b.yaml\n",
+			"b.yaml":      "$ref: ./a.yaml\n",
+		},
+		"siblings on non-object": {
+			"schema.yaml": "name:\n  $ref: ./name.yaml\n  description: oops\n",
+			"name.yaml":   "example\n",
+		},
+	}
+	for name, files := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir := writeSchemaFiles(t, files)
+			defer os.RemoveAll(dir)
+
+			_, err := BundleSpecDocument(filepath.Join(dir, "schema.yaml"))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestIsFileRef(t *testing.T) {
+	assert.True(t, isFileRef("./types.yaml"))
+	assert.True(t, isFileRef("../types.json#/example:index:Widget"))
+	assert.True(t, isFileRef("types.yml"))
+	assert.False(t, isFileRef("#/types/example:index:Widget"))
+	assert.False(t, isFileRef("pulumi.json#/Archive"))
+	assert.False(t, isFileRef("/aws/v4.0.0/schema.json#/types/aws:index:Tag"))
+	assert.False(t, isFileRef("https://example.com/schema.json#/types/example:index:Widget"))
+}
@@ -0,0 +1,355 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Change describes a single difference between two versions of a package schema.
+type Change struct {
+	// Path is a JSON pointer to the changed element of the schema.
+	Path string `json:"path"`
+	// Message is a human-readable description of the change.
+	Message string `json:"message"`
+	// Breaking is true if the change may break users of SDKs generated from the old schema.
+	Breaking bool `json:"breaking"`
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s", c.Path, c.Message)
+}
+
+// Changes is a list of schema changes.
+type Changes []Change
+
+// HasBreakingChanges returns true if any of the changes is breaking.
+func (cs Changes) HasBreakingChanges() bool {
+	for _, c := range cs {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareSpecs compares two versions of a package schema and returns the list of changes between them, sorted by
+// path. Removed resources, functions, types and properties, newly-required inputs, type changes, removed enum values
+// and renamed tokens are reported as breaking; additions are reported as non-breaking.
+//
+// Renames are only detected for resources, using the new resource's type aliases. Functions and types have no
+// aliases, so a renamed function or type is reported as removed and added.
+func CompareSpecs(old, new *PackageSpec) Changes {
+	var d schemaDiffer
+	d.compareConfig(old.Config, new.Config)
+	d.compareResource(jsonPointer("provider"), old.Provider, new.Provider)
+	d.compareResources(old.Resources, new.Resources)
+	d.compareFunctions(old.Functions, new.Functions)
+	d.compareTypes(old.Types, new.Types)
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Path < d.changes[j].Path
+	})
+	return d.changes
+}
+
+// schemaDiffer accumulates the changes found while comparing two schemas.
+type schemaDiffer struct {
+	changes Changes
+}
+
+func (d *schemaDiffer) breaking(path, format string, args ...interface{}) {
+	d.changes = append(d.changes, Change{Path: path, Message: fmt.Sprintf(format, args...), Breaking: true})
+}
+
+func (d *schemaDiffer) nonBreaking(path, format string, args ...interface{}) {
+	d.changes = append(d.changes, Change{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (d *schemaDiffer) compareConfig(old, new ConfigSpec) {
+	path := jsonPointer("config", "variables")
+	d.compareProperties(path, old.Variables, new.Variables, old.Required, new.Required, true)
+}
+
+func (d *schemaDiffer) compareResources(old, new map[string]ResourceSpec) {
+	// Build a map from aliased type tokens to the resources that alias them so that renames can be detected.
+	renames := map[string]string{}
+	for token, r := range new {
+		for _, alias := range r.Aliases {
+			if alias.Type != nil {
+				renames[*alias.Type] = token
+			}
+		}
+	}
+
+	for _, token := range sortedKeys(old) {
+		path := jsonPointer("resources", token)
+		newResource, ok := new[token]
+		if !ok {
+			if renamed, ok := renames[token]; ok {
+				d.breaking(path, "resource renamed to %q", renamed)
+			} else {
+				d.breaking(path, "resource removed")
+			}
+			continue
+		}
+		d.compareResource(path, old[token], newResource)
+	}
+	for _, token := range sortedKeys(new) {
+		if _, ok := old[token]; !ok {
+			d.nonBreaking(jsonPointer("resources", token), "resource added")
+		}
+	}
+}
+
+func (d *schemaDiffer) compareResource(path string, old, new ResourceSpec) {
+	d.compareProperties(path+jsonPointer("inputProperties"), old.InputProperties, new.InputProperties,
+		old.RequiredInputs, new.RequiredInputs, true)
+	d.compareProperties(path+jsonPointer("properties"), old.Properties, new.Properties,
+		old.Required, new.Required, false)
+	d.compareMethods(path+jsonPointer("methods"), old.Methods, new.Methods)
+}
+
+func (d *schemaDiffer) compareMethods(path string, old, new map[string]string) {
+	for _, name := range sortedKeys(old) {
+		newFunction, ok := new[name]
+		switch {
+		case !ok:
+			d.breaking(path+jsonPointer(name), "method removed")
+		case newFunction != old[name]:
+			d.breaking(path+jsonPointer(name), "method function changed from %q to %q", old[name], newFunction)
+		}
+	}
+	for _, name := range sortedKeys(new) {
+		if _, ok := old[name]; !ok {
+			d.nonBreaking(path+jsonPointer(name), "method added")
+		}
+	}
+}
+
+func (d *schemaDiffer) compareFunctions(old, new map[string]FunctionSpec) {
+	for _, token := range sortedKeys(old) {
+		path := jsonPointer("functions", token)
+		newFunction, ok := new[token]
+		if !ok {
+			d.breaking(path, "function removed (renamed functions are also reported as removed)")
+			continue
+		}
+		oldFunction := old[token]
+		d.compareObject(path+jsonPointer("inputs"), oldFunction.Inputs, newFunction.Inputs, true)
+		d.compareObject(path+jsonPointer("outputs"), oldFunction.Outputs, newFunction.Outputs, false)
+	}
+	for _, token := range sortedKeys(new) {
+		if _, ok := old[token]; !ok {
+			d.nonBreaking(jsonPointer("functions", token), "function added")
+		}
+	}
+}
+
+func (d *schemaDiffer) compareTypes(old, new map[string]ComplexTypeSpec) {
+	for _, token := range sortedKeys(old) {
+		path := jsonPointer("types", token)
+		newType, ok := new[token]
+		if !ok {
+			d.breaking(path, "type removed (renamed types are also reported as removed)")
+			continue
+		}
+		oldType := old[token]
+
+		if oldType.Type != newType.Type {
+			d.breaking(path+jsonPointer("type"), "type changed from %q to %q", oldType.Type, newType.Type)
+			continue
+		}
+
+		if len(oldType.Enum) != 0 || len(newType.Enum) != 0 {
+			d.compareEnum(path+jsonPointer("enum"), oldType.Enum, newType.Enum)
+			continue
+		}
+
+		// We do not know whether an object type is used as an input or an output, so we conservatively treat it as
+		// both: newly-required properties and properties that are no longer required are both breaking.
+		d.compareProperties(path+jsonPointer("properties"), oldType.Properties, newType.Properties,
+			oldType.Required, newType.Required, true)
+		d.compareRequired(path+jsonPointer("properties"), oldType.Properties, newType.Properties,
+			oldType.Required, newType.Required, false)
+	}
+	for _, token := range sortedKeys(new) {
+		if _, ok := old[token]; !ok {
+			d.nonBreaking(jsonPointer("types", token), "type added")
+		}
+	}
+}
+
+func (d *schemaDiffer) compareEnum(path string, old, new []*EnumValueSpec) {
+	newValues := map[string]bool{}
+	for _, v := range new {
+		newValues[fmt.Sprintf("%v", v.Value)] = true
+	}
+	oldValues := map[string]bool{}
+	for _, v := range old {
+		value := fmt.Sprintf("%v", v.Value)
+		oldValues[value] = true
+		if !newValues[value] {
+			d.breaking(path, "enum value %q removed", value)
+		}
+	}
+	for _, v := range new {
+		if value := fmt.Sprintf("%v", v.Value); !oldValues[value] {
+			d.nonBreaking(path, "enum value %q added", value)
+		}
+	}
+}
+
+// compareObject compares the properties of two object types that may be absent.
+func (d *schemaDiffer) compareObject(path string, old, new *ObjectTypeSpec, inputs bool) {
+	switch {
+	case old == nil && new == nil:
+		return
+	case old == nil:
+		old = &ObjectTypeSpec{}
+	case new == nil:
+		new = &ObjectTypeSpec{}
+	}
+	d.compareProperties(path+jsonPointer("properties"), old.Properties, new.Properties, old.Required, new.Required,
+		inputs)
+}
+
+// compareProperties compares two sets of properties. If inputs is true, the properties are treated as inputs, and
+// newly-required properties are breaking. Otherwise they are treated as outputs, and properties that are no longer
+// required are breaking.
+func (d *schemaDiffer) compareProperties(path string, old, new map[string]PropertySpec, oldRequired,
+	newRequired []string, inputs bool) {
+
+	for _, name := range sortedKeys(old) {
+		propertyPath := path + jsonPointer(name)
+		newProperty, ok := new[name]
+		if !ok {
+			d.breaking(propertyPath, "property removed")
+			continue
+		}
+		d.compareTypeSpec(propertyPath, old[name].TypeSpec, newProperty.TypeSpec)
+	}
+	for _, name := range sortedKeys(new) {
+		if _, ok := old[name]; !ok {
+			if inputs && stringSet(newRequired)[name] {
+				d.breaking(path+jsonPointer(name), "required property added")
+			} else {
+				d.nonBreaking(path+jsonPointer(name), "property added")
+			}
+		}
+	}
+
+	d.compareRequired(path, old, new, oldRequired, newRequired, inputs)
+}
+
+// compareRequired reports changes to the required-ness of properties that are present in both schemas. If inputs is
+// true, properties that are now required are breaking. Otherwise, properties that are no longer required are
+// breaking.
+func (d *schemaDiffer) compareRequired(path string, old, new map[string]PropertySpec, oldRequired,
+	newRequired []string, inputs bool) {
+
+	oldSet, newSet := stringSet(oldRequired), stringSet(newRequired)
+	for _, name := range sortedKeys(new) {
+		if _, ok := old[name]; !ok || oldSet[name] == newSet[name] {
+			continue
+		}
+		switch {
+		case inputs && newSet[name]:
+			d.breaking(path+jsonPointer(name), "property is now required")
+		case !inputs && oldSet[name]:
+			d.breaking(path+jsonPointer(name), "property is no longer required")
+		}
+	}
+}
+
+func (d *schemaDiffer) compareTypeSpec(path string, old, new TypeSpec) {
+	if !typeSpecsEqual(old, new) {
+		d.breaking(path, "type changed from %s to %s", typeSpecString(old), typeSpecString(new))
+	}
+}
+
+// typeSpecsEqual returns true if two type specs describe the same type. Only the parts of a type spec that determine
+// its type are compared: annotations such as discriminators do not change the type of a property.
+func typeSpecsEqual(old, new TypeSpec) bool {
+	if old.Type != new.Type || old.Ref != new.Ref || len(old.OneOf) != len(new.OneOf) ||
+		!optionalTypeSpecsEqual(old.Items, new.Items) ||
+		!optionalTypeSpecsEqual(old.AdditionalProperties, new.AdditionalProperties) {
+		return false
+	}
+	for i := range old.OneOf {
+		if !typeSpecsEqual(old.OneOf[i], new.OneOf[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func optionalTypeSpecsEqual(old, new *TypeSpec) bool {
+	if old == nil || new == nil {
+		return old == new
+	}
+	return typeSpecsEqual(*old, *new)
+}
+
+// typeSpecString returns a short, human-readable description of a type spec.
+func typeSpecString(t TypeSpec) string {
+	switch {
+	case t.Ref != "":
+		return t.Ref
+	case len(t.OneOf) != 0:
+		elements := make([]string, len(t.OneOf))
+		for i, e := range t.OneOf {
+			elements[i] = typeSpecString(e)
+		}
+		return "Union<" + strings.Join(elements, ", ") + ">"
+	case t.Type == "array" && t.Items != nil:
+		return "Array<" + typeSpecString(*t.Items) + ">"
+	case t.Type == "object" && t.AdditionalProperties != nil:
+		return "Map<" + typeSpecString(*t.AdditionalProperties) + ">"
+	default:
+		return t.Type
+	}
+}
+
+// jsonPointer joins a list of reference tokens into a JSON pointer fragment as defined by RFC 6901.
+func jsonPointer(tokens ...string) string {
+	var sb strings.Builder
+	for _, t := range tokens {
+		sb.WriteRune('/')
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(t, "~", "~0"), "/", "~1"))
+	}
+	return sb.String()
+}
+
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	result := make([]string, len(keys))
+	for i, k := range keys {
+		result[i] = k.String()
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,171 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareSpecsIdentical(t *testing.T) {
+	spec := readSchemaFile("kubernetes.json")
+
+	changes := CompareSpecs(&spec, &spec)
+	assert.Empty(t, changes)
+	assert.False(t, changes.HasBreakingChanges())
+}
+
+func TestCompareSpecs(t *testing.T) {
+	stringType := TypeSpec{Type: "string"}
+	intType := TypeSpec{Type: "integer"}
+	oldName := "example:index:OldThing"
+
+	old := PackageSpec{
+		Name: "example",
+		Resources: map[string]ResourceSpec{
+			"example:index:Bucket": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"arn":  {TypeSpec: TypeSpec{Type: "string", OneOf: []TypeSpec{}}},
+						"size": {TypeSpec: intType},
+					},
+					Required: []string{"arn", "size"},
+				},
+				Methods: map[string]string{
+					"empty": "example:index:Bucket/empty",
+					"list":  "example:index:Bucket/list",
+				},
+				InputProperties: map[string]PropertySpec{
+					"acl":  {TypeSpec: stringType},
+					"size": {TypeSpec: intType},
+					"tags": {TypeSpec: TypeSpec{Type: "object", AdditionalProperties: &stringType}},
+				},
+			},
+			"example:index:Gone":     {},
+			"example:index:OldThing": {},
+		},
+		Functions: map[string]FunctionSpec{
+			"example:index:getBucket": {
+				Inputs: &ObjectTypeSpec{Properties: map[string]PropertySpec{"name": {TypeSpec: stringType}}},
+			},
+			"example:index:getGone": {},
+		},
+		Types: map[string]ComplexTypeSpec{
+			"example:index:Color": {
+				ObjectTypeSpec: ObjectTypeSpec{Type: "string"},
+				Enum:           []*EnumValueSpec{{Value: "red"}, {Value: "blue"}},
+			},
+			"example:index:Shape": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Type: "object",
+					Properties: map[string]PropertySpec{
+						"edge": {TypeSpec: TypeSpec{OneOf: []TypeSpec{{Ref: "#/types/example:index:Line"}}}},
+					},
+				},
+			},
+			"example:index:Gone": {ObjectTypeSpec: ObjectTypeSpec{Type: "object"}},
+		},
+	}
+
+	new := PackageSpec{
+		Name: "example",
+		Resources: map[string]ResourceSpec{
+			"example:index:Bucket": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"arn":  {TypeSpec: stringType},
+						"size": {TypeSpec: intType},
+						"url":  {TypeSpec: stringType},
+					},
+					Required: []string{"arn"},
+				},
+				InputProperties: map[string]PropertySpec{
+					"size": {TypeSpec: intType},
+					"tags": {TypeSpec: TypeSpec{Type: "object", AdditionalProperties: &intType}},
+				},
+				RequiredInputs: []string{"size"},
+				Methods: map[string]string{
+					"list": "example:index:Bucket/list",
+					"sync": "example:index:Bucket/sync",
+				},
+			},
+			"example:index:NewThing": {
+				Aliases: []AliasSpec{{Type: &oldName}},
+			},
+		},
+		Functions: map[string]FunctionSpec{
+			"example:index:getBucket": {
+				Inputs: &ObjectTypeSpec{
+					Properties: map[string]PropertySpec{
+						"name":   {TypeSpec: stringType},
+						"region": {TypeSpec: stringType},
+					},
+				},
+			},
+			"example:index:listBuckets": {},
+		},
+		Types: map[string]ComplexTypeSpec{
+			"example:index:Color": {
+				ObjectTypeSpec: ObjectTypeSpec{Type: "string"},
+				Enum:           []*EnumValueSpec{{Value: "red"}, {Value: "green"}},
+			},
+			"example:index:Shape": {
+				ObjectTypeSpec: ObjectTypeSpec{
+					Type: "object",
+					Properties: map[string]PropertySpec{
+						"edge": {TypeSpec: TypeSpec{
+							OneOf:         []TypeSpec{{Ref: "#/types/example:index:Line"}},
+							Discriminator: &DiscriminatorSpec{PropertyName: "kind"},
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	expected := Changes{
+		{Path: "/functions/example:index:getBucket/inputs/properties/region", Message: "property added"},
+		{Path: "/functions/example:index:getGone",
+			Message: "function removed (renamed functions are also reported as removed)", Breaking: true},
+		{Path: "/functions/example:index:listBuckets", Message: "function added"},
+		{Path: "/resources/example:index:Bucket/inputProperties/acl", Message: "property removed", Breaking: true},
+		{Path: "/resources/example:index:Bucket/inputProperties/size", Message: "property is now required",
+			Breaking: true},
+		{Path: "/resources/example:index:Bucket/inputProperties/tags",
+			Message: "type changed from Map<string> to Map<integer>", Breaking: true},
+		{Path: "/resources/example:index:Bucket/methods/empty", Message: "method removed", Breaking: true},
+		{Path: "/resources/example:index:Bucket/methods/sync", Message: "method added"},
+		{Path: "/resources/example:index:Bucket/properties/size", Message: "property is no longer required",
+			Breaking: true},
+		{Path: "/resources/example:index:Bucket/properties/url", Message: "property added"},
+		{Path: "/resources/example:index:Gone", Message: "resource removed", Breaking: true},
+		{Path: "/resources/example:index:NewThing", Message: "resource added"},
+		{Path: "/resources/example:index:OldThing", Message: `resource renamed to "example:index:NewThing"`,
+			Breaking: true},
+		{Path: "/types/example:index:Color/enum", Message: `enum value "blue" removed`, Breaking: true},
+		{Path: "/types/example:index:Color/enum", Message: `enum value "green" added`},
+		{Path: "/types/example:index:Gone", Message: "type removed (renamed types are also reported as removed)",
+			Breaking: true},
+	}
+
+	changes := CompareSpecs(&old, &new)
+	assert.Equal(t, expected, changes)
+	assert.True(t, changes.HasBreakingChanges())
+}
+
+func TestJSONPointer(t *testing.T) {
+	assert.Equal(t, "/resources/aws:s3~1bucket:Bucket/a~0b", jsonPointer("resources", "aws:s3/bucket:Bucket", "a~b"))
+}
@@ -321,6 +321,17 @@ type Resource struct {
 	Language map[string]interface{}
 	// IsComponent indicates whether the resource is a ComponentResource.
 	IsComponent bool
+	// Methods is the list of methods for the resource.
+	Methods []*Method
+}
+
+// Method describes a method on a resource.
+type Method struct {
+	// Name is the name of the method.
+	Name string
+	// Function is the function that implements the method. The function's inputs include an additional `__self__`
+	// property that refers to the resource the method is called on.
+	Function *Function
 }
 
 // Function describes a Pulumi function.
@@ -339,6 +350,8 @@ type Function struct {
 	DeprecationMessage string
 	// Language specifies additional language-specific data about the function.
 	Language map[string]interface{}
+	// IsMethod indicates whether the function is a method of a resource.
+	IsMethod bool
 }
 
 // Package describes a Pulumi package.
@@ -767,6 +780,8 @@ type ResourceSpec struct {
 	Language map[string]json.RawMessage `json:"language,omitempty"`
 	// IsComponent indicates whether the resource is a ComponentResource.
 	IsComponent bool `json:"isComponent,omitempty"`
+	// Methods maps method names to functions in this schema.
+	Methods map[string]string `json:"methods,omitempty"`
 }
 
 // FunctionSpec is the serializable form of a function description.
@@ -908,6 +923,10 @@ func importSpec(spec PackageSpec, languages map[string]Language, loader Loader)
 		return nil, errors.Wrap(err, "binding functions")
 	}
 
+	if err := bindMethods(spec.Resources, resourceTable, functionTable); err != nil {
+		return nil, errors.Wrap(err, "binding methods")
+	}
+
 	// Build the type list.
 	var typeList []Type
 	for _, t := range types.resources {
@@ -970,10 +989,19 @@ func importSpec(spec PackageSpec, languages map[string]Language, loader Loader)
 
 }
 
-// ImportSpec converts a serializable PackageSpec into a Package.
+// ImportSpec converts a serializable PackageSpec into a Package. If the spec cannot be bound, the problems found by
+// the semantic checks of ValidateSpec are returned, along with their locations, rather than only the first binding
+// error.
 func ImportSpec(spec PackageSpec, languages map[string]Language) (*Package, error) {
 	// Call the internal implementation that includes a loader parameter.
-	return importSpec(spec, languages, nil)
+	pkg, err := importSpec(spec, languages, nil)
+	if err != nil {
+		if diags := validateSpecSemantics(&spec); diags.HasErrors() {
+			return nil, diagnosticsError(diags)
+		}
+		return nil, err
+	}
+	return pkg, nil
 }
 
 // types facilitates interning (only storing a single reference to an object) during schema processing. The fields
@@ -1720,6 +1748,53 @@ func bindResources(specs map[string]ResourceSpec, types *types) ([]*Resource, ma
 	return resources, resourceTable, nil
 }
 
+// bindMethods associates the methods of each resource with the functions that implement them.
+func bindMethods(specs map[string]ResourceSpec, resourceTable map[string]*Resource,
+	functionTable map[string]*Function) error {
+
+	methodResources := map[string]string{}
+	for _, token := range sortedKeys(specs) {
+		spec, res := specs[token], resourceTable[token]
+		if len(spec.Methods) != 0 && !spec.IsComponent {
+			return errors.Errorf("resource %v: methods can only be specified on component resources", token)
+		}
+
+		for _, name := range sortedKeys(spec.Methods) {
+			functionToken := spec.Methods[name]
+			f, ok := functionTable[functionToken]
+			if !ok {
+				return errors.Errorf("resource %v: unknown function %v for method %v", token, functionToken, name)
+			}
+			if owner, ok := methodResources[functionToken]; ok {
+				return errors.Errorf("resource %v: function %v for method %v is already a method of resource %v",
+					token, functionToken, name, owner)
+			}
+			if !hasSelfProperty(f, token) {
+				return errors.Errorf("resource %v: function %v for method %v must have a __self__ input that "+
+					"refers to the resource", token, functionToken, name)
+			}
+			methodResources[functionToken] = token
+
+			f.IsMethod = true
+			res.Methods = append(res.Methods, &Method{Name: name, Function: f})
+		}
+	}
+	return nil
+}
+
+// hasSelfProperty returns true if the given function has a `__self__` input that refers to the given resource.
+func hasSelfProperty(f *Function, resourceToken string) bool {
+	if f.Inputs == nil {
+		return false
+	}
+	self, ok := f.Inputs.Property("__self__")
+	if !ok {
+		return false
+	}
+	rt, ok := self.Type.(*ResourceType)
+	return ok && rt.Token == resourceToken
+}
+
 func bindFunction(token string, spec FunctionSpec, types *types) (*Function, error) {
 	var inputs *ObjectType
 	if spec.Inputs != nil {
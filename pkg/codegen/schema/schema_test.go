@@ -124,6 +124,55 @@ func TestEnums(t *testing.T) {
 	}
 }
 
+func TestMethods(t *testing.T) {
+	pkgSpec := readSchemaFile(filepath.Join("simple-methods-schema", "schema.json"))
+
+	pkg, err := ImportSpec(pkgSpec, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	res, ok := pkg.GetResource("example::Foo")
+	assert.True(t, ok)
+	if assert.Len(t, res.Methods, 2) {
+		assert.Equal(t, "bar", res.Methods[0].Name)
+		assert.Equal(t, "example::Foo/bar", res.Methods[0].Function.Token)
+		assert.True(t, res.Methods[0].Function.IsMethod)
+		assert.Equal(t, "baz", res.Methods[1].Name)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(spec *PackageSpec)
+	}{
+		{"unknown function", func(spec *PackageSpec) {
+			spec.Resources["example::Foo"].Methods["qux"] = "example::Foo/qux"
+		}},
+		{"missing self", func(spec *PackageSpec) {
+			f := spec.Functions["example::Foo/baz"]
+			f.Inputs = nil
+			spec.Functions["example::Foo/baz"] = f
+		}},
+		{"shared function", func(spec *PackageSpec) {
+			spec.Resources["example::Foo"].Methods["qux"] = "example::Foo/bar"
+		}},
+		{"custom resource", func(spec *PackageSpec) {
+			r := spec.Resources["example::Foo"]
+			r.IsComponent = false
+			spec.Resources["example::Foo"] = r
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := readSchemaFile(filepath.Join("simple-methods-schema", "schema.json"))
+			tt.modify(&spec)
+
+			_, err := ImportSpec(spec, nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestImportResourceRef(t *testing.T) {
 	tests := []struct {
 		name       string
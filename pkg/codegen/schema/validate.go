@@ -0,0 +1,388 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	// Used to embed the metaschema.
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/pkg/errors"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// MetaSchema is the JSON schema that describes the format of a Pulumi package schema.
+//go:embed pulumi.json
+var MetaSchema string
+
+var metaSchemaOnce sync.Once
+var metaSchema *gojsonschema.Schema
+
+func loadMetaSchema() *gojsonschema.Schema {
+	metaSchemaOnce.Do(func() {
+		s, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(MetaSchema))
+		contract.AssertNoErrorf(err, "invalid metaschema")
+		metaSchema = s
+	})
+	return metaSchema
+}
+
+// ValidateSpecDocument validates the JSON text of a package schema. Unlike ValidateSpec, this reports fields that are
+// not part of the schema format. All problems are collected and returned as diagnostics whose details are JSON
+// pointers to the offending elements.
+func ValidateSpecDocument(schemaBytes []byte) hcl.Diagnostics {
+	var spec PackageSpec
+	if err := json.Unmarshal(schemaBytes, &spec); err != nil {
+		// Report structural problems against the metaschema if possible, as they are more precise than the error
+		// produced by the decoder.
+		diags := validateMetaSchema(gojsonschema.NewBytesLoader(schemaBytes))
+		if !diags.HasErrors() {
+			diags = append(diags, validationError("", "invalid schema: %v", err))
+		}
+		return diags
+	}
+
+	diags := validateMetaSchema(gojsonschema.NewBytesLoader(schemaBytes))
+	return append(diags, validateSpecSemantics(&spec)...)
+}
+
+// ValidateSpec validates a package schema against the metaschema and checks it for semantic errors, e.g. references
+// to types that do not exist, invalid tokens, enum values that do not match the type of their enum, and default
+// values of the wrong type. All problems are collected and returned as diagnostics whose details are JSON pointers to
+// the offending elements.
+func ValidateSpec(spec PackageSpec) hcl.Diagnostics {
+	diags := validateMetaSchema(gojsonschema.NewGoLoader(spec))
+	return append(diags, validateSpecSemantics(&spec)...)
+}
+
+func validateMetaSchema(document gojsonschema.JSONLoader) hcl.Diagnostics {
+	result, err := loadMetaSchema().Validate(document)
+	if err != nil {
+		return hcl.Diagnostics{validationError("", "invalid schema: %v", err)}
+	}
+
+	var diags hcl.Diagnostics
+	for _, e := range result.Errors() {
+		diags = append(diags, validationError(contextPointer(e.Context()), "%s", e.Description()))
+	}
+	return diags
+}
+
+// contextPointer converts a gojsonschema context into a JSON pointer.
+func contextPointer(context *gojsonschema.JsonContext) string {
+	if context == nil {
+		return ""
+	}
+	// Use a delimiter that cannot appear in a JSON document so that keys that contain '.' or '/' are preserved.
+	parts := strings.Split(context.String("\x00"), "\x00")
+	if len(parts) > 0 && parts[0] == gojsonschema.STRING_CONTEXT_ROOT {
+		parts = parts[1:]
+	}
+	return jsonPointer(parts...)
+}
+
+// validationError returns an error diagnostic for the element of the schema at the given JSON pointer.
+func validationError(path, format string, args ...interface{}) *hcl.Diagnostic {
+	return &hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  fmt.Sprintf(format, args...),
+		Detail:   path,
+	}
+}
+
+// diagnosticsError converts validation diagnostics into an error that lists each problem along with its location.
+func diagnosticsError(diags hcl.Diagnostics) error {
+	messages := make([]string, len(diags))
+	for i, d := range diags {
+		messages[i] = d.Summary
+		if d.Detail != "" {
+			messages[i] = d.Detail + ": " + d.Summary
+		}
+	}
+	return errors.Errorf("invalid schema:\n  %s", strings.Join(messages, "\n  "))
+}
+
+// specValidator performs the semantic checks that cannot be expressed by the metaschema.
+type specValidator struct {
+	spec  *PackageSpec
+	types *types
+	diags hcl.Diagnostics
+}
+
+func validateSpecSemantics(spec *PackageSpec) hcl.Diagnostics {
+	// The ref parser needs the name and version of the package in order to identify references to external schemas.
+	pkg := &Package{Name: spec.Name}
+	if spec.Version != "" {
+		if v, err := semver.ParseTolerant(spec.Version); err == nil {
+			pkg.Version = &v
+		}
+	}
+
+	v := &specValidator{spec: spec, types: &types{pkg: pkg}}
+
+	v.validateProperties(jsonPointer("config", "variables"), spec.Config.Variables, spec.Config.Required)
+
+	for _, token := range sortedKeys(spec.Types) {
+		v.validateComplexType(jsonPointer("types", token), token, spec.Types[token])
+	}
+
+	v.validateResource(jsonPointer("provider"), spec.Provider)
+	for _, token := range sortedKeys(spec.Resources) {
+		path := jsonPointer("resources", token)
+		v.validateToken(path, token)
+		v.validateResource(path, spec.Resources[token])
+		v.validateMethods(path+jsonPointer("methods"), token, spec.Resources[token])
+	}
+
+	for _, token := range sortedKeys(spec.Functions) {
+		path := jsonPointer("functions", token)
+		v.validateToken(path, token)
+		f := spec.Functions[token]
+		if f.Inputs != nil {
+			v.validateObject(path+jsonPointer("inputs"), *f.Inputs)
+		}
+		if f.Outputs != nil {
+			v.validateObject(path+jsonPointer("outputs"), *f.Outputs)
+		}
+	}
+
+	return v.diags
+}
+
+func (v *specValidator) errorf(path, format string, args ...interface{}) {
+	v.diags = append(v.diags, validationError(path, format, args...))
+}
+
+// validateToken checks that a resource, function or type token has the form `pkg:module:member` and belongs to the
+// package being validated.
+func (v *specValidator) validateToken(path, token string) {
+	components := strings.Split(token, ":")
+	if len(components) != 3 || components[2] == "" {
+		v.errorf(path, "invalid token %q: tokens must be of the form <package>:<module>:<member>", token)
+		return
+	}
+	if components[0] != v.spec.Name {
+		v.errorf(path, "invalid token %q: the package component must be %q", token, v.spec.Name)
+	}
+}
+
+func (v *specValidator) validateComplexType(path, token string, t ComplexTypeSpec) {
+	v.validateToken(path, token)
+
+	if len(t.Enum) == 0 {
+		if t.Type != "" && t.Type != "object" {
+			v.errorf(path+jsonPointer("type"), "type %q must specify enum values", t.Type)
+		}
+		v.validateObject(path, t.ObjectTypeSpec)
+		return
+	}
+
+	switch t.Type {
+	case "boolean", "integer", "number", "string":
+	default:
+		v.errorf(path+jsonPointer("type"), "enums may only be of type boolean, integer, number, or string")
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, e := range t.Enum {
+		valuePath := path + jsonPointer("enum", strconv.Itoa(i), "value")
+		if !valueHasType(e.Value, t.Type) {
+			v.errorf(valuePath, "enum value %v is not of type %s", e.Value, t.Type)
+		}
+		key := fmt.Sprintf("%v", e.Value)
+		if seen[key] {
+			v.errorf(valuePath, "duplicate enum value %v", e.Value)
+		}
+		seen[key] = true
+	}
+}
+
+func (v *specValidator) validateResource(path string, r ResourceSpec) {
+	v.validateObject(path, r.ObjectTypeSpec)
+	v.validateProperties(path+jsonPointer("inputProperties"), r.InputProperties, r.RequiredInputs)
+	if r.StateInputs != nil {
+		v.validateObject(path+jsonPointer("stateInputs"), *r.StateInputs)
+	}
+}
+
+// validateMethods checks that each method of a resource refers to a function whose inputs include a `__self__`
+// property that refers to the resource.
+func (v *specValidator) validateMethods(path, token string, r ResourceSpec) {
+	if len(r.Methods) != 0 && !r.IsComponent {
+		v.errorf(path, "methods can only be specified on component resources")
+	}
+
+	for _, name := range sortedKeys(r.Methods) {
+		methodPath := path + jsonPointer(name)
+		f, ok := v.spec.Functions[r.Methods[name]]
+		if !ok {
+			v.errorf(methodPath, "function %q is not defined", r.Methods[name])
+			continue
+		}
+
+		var self PropertySpec
+		if f.Inputs != nil {
+			self, ok = f.Inputs.Properties["__self__"]
+		}
+		if !ok || self.Ref != "#/resources/"+token {
+			v.errorf(methodPath, "function %q must have a __self__ input that refers to %q", r.Methods[name], token)
+		}
+	}
+}
+
+func (v *specValidator) validateObject(path string, o ObjectTypeSpec) {
+	v.validateProperties(path+jsonPointer("properties"), o.Properties, o.Required)
+}
+
+func (v *specValidator) validateProperties(path string, properties map[string]PropertySpec, required []string) {
+	for _, name := range required {
+		if _, ok := properties[name]; !ok {
+			v.errorf(path, "required property %q is not defined", name)
+		}
+	}
+
+	for _, name := range sortedKeys(properties) {
+		propertyPath := path + jsonPointer(name)
+		p := properties[name]
+		v.validateTypeSpec(propertyPath, p.TypeSpec)
+		if p.Default != nil {
+			v.validateValue(propertyPath+jsonPointer("default"), p.Default, p.TypeSpec)
+		}
+		if p.Const != nil {
+			v.validateValue(propertyPath+jsonPointer("const"), p.Const, p.TypeSpec)
+		}
+	}
+}
+
+func (v *specValidator) validateTypeSpec(path string, t TypeSpec) {
+	if t.Ref != "" {
+		v.validateRef(path+jsonPointer("$ref"), t)
+	}
+	if t.Items != nil {
+		v.validateTypeSpec(path+jsonPointer("items"), *t.Items)
+	}
+	if t.AdditionalProperties != nil {
+		v.validateTypeSpec(path+jsonPointer("additionalProperties"), *t.AdditionalProperties)
+	}
+	for i, e := range t.OneOf {
+		v.validateTypeSpec(path+jsonPointer("oneOf", strconv.Itoa(i)), e)
+	}
+
+	switch {
+	case t.Ref != "" || len(t.OneOf) != 0:
+	case t.Type == "array" && t.Items == nil:
+		v.errorf(path, "missing \"items\" property in array type")
+	case t.Type == "":
+		v.errorf(path, "a type must specify either \"type\" or \"$ref\"")
+	}
+}
+
+func (v *specValidator) validateRef(path string, t TypeSpec) {
+	switch t.Ref {
+	case "pulumi.json#/Archive", "pulumi.json#/Asset", "pulumi.json#/Json", "pulumi.json#/Any":
+		return
+	}
+
+	ref, err := v.types.parseTypeSpecRef(t.Ref)
+	if err != nil {
+		v.errorf(path, "%v", err)
+		return
+	}
+
+	// References to other packages can only be checked by loading those packages, which is left to the binder.
+	if ref.Package != v.spec.Name || !versionEquals(ref.Version, v.types.pkg.Version) {
+		return
+	}
+
+	switch ref.Kind {
+	case typesRef:
+		// A reference to an undefined type that specifies an underlying type is an opaque token type.
+		if _, ok := v.spec.Types[ref.Token]; !ok && t.Type == "" {
+			v.errorf(path, "type %q is not defined", ref.Token)
+		}
+	case resourcesRef:
+		if _, ok := v.spec.Resources[ref.Token]; !ok {
+			v.errorf(path, "resource %q is not defined", ref.Token)
+		}
+	}
+}
+
+// validateValue checks that a default or constant value is assignable to the given type.
+func (v *specValidator) validateValue(path string, value interface{}, t TypeSpec) {
+	if t.Ref != "" {
+		ref, err := v.types.parseTypeSpecRef(t.Ref)
+		if err != nil || ref.Kind != typesRef || ref.Package != v.spec.Name {
+			return
+		}
+		enum, ok := v.spec.Types[ref.Token]
+		if !ok || len(enum.Enum) == 0 {
+			return
+		}
+		for _, e := range enum.Enum {
+			if fmt.Sprintf("%v", e.Value) == fmt.Sprintf("%v", value) {
+				return
+			}
+		}
+		v.errorf(path, "value %v is not a member of enum %q", value, ref.Token)
+		return
+	}
+
+	switch t.Type {
+	case "boolean", "integer", "number", "string":
+		if !valueHasType(value, t.Type) {
+			v.errorf(path, "value %v is not of type %s", value, t.Type)
+		}
+	}
+}
+
+// valueHasType returns true if the given value is of the given primitive type.
+func valueHasType(value interface{}, typ string) bool {
+	switch typ {
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		switch value.(type) {
+		case int, int32, int64, float32, float64:
+			return true
+		}
+		return false
+	case "integer":
+		switch value := value.(type) {
+		case int, int32, int64:
+			return true
+		case float64:
+			return value == math.Trunc(value)
+		case float32:
+			return float64(value) == math.Trunc(float64(value))
+		}
+		return false
+	default:
+		return false
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// diagnosticMessages returns the summary of each diagnostic, prefixed with the JSON pointer in its detail.
+func diagnosticMessages(diags hcl.Diagnostics) []string {
+	messages := make([]string, len(diags))
+	for i, d := range diags {
+		messages[i] = d.Summary
+		if d.Detail != "" {
+			messages[i] = d.Detail + ": " + d.Summary
+		}
+	}
+	return messages
+}
+
+func TestValidateSpecDocumentValidSchemas(t *testing.T) {
+	files := []string{
+		"aws.json",
+		"azure.json",
+		"azure-native.json",
+		"kubernetes.json",
+		"random.json",
+		filepath.Join("simple-methods-schema", "schema.json"),
+		filepath.Join("simple-plain-schema", "schema.json"),
+		filepath.Join("simple-resource-schema", "schema.json"),
+	}
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			schemaBytes, err := ioutil.ReadFile(filepath.Join("..", "internal", "test", "testdata", file))
+			assert.NoError(t, err)
+
+			diags := ValidateSpecDocument(schemaBytes)
+			assert.Empty(t, diagnosticMessages(diags))
+		})
+	}
+}
+
+func TestValidateSpecDocument(t *testing.T) {
+	schemaBytes := []byte(`{
+		"name": "example",
+		"unknownField": true,
+		"types": {
+			"example:index:Color": {
+				"type": "string",
+				"enum": [{"value": "red"}, {"value": 3}, {"value": "red"}]
+			},
+			"other:index:Shape": {
+				"type": "object",
+				"properties": {
+					"sides": {"type": "integer", "default": "three"}
+				},
+				"required": ["sides", "name"]
+			}
+		},
+		"resources": {
+			"example:index:Bucket": {
+				"inputProperties": {
+					"color": {"$ref": "#/types/example:index:Color", "default": "blue"},
+					"policy": {"$ref": "#/types/example:index:Policy"},
+					"owner": {"$ref": "#/resources/example:index:User"},
+					"tags": {"type": "array"}
+				},
+				"requiredInput": ["color"],
+				"methods": {
+					"empty": "example:index:Bucket/empty"
+				}
+			}
+		},
+		"functions": {
+			"example:index:Bucket/empty": {}
+		}
+	}`)
+
+	diags := ValidateSpecDocument(schemaBytes)
+	assert.ElementsMatch(t, []string{
+		"/resources/example:index:Bucket: Additional property requiredInput is not allowed",
+		"Additional property unknownField is not allowed",
+		"/types/example:index:Color/enum/1/value: enum value 3 is not of type string",
+		"/types/example:index:Color/enum/2/value: duplicate enum value red",
+		"/types/other:index:Shape: invalid token \"other:index:Shape\": the package component must be \"example\"",
+		"/types/other:index:Shape/properties: required property \"name\" is not defined",
+		"/types/other:index:Shape/properties/sides/default: value three is not of type integer",
+		"/resources/example:index:Bucket/inputProperties/color/default: value blue is not a member of enum " +
+			"\"example:index:Color\"",
+		"/resources/example:index:Bucket/inputProperties/owner/$ref: resource \"example:index:User\" is not defined",
+		"/resources/example:index:Bucket/inputProperties/policy/$ref: type \"example:index:Policy\" is not defined",
+		"/resources/example:index:Bucket/inputProperties/tags: missing \"items\" property in array type",
+		"/resources/example:index:Bucket/methods: methods can only be specified on component resources",
+		"/resources/example:index:Bucket/methods/empty: function \"example:index:Bucket/empty\" must have a " +
+			"__self__ input that refers to \"example:index:Bucket\"",
+	}, diagnosticMessages(diags))
+}
+
+func TestValidateSpec(t *testing.T) {
+	spec := readSchemaFile(filepath.Join("schema", "bad-enum-1.json"))
+	spec.Name = "fake-provider"
+
+	diags := ValidateSpec(spec)
+	assert.Equal(t, []string{
+		"/types/fake-provider:module1:BadEnum/enum/2/value: enum value 3 is not of type string",
+	}, diagnosticMessages(diags))
+}
+
+func TestImportSpecReportsValidationErrors(t *testing.T) {
+	spec := readSchemaFile(filepath.Join("schema", "bad-enum-1.json"))
+	spec.Name = "fake-provider"
+
+	_, err := ImportSpec(spec, nil)
+	assert.EqualError(t, err, "invalid schema:\n"+
+		"  /types/fake-provider:module1:BadEnum/enum/2/value: enum value 3 is not of type string")
+}
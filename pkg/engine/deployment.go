@@ -46,12 +46,19 @@ func ProjectInfoContext(projinfo *Projinfo, host plugin.Host, config plugin.Conf
 		return "", "", nil, err
 	}
 
+	// Resolve any local plugins that the project uses in place of the installed plugins.
+	projectPlugins, err := projinfo.Proj.GetProjectPlugins(projinfo.Root)
+	if err != nil {
+		return "", "", nil, err
+	}
+
 	// Create a context for plugins.
 	ctx, err := plugin.NewContextWithRoot(diag, statusDiag, host, config, pwd, projinfo.Root,
 		projinfo.Proj.Runtime.Options(), disableProviderPreview, tracingSpan)
 	if err != nil {
 		return "", "", nil, err
 	}
+	ctx.ProjectPlugins = projectPlugins
 
 	// If the project wants to connect to an existing language runtime, do so now.
 	if projinfo.Proj.Runtime.Name() == clientRuntimeName {
@@ -167,7 +174,7 @@ func newDeployment(ctx *Context, info *deploymentContext, opts deploymentOptions
 			plugctx, target, target.Snapshot, source, localPolicyPackPaths, dryRun, ctx.BackendClient)
 	} else {
 		_, defaultProviderVersions, pluginErr := installPlugins(proj, pwd, main, target, plugctx,
-			false /*returnInstallErrors*/)
+			opts.LockedPlugins, false /*returnInstallErrors*/)
 		if pluginErr != nil {
 			return nil, pluginErr
 		}
@@ -207,14 +214,33 @@ type runActions interface {
 	MaybeCorrupt() bool
 }
 
-// run executes the deployment. It is primarily responsible for handling cancellation.
+// run executes the deployment, emitting prelude and summary events around it.
 func (deployment *deployment) run(cancelCtx *Context, actions runActions, policyPacks map[string]string,
 	preview bool) (ResourceChanges, result.Result) {
 
+	// Emit an appropriate prelude event.
+	deployment.Options.Events.preludeEvent(preview, deployment.Ctx.Update.GetTarget().Config)
+
+	// Execute the deployment.
+	start := time.Now()
+	res := deployment.execute(cancelCtx, actions, preview, false /*analyzePlanOnly*/)
+	duration := time.Since(start)
+	changes := actions.Changes()
+
+	// Emit a summary event.
+	deployment.Options.Events.summaryEvent(preview, actions.MaybeCorrupt(), duration, changes, policyPacks)
+
+	return changes, res
+}
+
+// execute executes the deployment. It is primarily responsible for handling cancellation.
+func (deployment *deployment) execute(cancelCtx *Context, events deploy.Events, preview,
+	analyzePlanOnly bool) result.Result {
+
 	// Change into the plugin context's working directory.
 	chdir, err := fsutil.Chdir(deployment.Plugctx.Pwd)
 	if err != nil {
-		return nil, result.FromError(err)
+		return result.FromError(err)
 	}
 	defer chdir()
 
@@ -226,17 +252,11 @@ func (deployment *deployment) run(cancelCtx *Context, actions runActions, policy
 		ctx = opentracing.ContextWithSpan(ctx, deployment.Ctx.TracingSpan)
 	}
 
-	// Emit an appropriate prelude event.
-	deployment.Options.Events.preludeEvent(preview, deployment.Ctx.Update.GetTarget().Config)
-
-	// Execute the deployment.
-	start := time.Now()
-
 	done := make(chan bool)
 	var walkResult result.Result
 	go func() {
 		opts := deploy.Options{
-			Events:                    actions,
+			Events:                    events,
 			Parallel:                  deployment.Options.Parallel,
 			Refresh:                   deployment.Options.Refresh,
 			RefreshOnly:               deployment.Options.isRefresh,
@@ -248,6 +268,8 @@ func (deployment *deployment) run(cancelCtx *Context, actions runActions, policy
 			TrustDependencies:         deployment.Options.trustDependencies,
 			UseLegacyDiff:             deployment.Options.UseLegacyDiff,
 			DisableResourceReferences: deployment.Options.DisableResourceReferences,
+			PolicyExemptions:          deployment.Options.PolicyExemptions,
+			AnalyzePlanOnly:           analyzePlanOnly,
 		}
 		walkResult = deployment.Deployment.Execute(ctx, opts, preview)
 		close(done)
@@ -273,14 +295,7 @@ func (deployment *deployment) run(cancelCtx *Context, actions runActions, policy
 	case <-done:
 		res = walkResult
 	}
-
-	duration := time.Since(start)
-	changes := actions.Changes()
-
-	// Emit a summary event.
-	deployment.Options.Events.summaryEvent(preview, actions.MaybeCorrupt(), duration, changes, policyPacks)
-
-	return changes, res
+	return res
 }
 
 func (deployment *deployment) Close() error {
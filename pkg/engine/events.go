@@ -30,6 +30,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/deepcopy"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
 // Event represents an event generated by the engine during an operation. The underlying
@@ -60,6 +61,8 @@ func NewEvent(typ EventType, payload interface{}) Event {
 		_, ok = payload.(ResourceOperationFailedPayload)
 	case PolicyViolationEvent:
 		_, ok = payload.(PolicyViolationEventPayload)
+	case PolicyRemediationEvent:
+		_, ok = payload.(PolicyRemediationEventPayload)
 	default:
 		contract.Failf("unknown event type %v", typ)
 	}
@@ -83,6 +86,7 @@ const (
 	ResourceOutputsEvent    EventType = "resource-outputs"
 	ResourceOperationFailed EventType = "resource-operationfailed"
 	PolicyViolationEvent    EventType = "policy-violation"
+	PolicyRemediationEvent  EventType = "policy-remediation"
 )
 
 func (e Event) Payload() interface{} {
@@ -114,6 +118,25 @@ type PolicyViolationEventPayload struct {
 	PolicyPackVersion string
 	EnforcementLevel  apitype.EnforcementLevel
 	Prefix            string
+	Description       string
+	Tags              []string
+	SourcePosition    *resource.SourcePosition // the position of the code that registered the resource, if known.
+
+	// Exempted is true if the violation is waived by a policy exemption, in which case ExemptionJustification
+	// explains why.
+	Exempted               bool
+	ExemptionJustification string
+}
+
+// PolicyRemediationEventPayload is the payload for an event with type `policy-remediation`.
+type PolicyRemediationEventPayload struct {
+	ResourceURN       resource.URN
+	Color             colors.Colorization
+	PolicyName        string
+	PolicyPackName    string
+	PolicyPackVersion string
+	Before            resource.PropertyMap
+	After             resource.PropertyMap
 }
 
 type StdoutEventPayload struct {
@@ -532,16 +555,31 @@ func (e *eventEmitter) summaryEvent(preview, maybeCorrupt bool, duration time.Du
 }
 
 func (e *eventEmitter) policyViolationEvent(urn resource.URN, d plugin.AnalyzeDiagnostic) {
+	e.emitPolicyViolationEvent(urn, d, nil)
+}
+
+func (e *eventEmitter) policyExemptionEvent(urn resource.URN, d plugin.AnalyzeDiagnostic,
+	exemption workspace.PolicyExemption) {
+	e.emitPolicyViolationEvent(urn, d, &exemption)
+}
+
+func (e *eventEmitter) emitPolicyViolationEvent(urn resource.URN, d plugin.AnalyzeDiagnostic,
+	exemption *workspace.PolicyExemption) {
 
 	contract.Requiref(e != nil, "e", "!= nil")
 
-	// Write prefix.
+	// Write prefix. Exempted violations are informational regardless of their enforcement level.
 	var prefix bytes.Buffer
-	switch d.EnforcementLevel {
-	case apitype.Mandatory:
+	switch {
+	case exemption != nil:
+		prefix.WriteString(colors.SpecInfo)
+		prefix.WriteString("exempted ")
+	case d.EnforcementLevel == apitype.Mandatory:
 		prefix.WriteString(colors.SpecError)
-	case apitype.Advisory:
+	case d.EnforcementLevel == apitype.Advisory:
 		prefix.WriteString(colors.SpecWarning)
+	case d.EnforcementLevel == apitype.Remediate:
+		prefix.WriteString(colors.SpecInfo)
 	default:
 		contract.Failf("Unrecognized diagnostic severity: %v", d)
 	}
@@ -559,15 +597,41 @@ func (e *eventEmitter) policyViolationEvent(urn resource.URN, d plugin.AnalyzeDi
 	buffer.WriteString(colors.Reset)
 	buffer.WriteRune('\n')
 
-	e.ch <- NewEvent(PolicyViolationEvent, PolicyViolationEventPayload{
+	payload := PolicyViolationEventPayload{
 		ResourceURN:       urn,
-		Message:           logging.FilterString(buffer.String()),
 		Color:             colors.Raw,
 		PolicyName:        d.PolicyName,
 		PolicyPackName:    d.PolicyPackName,
 		PolicyPackVersion: d.PolicyPackVersion,
 		EnforcementLevel:  d.EnforcementLevel,
 		Prefix:            logging.FilterString(prefix.String()),
+		Description:       d.Description,
+		Tags:              d.Tags,
+		SourcePosition:    d.SourcePosition,
+	}
+	if exemption != nil {
+		buffer.WriteString("exempted until " + exemption.Expires + ": " + exemption.Justification + "\n")
+		payload.Exempted = true
+		payload.ExemptionJustification = exemption.Justification
+	}
+	payload.Message = logging.FilterString(buffer.String())
+
+	e.ch <- NewEvent(PolicyViolationEvent, payload)
+}
+
+func (e *eventEmitter) policyRemediationEvent(urn resource.URN, t plugin.Remediation,
+	before resource.PropertyMap, after resource.PropertyMap, debug bool) {
+
+	contract.Requiref(e != nil, "e", "!= nil")
+
+	e.ch <- NewEvent(PolicyRemediationEvent, PolicyRemediationEventPayload{
+		ResourceURN:       urn,
+		Color:             colors.Raw,
+		PolicyName:        t.PolicyName,
+		PolicyPackName:    t.PolicyPackName,
+		PolicyPackVersion: t.PolicyPackVersion,
+		Before:            filterPropertyMap(before, debug),
+		After:             filterPropertyMap(after, debug),
 	})
 }
 
@@ -497,6 +497,73 @@ func TestImportPlan(t *testing.T) {
 	assert.Len(t, snap.Resources, 4)
 }
 
+func TestImportWithParentsAndDependencies(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				GetSchemaF: func(version int) ([]byte, error) {
+					return []byte(importSchema), nil
+				},
+				ReadF: func(urn resource.URN, id resource.ID,
+					inputs, state resource.PropertyMap) (plugin.ReadResult, resource.Status, error) {
+
+					return plugin.ReadResult{
+						Inputs: resource.PropertyMap{
+							"foo": resource.NewStringProperty("bar"),
+						},
+						Outputs: resource.PropertyMap{
+							"foo": resource.NewStringProperty("bar"),
+						},
+					}, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	parentURN := p.NewURN("pkgA:m:typA", "parent", "")
+	childURN := p.NewURN("pkgA:m:typA", "child", parentURN)
+	depURN := p.NewURN("pkgA:m:typA", "dep", "")
+
+	// Import a parent, a child of that parent, and a resource that depends upon the child. The child and the
+	// dependent resource are listed first to ensure that the import orders them after the resources they refer to.
+	project := p.GetProject()
+	snap, res := ImportOp([]deploy.Import{
+		{Type: "pkgA:m:typA", Name: "dep", ID: "dep-id", Dependencies: []resource.URN{childURN}},
+		{Type: "pkgA:m:typA", Name: "child", ID: "child-id", Parent: parentURN},
+		{Type: "pkgA:m:typA", Name: "parent", ID: "parent-id"},
+	}).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	assert.Len(t, snap.Resources, 5)
+
+	indices := map[resource.URN]int{}
+	for i, r := range snap.Resources {
+		indices[r.URN] = i
+	}
+	assert.Less(t, indices[parentURN], indices[childURN])
+	assert.Less(t, indices[childURN], indices[depURN])
+	assert.Equal(t, parentURN, snap.Resources[indices[childURN]].Parent)
+	assert.Equal(t, []resource.URN{childURN}, snap.Resources[indices[depURN]].Dependencies)
+
+	// An import that depends upon itself should fail.
+	_, res = ImportOp([]deploy.Import{
+		{
+			Type:         "pkgA:m:typA",
+			Name:         "loop",
+			ID:           "loop-id",
+			Dependencies: []resource.URN{p.NewURN("pkgA:m:typA", "loop", "")},
+		},
+	}).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	assert.NotNil(t, res)
+}
+
 func TestImportIgnoreChanges(t *testing.T) {
 	loaders := []*deploytest.ProviderLoader{
 		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
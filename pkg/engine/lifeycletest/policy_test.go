@@ -0,0 +1,586 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycletest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/pulumi/pulumi/pkg/v3/engine"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/deploytest"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// testAnalyzer is a plugin.Analyzer whose behavior is supplied by its fields.
+type testAnalyzer struct {
+	RemediateF    func(r plugin.AnalyzerResource) ([]plugin.Remediation, error)
+	AnalyzeF      func(r plugin.AnalyzerResource) ([]plugin.AnalyzeDiagnostic, error)
+	AnalyzeStackF func(resources []plugin.AnalyzerStackResource) ([]plugin.AnalyzeDiagnostic, error)
+	AnalyzePlanF  func(steps []plugin.AnalyzerPlannedStep) ([]plugin.AnalyzeDiagnostic, error)
+}
+
+func (a *testAnalyzer) Close() error       { return nil }
+func (a *testAnalyzer) Name() tokens.QName { return "testAnalyzer" }
+
+func (a *testAnalyzer) Analyze(r plugin.AnalyzerResource) ([]plugin.AnalyzeDiagnostic, error) {
+	if a.AnalyzeF == nil {
+		return nil, nil
+	}
+	return a.AnalyzeF(r)
+}
+
+func (a *testAnalyzer) AnalyzeStack(resources []plugin.AnalyzerStackResource) ([]plugin.AnalyzeDiagnostic, error) {
+	if a.AnalyzeStackF == nil {
+		return nil, nil
+	}
+	return a.AnalyzeStackF(resources)
+}
+
+func (a *testAnalyzer) AnalyzePlan(steps []plugin.AnalyzerPlannedStep) ([]plugin.AnalyzeDiagnostic, error) {
+	if a.AnalyzePlanF == nil {
+		return nil, nil
+	}
+	return a.AnalyzePlanF(steps)
+}
+
+func (a *testAnalyzer) Remediate(r plugin.AnalyzerResource) ([]plugin.Remediation, error) {
+	if a.RemediateF == nil {
+		return nil, nil
+	}
+	return a.RemediateF(r)
+}
+
+func (a *testAnalyzer) GetAnalyzerInfo() (plugin.AnalyzerInfo, error) {
+	return plugin.AnalyzerInfo{Name: "testAnalyzer"}, nil
+}
+
+func (a *testAnalyzer) GetPluginInfo() (workspace.PluginInfo, error) {
+	return workspace.PluginInfo{Name: "testAnalyzer", Kind: workspace.AnalyzerPlugin}, nil
+}
+
+func (a *testAnalyzer) Configure(policyConfig map[string]plugin.AnalyzerPolicyConfig) error {
+	return nil
+}
+
+// analyzerHost is a plugin host that reports a fixed set of analyzers.
+type analyzerHost struct {
+	plugin.Host
+
+	analyzers []plugin.Analyzer
+}
+
+func (host *analyzerHost) ListAnalyzers() []plugin.Analyzer {
+	return host.analyzers
+}
+
+func TestPolicyRemediation(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{
+				"encrypted": resource.NewBoolProperty(false),
+			},
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+
+	// The analyzer requires encryption and tags every resource with its owner. The second remediation should see the
+	// result of the first, and analysis should see the result of both.
+	var analyzed resource.PropertyMap
+	analyzer := &testAnalyzer{
+		RemediateF: func(r plugin.AnalyzerResource) ([]plugin.Remediation, error) {
+			if r.Type != "pkgA:m:typA" {
+				return nil, nil
+			}
+
+			encrypted := r.Properties.Copy()
+			encrypted["encrypted"] = resource.NewBoolProperty(true)
+
+			tagged := encrypted.Copy()
+			tagged["tags"] = resource.NewObjectProperty(resource.PropertyMap{
+				"owner": resource.NewStringProperty("ops"),
+			})
+
+			return []plugin.Remediation{
+				{PolicyName: "require-encryption", PolicyPackName: "pack", Properties: encrypted},
+				{PolicyName: "advisory-only", PolicyPackName: "pack", Diagnostic: "unable to remediate"},
+				{PolicyName: "require-tags", PolicyPackName: "pack", Properties: tagged},
+			}, nil
+		},
+		AnalyzeF: func(r plugin.AnalyzerResource) ([]plugin.AnalyzeDiagnostic, error) {
+			if r.Type == "pkgA:m:typA" {
+				analyzed = r.Properties
+			}
+			return nil, nil
+		},
+	}
+	host := &analyzerHost{
+		Host:      deploytest.NewPluginHost(nil, nil, program, loaders...),
+		analyzers: []plugin.Analyzer{analyzer},
+	}
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	resURN := p.NewURN("pkgA:m:typA", "resA", "")
+
+	expected := resource.PropertyMap{
+		"encrypted": resource.NewBoolProperty(true),
+		"tags": resource.NewObjectProperty(resource.PropertyMap{
+			"owner": resource.NewStringProperty("ops"),
+		}),
+	}
+
+	project := p.GetProject()
+	snap, res := TestOp(Update).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, res result.Result) result.Result {
+			var remediations []PolicyRemediationEventPayload
+			var violations []PolicyViolationEventPayload
+			for _, e := range events {
+				switch e.Type {
+				case PolicyRemediationEvent:
+					remediations = append(remediations, e.Payload().(PolicyRemediationEventPayload))
+				case PolicyViolationEvent:
+					violations = append(violations, e.Payload().(PolicyViolationEventPayload))
+				}
+			}
+
+			if assert.Len(t, remediations, 2) {
+				assert.Equal(t, resURN, remediations[0].ResourceURN)
+				assert.Equal(t, "require-encryption", remediations[0].PolicyName)
+				assert.Equal(t, resource.NewBoolProperty(false), remediations[0].Before["encrypted"])
+				assert.Equal(t, resource.NewBoolProperty(true), remediations[0].After["encrypted"])
+
+				assert.Equal(t, "require-tags", remediations[1].PolicyName)
+				assert.Equal(t, expected, remediations[1].After)
+			}
+			if assert.Len(t, violations, 1) {
+				assert.Equal(t, "advisory-only", violations[0].PolicyName)
+				assert.Contains(t, violations[0].Message, "unable to remediate")
+			}
+			return res
+		})
+	assert.Nil(t, res)
+	assert.Equal(t, expected, analyzed)
+
+	var found bool
+	for _, r := range snap.Resources {
+		if r.URN == resURN {
+			found = true
+			assert.Equal(t, expected, r.Inputs)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestPolicyRemediationIsChecked(t *testing.T) {
+	var checked []resource.PropertyMap
+	created := false
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CheckF: func(urn resource.URN,
+					olds, news resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
+
+					checked = append(checked, news)
+					if size, ok := news["size"]; ok && size.IsNumber() && size.NumberValue() > 10 {
+						return nil, []plugin.CheckFailure{{Property: "size", Reason: "size must be at most 10"}}, nil
+					}
+					return news, nil, nil
+				},
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					created = true
+					return "id", news, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"size": resource.NewNumberProperty(5)},
+		})
+		assert.Error(t, err)
+		return err
+	})
+
+	// The analyzer remediates the resource's inputs into inputs that the provider rejects.
+	analyzer := &testAnalyzer{
+		RemediateF: func(r plugin.AnalyzerResource) ([]plugin.Remediation, error) {
+			if r.Type != "pkgA:m:typA" {
+				return nil, nil
+			}
+			remediated := r.Properties.Copy()
+			remediated["size"] = resource.NewNumberProperty(100)
+			return []plugin.Remediation{
+				{PolicyName: "grow", PolicyPackName: "pack", Properties: remediated},
+			}, nil
+		},
+	}
+	host := &analyzerHost{
+		Host:      deploytest.NewPluginHost(nil, nil, program, loaders...),
+		analyzers: []plugin.Analyzer{analyzer},
+	}
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	_, res := TestOp(Update).Run(p.GetProject(), p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	assert.NotNil(t, res)
+	assert.False(t, created)
+
+	// The program's inputs and the remediated inputs are both checked.
+	var sizes []float64
+	for _, news := range checked {
+		if size, ok := news["size"]; ok {
+			sizes = append(sizes, size.NumberValue())
+		}
+	}
+	assert.Equal(t, []float64{5, 100}, sizes)
+}
+
+func TestCheckPolicies(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					outs := news.Copy()
+					outs["arn"] = resource.NewStringProperty("arn:" + string(urn.Name()))
+					return "id-" + resource.ID(urn.Name()), outs, resource.StatusOK, nil
+				},
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"encrypted": resource.NewBoolProperty(false)},
+		})
+		assert.NoError(t, err)
+		_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"encrypted": resource.NewBoolProperty(true)},
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	project := p.GetProject()
+	snap, res := TestOp(Update).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+
+	// The analyzer requires encryption of each resource, and that the stack contains no more than one resource with
+	// an ARN. Resources should be analyzed using their recorded inputs and the stack using the recorded outputs.
+	var analyzedProvider *plugin.AnalyzerProviderResource
+	analyzer := &testAnalyzer{
+		AnalyzeF: func(r plugin.AnalyzerResource) ([]plugin.AnalyzeDiagnostic, error) {
+			if r.Type != "pkgA:m:typA" {
+				return nil, nil
+			}
+			analyzedProvider = r.Provider
+			if r.Properties["encrypted"].IsBool() && r.Properties["encrypted"].BoolValue() {
+				return nil, nil
+			}
+			return []plugin.AnalyzeDiagnostic{{
+				PolicyName:       "require-encryption",
+				PolicyPackName:   "pack",
+				Message:          "resources must be encrypted",
+				EnforcementLevel: apitype.Mandatory,
+			}}, nil
+		},
+		AnalyzeStackF: func(resources []plugin.AnalyzerStackResource) ([]plugin.AnalyzeDiagnostic, error) {
+			arns := 0
+			for _, r := range resources {
+				if _, ok := r.Properties["arn"]; ok {
+					arns++
+				}
+			}
+			if arns <= 1 {
+				return nil, nil
+			}
+			return []plugin.AnalyzeDiagnostic{{
+				PolicyName:       "single-resource",
+				PolicyPackName:   "pack",
+				Message:          "too many resources",
+				EnforcementLevel: apitype.Advisory,
+			}}, nil
+		},
+	}
+	checkHost := &analyzerHost{
+		Host:      deploytest.NewPluginHost(nil, nil, program, loaders...),
+		analyzers: []plugin.Analyzer{analyzer},
+	}
+
+	target := p.GetTarget(snap)
+	diags, err := CheckPolicies(&project, "", &target, PolicyCheckOptions{Host: checkHost})
+	assert.NoError(t, err)
+	if assert.Len(t, diags, 2) {
+		assert.Equal(t, "require-encryption", diags[0].PolicyName)
+		assert.Equal(t, p.NewURN("pkgA:m:typA", "resA", ""), diags[0].URN)
+		assert.Equal(t, "single-resource", diags[1].PolicyName)
+		assert.Equal(t, resource.DefaultRootStackURN(target.Name, project.Name), diags[1].URN)
+	}
+	if assert.NotNil(t, analyzedProvider) {
+		assert.Equal(t, p.NewProviderURN("pkgA", "default", ""), analyzedProvider.URN)
+	}
+}
+
+func TestPolicyExemptions(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{}, nil
+		}),
+	}
+
+	sourcePosition := &resource.SourcePosition{URI: "file:///app/main.go", Line: 12}
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "legacy-logs", true, deploytest.ResourceOptions{
+			SourcePosition: sourcePosition,
+		})
+		assert.NoError(t, err)
+		return nil
+	})
+
+	// The analyzer reports a mandatory violation for every resource.
+	analyzer := &testAnalyzer{
+		AnalyzeF: func(r plugin.AnalyzerResource) ([]plugin.AnalyzeDiagnostic, error) {
+			if r.Type != "pkgA:m:typA" {
+				return nil, nil
+			}
+			return []plugin.AnalyzeDiagnostic{{
+				PolicyName:       "require-encryption",
+				PolicyPackName:   "pack",
+				Message:          "resources must be encrypted",
+				EnforcementLevel: apitype.Mandatory,
+			}}, nil
+		},
+	}
+	host := &analyzerHost{
+		Host:      deploytest.NewPluginHost(nil, nil, program, loaders...),
+		analyzers: []plugin.Analyzer{analyzer},
+	}
+
+	exemption := workspace.PolicyExemption{
+		Policy:        "pack/require-encryption",
+		URN:           "*::legacy-*",
+		Justification: "Scheduled for deletion.",
+		Expires:       time.Now().AddDate(0, 0, 7).Format(workspace.PolicyExemptionDateFormat),
+	}
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host, PolicyExemptions: []workspace.PolicyExemption{exemption}},
+	}
+	resURN := p.NewURN("pkgA:m:typA", "legacy-logs", "")
+
+	// The exempted violation should be reported, but should not fail the update.
+	project := p.GetProject()
+	_, res := TestOp(Update).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, _ JournalEntries, events []Event, res result.Result) result.Result {
+			var violations []PolicyViolationEventPayload
+			for _, e := range events {
+				if e.Type == PolicyViolationEvent {
+					violations = append(violations, e.Payload().(PolicyViolationEventPayload))
+				}
+			}
+			if assert.Len(t, violations, 1) {
+				assert.Equal(t, resURN, violations[0].ResourceURN)
+				assert.True(t, violations[0].Exempted)
+				assert.Equal(t, "Scheduled for deletion.", violations[0].ExemptionJustification)
+				assert.Equal(t, sourcePosition, violations[0].SourcePosition)
+			}
+			return res
+		})
+	assert.Nil(t, res)
+
+	// An exemption that does not match the resource should leave the violation in place.
+	p.Options.PolicyExemptions[0].URN = "*::other"
+	_, res = TestOp(Update).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	assert.NotNil(t, res)
+
+	// An expired exemption should fail the update.
+	p.Options.PolicyExemptions[0] = exemption
+	p.Options.PolicyExemptions[0].Expires = time.Now().AddDate(0, 0, -1).Format(workspace.PolicyExemptionDateFormat)
+	_, res = TestOp(Update).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	if assert.NotNil(t, res) {
+		assert.Contains(t, res.Error().Error(), "expired policy exemptions must be renewed or removed")
+	}
+}
+
+func TestPolicyPlanAnalysis(t *testing.T) {
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			return &deploytest.Provider{
+				CreateF: func(urn resource.URN, news resource.PropertyMap, timeout float64,
+					preview bool) (resource.ID, resource.PropertyMap, resource.Status, error) {
+					return "id-" + resource.ID(urn.Name()), news, resource.StatusOK, nil
+				},
+				DiffF: func(urn resource.URN, id resource.ID,
+					olds, news resource.PropertyMap, ignoreChanges []string) (plugin.DiffResult, error) {
+
+					if !olds["class"].DeepEquals(news["class"]) {
+						return plugin.DiffResult{
+							Changes:     plugin.DiffSome,
+							ReplaceKeys: []resource.PropertyKey{"class"},
+							DetailedDiff: map[string]plugin.PropertyDiff{
+								"class": {Kind: plugin.DiffUpdateReplace},
+							},
+						}, nil
+					}
+					return plugin.DiffResult{}, nil
+				},
+			}, nil
+		}),
+	}
+
+	class, createB := "db.small", true
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		_, _, _, err := monitor.RegisterResource("pkgA:m:typA", "resA", true, deploytest.ResourceOptions{
+			Inputs: resource.PropertyMap{"class": resource.NewStringProperty(class)},
+		})
+		assert.NoError(t, err)
+		if createB {
+			_, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resB", true, deploytest.ResourceOptions{})
+			assert.NoError(t, err)
+		}
+		return nil
+	})
+
+	// The analyzer forbids replacing databases and deleting resources.
+	var planned []plugin.AnalyzerPlannedStep
+	analyzer := &testAnalyzer{
+		AnalyzePlanF: func(steps []plugin.AnalyzerPlannedStep) ([]plugin.AnalyzeDiagnostic, error) {
+			planned = steps
+
+			var diags []plugin.AnalyzeDiagnostic
+			for _, s := range steps {
+				switch {
+				case s.Op == "replace" && s.Old.Properties["class"].DeepEquals(resource.NewStringProperty("db.small")):
+					diags = append(diags, plugin.AnalyzeDiagnostic{
+						PolicyName:       "no-db-replace",
+						PolicyPackName:   "pack",
+						Message:          "databases must not be replaced",
+						EnforcementLevel: apitype.Mandatory,
+						URN:              s.URN,
+					})
+				case s.Op == "delete":
+					diags = append(diags, plugin.AnalyzeDiagnostic{
+						PolicyName:       "no-deletes",
+						PolicyPackName:   "pack",
+						Message:          "resources must not be deleted",
+						EnforcementLevel: apitype.Advisory,
+						URN:              s.URN,
+					})
+				}
+			}
+			return diags, nil
+		},
+	}
+	host := &analyzerHost{
+		Host:      deploytest.NewPluginHost(nil, nil, program, loaders...),
+		analyzers: []plugin.Analyzer{analyzer},
+	}
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+	}
+	project := p.GetProject()
+	resA, resB := p.NewURN("pkgA:m:typA", "resA", ""), p.NewURN("pkgA:m:typA", "resB", "")
+
+	snap, res := TestOp(Update).Run(project, p.GetTarget(nil), p.Options, false, p.BackendClient, nil)
+	assert.Nil(t, res)
+	ops := map[resource.URN]string{}
+	for _, s := range planned {
+		ops[s.URN] = s.Op
+	}
+	assert.Equal(t, "create", ops[resA])
+	assert.Equal(t, "create", ops[resB])
+
+	// Replacing resA and deleting resB should report both violations, and the mandatory violation should fail the
+	// preview.
+	class, createB = "db.large", false
+	_, res = TestOp(Update).Run(project, p.GetTarget(snap), p.Options, true, p.BackendClient, nil)
+	assert.NotNil(t, res)
+
+	// The update's plan is analyzed before any of its steps are applied, so the mandatory violation should fail the
+	// update without changing the stack.
+	var violations []PolicyViolationEventPayload
+	var applied JournalEntries
+	snap, res = TestOp(Update).Run(project, p.GetTarget(snap), p.Options, false, p.BackendClient,
+		func(_ workspace.Project, _ deploy.Target, entries JournalEntries, events []Event,
+			res result.Result) result.Result {
+
+			applied = entries
+			for _, e := range events {
+				if e.Type == PolicyViolationEvent {
+					violations = append(violations, e.Payload().(PolicyViolationEventPayload))
+				}
+			}
+			return res
+		})
+	assert.NotNil(t, res)
+	assert.Empty(t, applied)
+
+	var replace *plugin.AnalyzerPlannedStep
+	ops = map[resource.URN]string{}
+	for i, s := range planned {
+		ops[s.URN] += s.Op + " "
+		if s.Op == "replace" {
+			replace = &planned[i]
+		}
+	}
+	assert.Equal(t, "create-replacement replace delete-replaced ", ops[resA])
+	assert.Equal(t, "delete ", ops[resB])
+	if assert.NotNil(t, replace) {
+		assert.Equal(t, resource.NewStringProperty("db.large"), replace.New.Properties["class"])
+		assert.Equal(t, []resource.PropertyKey{"class"}, replace.ReplaceKeys)
+		assert.Equal(t, map[string]plugin.PropertyDiff{"class": {Kind: plugin.DiffUpdateReplace}},
+			replace.DetailedDiff)
+	}
+
+	if assert.Len(t, violations, 2) {
+		assert.Equal(t, "no-db-replace", violations[0].PolicyName)
+		assert.Equal(t, resA, violations[0].ResourceURN)
+		assert.Equal(t, "no-deletes", violations[1].PolicyName)
+		assert.Equal(t, resB, violations[1].ResourceURN)
+	}
+
+	urns := map[resource.URN]*resource.State{}
+	for _, r := range snap.Resources {
+		urns[r.URN] = r
+	}
+	if assert.Contains(t, urns, resA) {
+		assert.Equal(t, resource.NewStringProperty("db.small"), urns[resA].Inputs["class"])
+	}
+	assert.Contains(t, urns, resB)
+}
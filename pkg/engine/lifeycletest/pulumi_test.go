@@ -1787,6 +1787,83 @@ func TestSingleComponentDefaultProviderLifecycle(t *testing.T) {
 	p.Run(t, nil)
 }
 
+func TestSingleComponentMethodCall(t *testing.T) {
+	var urnA resource.URN
+	loaders := []*deploytest.ProviderLoader{
+		deploytest.NewProviderLoader("pkgA", semver.MustParse("1.0.0"), func() (plugin.Provider, error) {
+			construct := func(monitor *deploytest.ResourceMonitor,
+				typ, name string, parent resource.URN, inputs resource.PropertyMap,
+				options plugin.ConstructOptions) (plugin.ConstructResult, error) {
+
+				urn, _, _, err := monitor.RegisterResource(tokens.Type(typ), name, false, deploytest.ResourceOptions{
+					Parent: parent,
+				})
+				assert.NoError(t, err)
+
+				return plugin.ConstructResult{
+					URN:     urn,
+					Outputs: resource.PropertyMap{},
+				}, nil
+			}
+
+			call := func(monitor *deploytest.ResourceMonitor, tok tokens.ModuleMember, args resource.PropertyMap,
+				info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error) {
+
+				assert.Equal(t, tokens.ModuleMember("pkgA:m:typA/methodA"), tok)
+				assert.Equal(t, resource.MakeComponentResourceReference(urnA, ""), args["__self__"])
+				assert.Equal(t, resource.NewStringProperty("bar"), args["foo"])
+				assert.Equal(t, []resource.URN{urnA}, options.ArgDependencies["foo"])
+				assert.NotEmpty(t, info.MonitorAddress)
+
+				return plugin.CallResult{
+					Return: resource.PropertyMap{
+						"result": resource.NewStringProperty("baz"),
+					},
+					ReturnDependencies: map[resource.PropertyKey][]resource.URN{
+						"result": {urnA},
+					},
+				}, nil
+			}
+
+			return &deploytest.Provider{
+				ConstructF: construct,
+				CallF:      call,
+			}, nil
+		}),
+	}
+
+	program := deploytest.NewLanguageRuntime(func(_ plugin.RunInfo, monitor *deploytest.ResourceMonitor) error {
+		var err error
+		urnA, _, _, err = monitor.RegisterResource("pkgA:m:typA", "resA", false, deploytest.ResourceOptions{
+			Remote: true,
+		})
+		assert.NoError(t, err)
+
+		ret, deps, failures, err := monitor.Call("pkgA:m:typA/methodA", resource.PropertyMap{
+			"__self__": resource.MakeComponentResourceReference(urnA, ""),
+			"foo":      resource.NewStringProperty("bar"),
+		}, map[resource.PropertyKey][]resource.URN{
+			"foo": {urnA},
+		}, "", "")
+		assert.NoError(t, err)
+		assert.Empty(t, failures)
+		assert.Equal(t, resource.PropertyMap{
+			"result": resource.NewStringProperty("baz"),
+		}, ret)
+		assert.Equal(t, map[resource.PropertyKey][]resource.URN{
+			"result": {urnA},
+		}, deps)
+		return nil
+	})
+	host := deploytest.NewPluginHost(nil, nil, program, loaders...)
+
+	p := &TestPlan{
+		Options: UpdateOptions{Host: host},
+		Steps:   MakeBasicLifecycleSteps(t, 2),
+	}
+	p.Run(t, nil)
+}
+
 type updateContext struct {
 	*deploytest.ResourceMonitor
 
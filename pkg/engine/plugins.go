@@ -17,14 +17,19 @@ package engine
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/blang/semver"
+	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
 	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
@@ -127,14 +132,103 @@ func gatherPluginsFromSnapshot(plugctx *plugin.Context, target *deploy.Target) (
 	return set, nil
 }
 
+// loadPluginLock reads the plugin lock file of the project in the given root directory, if there is one.
+func loadPluginLock(root string) (*workspace.PluginLock, error) {
+	if root == "" {
+		return nil, nil
+	}
+	lock, err := workspace.LoadPluginLock(filepath.Join(root, workspace.PluginLockFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return lock, nil
+}
+
+// applyPluginLock pins the plugins required by the program and by the snapshot to the plugins recorded in the
+// project's plugin lock, including their servers and expected checksums. Plugins that the program requires without a
+// version are pinned to the locked version, and locked resource plugins that the program does not report are added to
+// the program's plugins, so that default providers use the locked versions rather than the newest installed versions.
+// If the program requires a plugin that is not locked or that is locked to a different version, an error is returned
+// if locked is true, and a warning is issued otherwise.
+func applyPluginLock(d diag.Sink, lock *workspace.PluginLock, locked bool,
+	languagePlugins, snapshotPlugins pluginSet) (pluginSet, pluginSet, error) {
+
+	var mismatches []string
+	pin := func(plug workspace.PluginInfo, fromProgram bool) workspace.PluginInfo {
+		if plug.Kind == workspace.LanguagePlugin {
+			return plug
+		}
+		lp, ok := lock.Get(plug.Kind, plug.Name)
+		if !ok {
+			if fromProgram {
+				mismatches = append(mismatches, fmt.Sprintf("%s plugin %s is not locked", plug.Kind, plug))
+			}
+			return plug
+		}
+
+		pinned := lp.PluginInfo()
+		if plug.Version != nil && !plug.Version.EQ(*pinned.Version) {
+			// The snapshot may refer to older versions of locked plugins until the stack is next updated.
+			if fromProgram {
+				mismatches = append(mismatches, fmt.Sprintf("the program requires %s plugin %s, but %s is locked",
+					plug.Kind, plug, lp.Version))
+			}
+			return plug
+		}
+		if plug.ServerURL != "" {
+			pinned.ServerURL = plug.ServerURL
+		}
+		return pinned
+	}
+
+	newLanguagePlugins, newSnapshotPlugins := newPluginSet(), newPluginSet()
+	for _, plug := range languagePlugins.Values() {
+		newLanguagePlugins.Add(pin(plug, true))
+	}
+	for _, plug := range snapshotPlugins.Values() {
+		newSnapshotPlugins.Add(pin(plug, false))
+	}
+	for _, lp := range lock.Plugins {
+		if lp.Kind != workspace.ResourcePlugin {
+			continue
+		}
+		found := false
+		for _, plug := range newLanguagePlugins {
+			if plug.Kind == lp.Kind && plug.Name == lp.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			newLanguagePlugins.Add(lp.PluginInfo())
+		}
+	}
+
+	if len(mismatches) > 0 {
+		sort.Strings(mismatches)
+		if locked {
+			return nil, nil, errors.Errorf("the plugins required by the program do not match %s:\n  %s\n"+
+				"run `pulumi plugin lock` to update it", workspace.PluginLockFile, strings.Join(mismatches, "\n  "))
+		}
+		for _, mismatch := range mismatches {
+			d.Warningf(diag.Message("", "%s; run `pulumi plugin lock` to update %s"), mismatch, workspace.PluginLockFile)
+		}
+	}
+	return newLanguagePlugins, newSnapshotPlugins, nil
+}
+
 // ensurePluginsAreInstalled inspects all plugins in the plugin set and, if any plugins are not currently installed,
-// uses the given backend client to install them. Installations are processed in parallel, though
-// ensurePluginsAreInstalled does not return until all installations are completed.
-func ensurePluginsAreInstalled(plugins pluginSet) error {
+// uses the given backend client to install them. Plugins that are overridden by the project's local plugins are not
+// installed. Installations are processed in parallel, though ensurePluginsAreInstalled does not return until all
+// installations are completed.
+func ensurePluginsAreInstalled(plugins pluginSet, projectPlugins []workspace.ProjectPlugin) error {
 	logging.V(preparePluginLog).Infof("ensurePluginsAreInstalled(): beginning")
 	var installTasks errgroup.Group
 	for _, plug := range plugins.Values() {
-		_, path, err := workspace.GetPluginPath(plug.Kind, plug.Name, plug.Version)
+		_, path, err := workspace.GetPluginPathWithOverrides(plug.Kind, plug.Name, plug.Version, projectPlugins)
 		if err == nil && path != "" {
 			logging.V(preparePluginLog).Infof(
 				"ensurePluginsAreInstalled(): plugin %s %s already installed", plug.Name, plug.Version)
@@ -155,12 +249,97 @@ func ensurePluginsAreInstalled(plugins pluginSet) error {
 	return err
 }
 
+// verifyPluginChecksums checks that the installed plugins that have expected checksums, i.e. those that are pinned by
+// the project's plugin lock, were installed from tarballs with those checksums. Plugins that were installed before
+// checksums were recorded cannot be verified, and are rejected. Plugins that are overridden by the project's local
+// plugins or found on the $PATH were not installed from tarballs, and are not checked.
+func verifyPluginChecksums(plugins pluginSet, projectPlugins []workspace.ProjectPlugin) error {
+	for _, plug := range plugins.Values() {
+		if plug.Checksum == "" {
+			continue
+		}
+		dir, path, err := workspace.GetPluginPathWithOverrides(plug.Kind, plug.Name, plug.Version, projectPlugins)
+		if err != nil || path == "" {
+			// The plugin is not installed. This is reported when the plugin is loaded.
+			continue
+		}
+		cacheDir, err := plug.DirPath()
+		if err != nil {
+			return err
+		}
+		if dir != cacheDir {
+			continue
+		}
+
+		checksum, err := plug.InstalledChecksum()
+		if err != nil {
+			return errors.Wrapf(err, "reading the checksum of %s plugin %s", plug.Kind, plug)
+		}
+		if checksum == "" {
+			return errors.Errorf("%s plugin %s was installed without a checksum, so it cannot be verified against "+
+				"%s; run `pulumi plugin rm %s %s %s` to remove it so that it is reinstalled",
+				plug.Kind, plug, workspace.PluginLockFile, plug.Kind, plug.Name, plug.Version)
+		}
+		if !strings.EqualFold(checksum, plug.Checksum) {
+			return &workspace.ChecksumMismatchError{
+				Info:     plug,
+				Expected: strings.ToLower(plug.Checksum),
+				Actual:   checksum,
+			}
+		}
+	}
+	return nil
+}
+
 // ensurePluginsAreLoaded ensures that all of the plugins in the given plugin set that match the given plugin flags are
 // loaded.
 func ensurePluginsAreLoaded(plugctx *plugin.Context, plugins pluginSet, kinds plugin.Flags) error {
 	return plugctx.Host.EnsurePlugins(plugins.Values(), kinds)
 }
 
+// pluginCacheProjectAge is how recently a project must have been used for the plugins it requires to be kept when
+// the plugin cache is pruned to its maximum size.
+const pluginCacheProjectAge = 30 * 24 * time.Hour
+
+// prunePluginCache removes the least recently used plugins that no recently used project requires from the plugin
+// cache until it fits, if the plugin cache has a maximum size. It is called once a deployment has finished, and never
+// removes the plugins that the deployment loaded. Pruning is best-effort: failures are reported as warnings.
+func prunePluginCache(d diag.Sink, loaded []workspace.PluginInfo) {
+	maxSize, err := workspace.GetPluginCacheMaxSize()
+	if err != nil || maxSize == "" {
+		return
+	}
+	maxBytes, err := humanize.ParseBytes(maxSize)
+	if err != nil {
+		d.Warningf(diag.Message("", "ignoring invalid maximum plugin cache size %q: %v"), maxSize, err)
+		return
+	}
+
+	installed, err := workspace.GetPluginsWithMetadata()
+	if err != nil {
+		d.Warningf(diag.Message("", "could not prune the plugin cache: %v"), err)
+		return
+	}
+	refs, err := workspace.GetPluginReferences()
+	if err != nil {
+		d.Warningf(diag.Message("", "could not prune the plugin cache: %v"), err)
+		return
+	}
+	prune := workspace.SelectPluginsToPrune(installed, refs, time.Now(), workspace.PluginPruneOptions{
+		ProjectAge: pluginCacheProjectAge,
+		MaxSize:    maxBytes,
+		Keep:       loaded,
+	})
+	for _, plug := range prune {
+		if err := plug.Prune(); err != nil {
+			d.Warningf(diag.Message("", "could not remove %s plugin %s from the plugin cache: %v"), plug.Kind, plug, err)
+			continue
+		}
+		d.Infof(diag.Message("", "removed %s plugin %s (%s) from the plugin cache, which is limited to %s"),
+			plug.Kind, plug, humanize.Bytes(uint64(plug.Size)), maxSize)
+	}
+}
+
 // installPlugin installs a plugin from the given backend client.
 func installPlugin(plugin workspace.PluginInfo) error {
 	logging.V(preparePluginLog).Infof("installPlugin(%s, %s): beginning install", plugin.Name, plugin.Version)
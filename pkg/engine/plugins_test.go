@@ -15,11 +15,18 @@
 package engine
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/blang/semver"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
@@ -124,3 +131,97 @@ func TestDefaultProvidersSnapshotOverrides(t *testing.T) {
 	assert.NotNil(t, awsVer)
 	assert.Equal(t, "0.17.0", awsVer.String())
 }
+
+func TestApplyPluginLock(t *testing.T) {
+	lock := &workspace.PluginLock{Plugins: []workspace.LockedPlugin{
+		{Kind: workspace.ResourcePlugin, Name: "aws", Version: "3.0.0", Server: workspace.DefaultPluginServerURL,
+			Checksums: map[string]string{workspace.CurrentPlatform(): "aaaa"}},
+		{Kind: workspace.ResourcePlugin, Name: "random", Version: "4.0.0", Server: "https://plugins.example.com"},
+	}}
+
+	var warnings bytes.Buffer
+	sink := diag.DefaultSink(&warnings, &warnings, diag.FormatOptions{Color: colors.Never})
+
+	languagePlugins := newPluginSet()
+	languagePlugins.Add(workspace.PluginInfo{Name: "aws", Kind: workspace.ResourcePlugin})
+	languagePlugins.Add(workspace.PluginInfo{Name: "nodejs", Kind: workspace.LanguagePlugin})
+	snapshotPlugins := newPluginSet()
+	snapshotPlugins.Add(workspace.PluginInfo{Name: "aws", Kind: workspace.ResourcePlugin,
+		Version: mustMakeVersion("2.0.0")})
+
+	languagePlugins, snapshotPlugins, err := applyPluginLock(sink, lock, true, languagePlugins, snapshotPlugins)
+	require.NoError(t, err)
+	assert.Empty(t, warnings.String())
+
+	// Unversioned plugins are pinned to the locked version and checksum, and locked plugins that the program does not
+	// report are added. Older versions in the snapshot are left as they are.
+	values := languagePlugins.Values()
+	require.Len(t, values, 3)
+	byName := map[string]workspace.PluginInfo{}
+	for _, plug := range values {
+		byName[plug.Name] = plug
+	}
+	assert.Equal(t, "3.0.0", byName["aws"].Version.String())
+	assert.Equal(t, "aaaa", byName["aws"].Checksum)
+	assert.Equal(t, "4.0.0", byName["random"].Version.String())
+	assert.Equal(t, "https://plugins.example.com", byName["random"].ServerURL)
+	assert.Nil(t, byName["nodejs"].Version)
+
+	snapshotValues := snapshotPlugins.Values()
+	require.Len(t, snapshotValues, 1)
+	assert.Equal(t, "2.0.0", snapshotValues[0].Version.String())
+
+	// Plugins that do not match the lock are an error if the lock is enforced, and a warning otherwise.
+	mismatched := newPluginSet()
+	mismatched.Add(workspace.PluginInfo{Name: "aws", Kind: workspace.ResourcePlugin, Version: mustMakeVersion("3.1.0")})
+	mismatched.Add(workspace.PluginInfo{Name: "gcp", Kind: workspace.ResourcePlugin, Version: mustMakeVersion("5.0.0")})
+
+	_, _, err = applyPluginLock(sink, lock, true, mismatched, newPluginSet())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "resource plugin gcp-5.0.0 is not locked")
+	assert.Contains(t, err.Error(), "the program requires resource plugin aws-3.1.0, but 3.0.0 is locked")
+
+	languagePlugins, _, err = applyPluginLock(sink, lock, false, mismatched, newPluginSet())
+	require.NoError(t, err)
+	assert.Contains(t, warnings.String(), "resource plugin gcp-5.0.0 is not locked")
+	assert.Len(t, languagePlugins.Values(), 3)
+}
+
+func TestVerifyPluginChecksums(t *testing.T) {
+	home, err := ioutil.TempDir("", "home")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	os.Setenv(workspace.PulumiHomeEnvVar, home)
+	defer os.Unsetenv(workspace.PulumiHomeEnvVar)
+	os.Setenv("PULUMI_IGNORE_AMBIENT_PLUGINS", "true")
+	defer os.Unsetenv("PULUMI_IGNORE_AMBIENT_PLUGINS")
+
+	info := workspace.PluginInfo{Name: "aws", Kind: workspace.ResourcePlugin, Version: mustMakeVersion("3.0.0")}
+	dir, err := info.DirPath()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	binary := filepath.Join(dir, info.FilePrefix()+info.FileSuffix())
+	require.NoError(t, ioutil.WriteFile(binary, nil, 0700))
+
+	plugins := newPluginSet()
+	info.Checksum = "AAAA"
+	plugins.Add(info)
+
+	// Plugins that were installed without a checksum cannot be verified.
+	err = verifyPluginChecksums(plugins, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "was installed without a checksum")
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, workspace.PluginChecksumFile), []byte("bbbb\n"), 0600))
+	err = verifyPluginChecksums(plugins, nil)
+	assert.IsType(t, &workspace.ChecksumMismatchError{}, err)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, workspace.PluginChecksumFile), []byte("aaaa\n"), 0600))
+	assert.NoError(t, verifyPluginChecksums(plugins, nil))
+
+	// Local plugins are not checked.
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, workspace.PluginChecksumFile), []byte("bbbb\n"), 0600))
+	assert.NoError(t, verifyPluginChecksums(plugins, []workspace.ProjectPlugin{
+		{Kind: workspace.ResourcePlugin, Name: "aws", Path: filepath.Join(home, "pulumi-resource-aws")},
+	}))
+}
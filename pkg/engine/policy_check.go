@@ -0,0 +1,188 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy"
+	"github.com/pulumi/pulumi/pkg/v3/resource/deploy/providers"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// PolicyCheckOptions contains the options for checking the resources of a stack against a set of policy packs.
+type PolicyCheckOptions struct {
+	// LocalPolicyPacks contains an optional set of local policy packs to run.
+	LocalPolicyPacks []LocalPolicyPack
+	// RequiredPolicies contains an optional set of published policy packs to run.
+	RequiredPolicies []RequiredPolicy
+	// Host is an optional plugin host to use in place of the default host.
+	Host plugin.Host
+	// Diag is an optional sink for diagnostics reported while loading the policy packs.
+	Diag diag.Sink
+	// PolicyExemptions waive the policy violations of specific resources. Expired exemptions fail the check.
+	PolicyExemptions []workspace.PolicyExemption
+}
+
+// PolicyDiagnostic is a policy violation reported by CheckPolicies.
+type PolicyDiagnostic struct {
+	plugin.AnalyzeDiagnostic
+
+	// Exemption is the policy exemption that waives the violation, if any.
+	Exemption *workspace.PolicyExemption
+}
+
+// CheckPolicies runs the given policy packs against the resources in the target's latest snapshot and returns the
+// resulting diagnostics. Unlike a preview, the program is not run and no providers are loaded: each resource is
+// analyzed using the inputs recorded for it in the snapshot, and the stack as a whole is analyzed using the outputs
+// recorded for each resource.
+//
+// The URN of each returned diagnostic is set to the URN of the resource that violated the policy, or to the URN of
+// the root stack resource if the violation does not apply to a particular resource. Violations that are waived by one
+// of the given policy exemptions are still returned, along with the exemption that waives them.
+func CheckPolicies(proj *workspace.Project, pwd string, target *deploy.Target,
+	opts PolicyCheckOptions) ([]PolicyDiagnostic, error) {
+
+	contract.Require(proj != nil, "proj")
+	contract.Require(target != nil, "target")
+
+	if err := workspace.ValidatePolicyExemptions(opts.PolicyExemptions, time.Now()); err != nil {
+		return nil, err
+	}
+
+	plugctx, err := plugin.NewContext(opts.Diag, opts.Diag, opts.Host, nil, pwd, nil, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer contract.IgnoreClose(plugctx)
+
+	config, err := target.Config.Decrypt(target.Decrypter)
+	if err != nil {
+		return nil, err
+	}
+	analyzerOpts := plugin.PolicyAnalyzerOptions{
+		Project: proj.Name.String(),
+		Stack:   target.Name.String(),
+		Config:  config,
+		DryRun:  true,
+	}
+	if err := installAndLoadPolicyPlugins(plugctx, opts.Diag, opts.RequiredPolicies, opts.LocalPolicyPacks,
+		&analyzerOpts); err != nil {
+		return nil, err
+	}
+
+	// Gather the live resources in the snapshot, indexing providers by URN so that each resource can be sent along
+	// with its provider.
+	var states []*resource.State
+	providerStates := map[resource.URN]*resource.State{}
+	if target.Snapshot != nil {
+		for _, state := range target.Snapshot.Resources {
+			if state.Delete {
+				continue
+			}
+			states = append(states, state)
+			if providers.IsProviderType(state.Type) {
+				providerStates[state.URN] = state
+			}
+		}
+	}
+	getProvider := func(state *resource.State) *plugin.AnalyzerProviderResource {
+		if state.Provider == "" {
+			return nil
+		}
+		ref, err := providers.ParseReference(state.Provider)
+		if err != nil {
+			return nil
+		}
+		provider, ok := providerStates[ref.URN()]
+		if !ok {
+			return nil
+		}
+		return &plugin.AnalyzerProviderResource{
+			URN:        provider.URN,
+			Type:       provider.Type,
+			Name:       provider.URN.Name(),
+			Properties: provider.Inputs,
+		}
+	}
+	analyzerResource := func(state *resource.State, props resource.PropertyMap) plugin.AnalyzerResource {
+		return plugin.AnalyzerResource{
+			URN:        state.URN,
+			Type:       state.Type,
+			Name:       state.URN.Name(),
+			Properties: props,
+			Options: plugin.AnalyzerResourceOptions{
+				Protect:                 state.Protect,
+				AdditionalSecretOutputs: state.AdditionalSecretOutputs,
+				Aliases:                 state.Aliases,
+				CustomTimeouts:          state.CustomTimeouts,
+			},
+			Provider: getProvider(state),
+		}
+	}
+
+	inStack := map[resource.URN]bool{}
+	stackResources := make([]plugin.AnalyzerStackResource, len(states))
+	for i, state := range states {
+		inStack[state.URN] = true
+		stackResources[i] = plugin.AnalyzerStackResource{
+			AnalyzerResource:     analyzerResource(state, state.Outputs),
+			Parent:               state.Parent,
+			Dependencies:         state.Dependencies,
+			PropertyDependencies: state.PropertyDependencies,
+		}
+	}
+	rootURN := resource.DefaultRootStackURN(target.Name, proj.Name)
+
+	var diagnostics []PolicyDiagnostic
+	addDiagnostic := func(d plugin.AnalyzeDiagnostic) {
+		diagnostics = append(diagnostics, PolicyDiagnostic{
+			AnalyzeDiagnostic: d,
+			Exemption: workspace.FindPolicyExemption(opts.PolicyExemptions,
+				d.PolicyPackName, d.PolicyName, string(d.URN)),
+		})
+	}
+	for _, analyzer := range plugctx.Host.ListAnalyzers() {
+		for _, state := range states {
+			diags, err := analyzer.Analyze(analyzerResource(state, state.Inputs))
+			if err != nil {
+				return nil, errors.Wrapf(err, "analyzing %v", state.URN)
+			}
+			for _, d := range diags {
+				d.URN = state.URN
+				addDiagnostic(d)
+			}
+		}
+
+		diags, err := analyzer.AnalyzeStack(stackResources)
+		if err != nil {
+			return nil, errors.Wrap(err, "analyzing stack")
+		}
+		for _, d := range diags {
+			if !inStack[d.URN] {
+				d.URN = rootURN
+			}
+			addDiagnostic(d)
+		}
+	}
+
+	return diagnostics, nil
+}
@@ -93,7 +93,7 @@ func newQuerySource(cancel context.Context, client deploy.BackendClient, q Query
 	opts QueryOptions) (deploy.QuerySource, error) {
 
 	allPlugins, defaultProviderVersions, err := installPlugins(q.GetProject(), opts.pwd, opts.main,
-		nil, opts.plugctx, false /*returnInstallErrors*/)
+		nil, opts.plugctx, false /*lockedPlugins*/, false /*returnInstallErrors*/)
 	if err != nil {
 		return nil, err
 	}
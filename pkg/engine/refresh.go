@@ -66,7 +66,7 @@ func newRefreshSource(client deploy.BackendClient, opts deploymentOptions, proj
 	}
 
 	// Like Update, if we're missing plugins, attempt to download the missing plugins.
-	if err := ensurePluginsAreInstalled(plugins); err != nil {
+	if err := ensurePluginsAreInstalled(plugins, plugctx.ProjectPlugins); err != nil {
 		logging.V(7).Infof("newRefreshSource(): failed to install missing plugins: %v", err)
 	}
 
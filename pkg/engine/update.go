@@ -22,6 +22,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/pkg/errors"
@@ -103,6 +104,9 @@ type UpdateOptions struct {
 	// RequiredPolicies is the set of policies that are required to run as part of the update.
 	RequiredPolicies []RequiredPolicy
 
+	// PolicyExemptions waive the policy violations of specific resources. Expired exemptions fail the update.
+	PolicyExemptions []workspace.PolicyExemption
+
 	// the degree of parallelism for resource operations (<=1 for serial).
 	Parallel int
 
@@ -137,6 +141,9 @@ type UpdateOptions struct {
 	// true if the engine should disable resource reference support.
 	DisableResourceReferences bool
 
+	// true if the update should fail if the plugins the program requires do not match the project's plugin lock file.
+	LockedPlugins bool
+
 	// true if we should report events for steps that involve default providers.
 	reportDefaultProviderSteps bool
 
@@ -191,13 +198,14 @@ func Update(u UpdateInfo, ctx *Context, opts UpdateOptions, dryRun bool) (Resour
 // RunInstallPlugins calls installPlugins and just returns the error (avoids having to export pluginSet).
 func RunInstallPlugins(
 	proj *workspace.Project, pwd, main string, target *deploy.Target, plugctx *plugin.Context) error {
-	_, _, err := installPlugins(proj, pwd, main, target, plugctx, true /*returnInstallErrors*/)
+	_, _, err := installPlugins(proj, pwd, main, target, plugctx, false /*lockedPlugins*/, true /*returnInstallErrors*/)
 	return err
 }
 
 func installPlugins(
 	proj *workspace.Project, pwd, main string, target *deploy.Target,
-	plugctx *plugin.Context, returnInstallErrors bool) (pluginSet, map[tokens.Package]*semver.Version, error) {
+	plugctx *plugin.Context, lockedPlugins, returnInstallErrors bool,
+) (pluginSet, map[tokens.Package]*semver.Version, error) {
 
 	// Before launching the source, ensure that we have all of the plugins that we need in order to proceed.
 	//
@@ -224,6 +232,22 @@ func installPlugins(
 		return nil, nil, err
 	}
 
+	// If the project has a plugin lock file, use the plugins it records.
+	lock, err := loadPluginLock(plugctx.Root)
+	if err != nil {
+		return nil, nil, err
+	}
+	if lock != nil {
+		languagePlugins, snapshotPlugins, err = applyPluginLock(plugctx.Diag, lock, lockedPlugins,
+			languagePlugins, snapshotPlugins)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else if lockedPlugins {
+		return nil, nil, errors.Errorf("the project does not have a %s file; run `pulumi plugin lock` to create one",
+			workspace.PluginLockFile)
+	}
+
 	allPlugins := languagePlugins.Union(snapshotPlugins)
 
 	// If there are any plugins that are not available, we can attempt to install them here.
@@ -231,13 +255,26 @@ func installPlugins(
 	// Note that this is purely a best-effort thing. If we can't install missing plugins, just proceed; we'll fail later
 	// with an error message indicating exactly what plugins are missing. If `returnInstallErrors` is set, then return
 	// the error.
-	if err := ensurePluginsAreInstalled(allPlugins); err != nil {
+	if err := ensurePluginsAreInstalled(allPlugins, plugctx.ProjectPlugins); err != nil {
 		if returnInstallErrors {
 			return nil, nil, err
 		}
 		logging.V(7).Infof("newUpdateSource(): failed to install missing plugins: %v", err)
 	}
 
+	// Plugins that are pinned by the plugin lock must have been installed from the locked tarballs.
+	if err := verifyPluginChecksums(allPlugins, plugctx.ProjectPlugins); err != nil {
+		return nil, nil, err
+	}
+
+	// Record the plugins this project uses so that they are kept when the plugin cache is pruned.
+	if plugctx.Root != "" {
+		err := workspace.RecordPluginReferences(plugctx.Root, allPlugins.Values(), plugctx.ProjectPlugins)
+		if err != nil {
+			logging.V(7).Infof("installPlugins(): failed to record plugin references: %v", err)
+		}
+	}
+
 	// Collect the version information for default providers.
 	defaultProviderVersions := computeDefaultProviderPlugins(languagePlugins, allPlugins)
 
@@ -362,7 +399,7 @@ func newUpdateSource(
 	//
 
 	allPlugins, defaultProviderVersions, err := installPlugins(proj, pwd, main, target,
-		plugctx, false /*returnInstallErrors*/)
+		plugctx, opts.LockedPlugins, false /*returnInstallErrors*/)
 	if err != nil {
 		return nil, err
 	}
@@ -426,6 +463,18 @@ func update(ctx *Context, info *deploymentContext, opts deploymentOptions,
 			packName := fmt.Sprintf("%s (%s)", pack.Name, path)
 			policies[packName] = "(local)"
 		}
+
+		if err := workspace.ValidatePolicyExemptions(opts.PolicyExemptions, time.Now()); err != nil {
+			return nil, result.FromError(err)
+		}
+	}
+
+	// Before an update that runs policies, preview it so that the policies can analyze its plan before any of its
+	// steps are applied.
+	if !preview && !opts.isRefresh && !opts.isImport && hasPolicies(opts) {
+		if res := analyzePlan(ctx, info, opts); res != nil {
+			return nil, res
+		}
 	}
 
 	// Create an appropriate set of event listeners.
@@ -442,7 +491,32 @@ func update(ctx *Context, info *deploymentContext, opts deploymentOptions,
 	}
 	defer contract.IgnoreClose(deployment)
 
-	return deployment.run(ctx, actions, policies, preview)
+	changes, res := deployment.run(ctx, actions, policies, preview)
+
+	// Now that the deployment has finished, prune the plugin cache, keeping every plugin the deployment loaded.
+	prunePluginCache(opts.Diag, deployment.Plugctx.Host.ListPlugins())
+
+	return changes, res
+}
+
+// hasPolicies returns true if a deployment with the given options runs any policies, either from policy packs or from
+// analyzers supplied by the options' plugin host.
+func hasPolicies(opts deploymentOptions) bool {
+	return len(opts.RequiredPolicies) != 0 || len(opts.LocalPolicyPacks) != 0 ||
+		opts.Host != nil && len(opts.Host.ListAnalyzers()) != 0
+}
+
+// analyzePlan previews an update so that its policies can analyze the steps it plans before any of them are applied.
+// Only the policy violations reported by AnalyzePlan are shown; the update reports everything else. A mandatory
+// violation fails the update before it begins.
+func analyzePlan(ctx *Context, info *deploymentContext, opts deploymentOptions) result.Result {
+	deployment, err := newDeployment(ctx, info, opts, true /*dryRun*/)
+	if err != nil {
+		return result.FromError(err)
+	}
+	defer contract.IgnoreClose(deployment)
+
+	return deployment.execute(ctx, &planActions{Opts: opts}, true /*preview*/, true /*analyzePlanOnly*/)
 }
 
 // abbreviateFilePath is a helper function that cleans up and shortens a provided file path.
@@ -618,6 +692,16 @@ func (acts *updateActions) OnPolicyViolation(urn resource.URN, d plugin.AnalyzeD
 	acts.Opts.Events.policyViolationEvent(urn, d)
 }
 
+func (acts *updateActions) OnPolicyRemediation(urn resource.URN, t plugin.Remediation,
+	before resource.PropertyMap, after resource.PropertyMap) {
+	acts.Opts.Events.policyRemediationEvent(urn, t, before, after, acts.Opts.Debug)
+}
+
+func (acts *updateActions) OnPolicyExemption(urn resource.URN, d plugin.AnalyzeDiagnostic,
+	exemption workspace.PolicyExemption) {
+	acts.Opts.Events.policyExemptionEvent(urn, d, exemption)
+}
+
 func (acts *updateActions) MaybeCorrupt() bool {
 	return acts.maybeCorrupt
 }
@@ -735,6 +819,51 @@ func (acts *previewActions) OnPolicyViolation(urn resource.URN, d plugin.Analyze
 	acts.Opts.Events.policyViolationEvent(urn, d)
 }
 
+func (acts *previewActions) OnPolicyRemediation(urn resource.URN, t plugin.Remediation,
+	before resource.PropertyMap, after resource.PropertyMap) {
+	acts.Opts.Events.policyRemediationEvent(urn, t, before, after, acts.Opts.Debug)
+}
+
+func (acts *previewActions) OnPolicyExemption(urn resource.URN, d plugin.AnalyzeDiagnostic,
+	exemption workspace.PolicyExemption) {
+	acts.Opts.Events.policyExemptionEvent(urn, d, exemption)
+}
+
+// planActions are the event listeners of the preview that analyzes an update's plan. Its steps are not reported, as
+// the update reports its own.
+type planActions struct {
+	Opts deploymentOptions
+}
+
+func (acts *planActions) OnResourceStepPre(step deploy.Step) (interface{}, error) {
+	return nil, nil
+}
+
+func (acts *planActions) OnResourceStepPost(ctx interface{},
+	step deploy.Step, status resource.Status, err error) error {
+	if err != nil {
+		acts.Opts.Diag.Errorf(diag.GetPreviewFailedError(step.URN()), err)
+	}
+	return nil
+}
+
+func (acts *planActions) OnResourceOutputs(step deploy.Step) error {
+	return nil
+}
+
+func (acts *planActions) OnPolicyViolation(urn resource.URN, d plugin.AnalyzeDiagnostic) {
+	acts.Opts.Events.policyViolationEvent(urn, d)
+}
+
+func (acts *planActions) OnPolicyRemediation(urn resource.URN, t plugin.Remediation,
+	before resource.PropertyMap, after resource.PropertyMap) {
+}
+
+func (acts *planActions) OnPolicyExemption(urn resource.URN, d plugin.AnalyzeDiagnostic,
+	exemption workspace.PolicyExemption) {
+	acts.Opts.Events.policyExemptionEvent(urn, d, exemption)
+}
+
 func (acts *previewActions) MaybeCorrupt() bool {
 	return false
 }
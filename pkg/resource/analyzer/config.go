@@ -18,24 +18,200 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/texttheater/golang-levenshtein/levenshtein"
 	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
 )
 
-// LoadPolicyPackConfigFromFile loads the JSON config from a file.
+// LoadPolicyPackConfigFromFile loads the JSON or YAML config from a file. Files with a .yaml or .yml extension are
+// parsed as YAML; all other files are parsed as JSON.
 func LoadPolicyPackConfigFromFile(file string) (map[string]plugin.AnalyzerPolicyConfig, error) {
 	b, err := ioutil.ReadFile(file)
 	if err != nil {
 		return nil, err
 	}
+	if isYAMLConfigFile(file) {
+		if b, err = policyPackConfigYAMLToJSON(b); err != nil {
+			return nil, errors.Wrap(err, file)
+		}
+	}
 	return parsePolicyPackConfig(b)
 }
 
+// LoadPolicyPackConfigDocument loads the JSON or YAML config from a file without interpreting it, so that it can be
+// edited and saved with SavePolicyPackConfigDocument.
+func LoadPolicyPackConfigDocument(file string) (map[string]interface{}, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if isYAMLConfigFile(file) {
+		if b, err = policyPackConfigYAMLToJSON(b); err != nil {
+			return nil, errors.Wrap(err, file)
+		}
+	}
+
+	document := make(map[string]interface{})
+	if strings.TrimSpace(string(b)) == "" {
+		return document, nil
+	}
+	if err = json.Unmarshal(b, &document); err != nil {
+		return nil, errors.Wrap(err, file)
+	}
+	return document, nil
+}
+
+// SavePolicyPackConfigDocument saves config to a file, as YAML if the file has a .yaml or .yml extension and as JSON
+// otherwise.
+func SavePolicyPackConfigDocument(file string, document map[string]interface{}) error {
+	var b []byte
+	var err error
+	if isYAMLConfigFile(file) {
+		b, err = yaml.Marshal(document)
+	} else {
+		b, err = json.MarshalIndent(document, "", "    ")
+		b = append(b, '\n')
+	}
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, b, 0600)
+}
+
+// NewPolicyPackConfigDocument returns config for the given policies that sets each policy's enforcement level and
+// the default value of each of its properties, along with any initial config supplied by the policy pack.
+func NewPolicyPackConfigDocument(policies []plugin.AnalyzerPolicyInfo,
+	initialConfig map[string]plugin.AnalyzerPolicyConfig) map[string]interface{} {
+
+	config := createConfigWithDefaults(policies)
+	if initialConfig != nil {
+		config = applyConfig(config, initialConfig)
+	}
+
+	document := make(map[string]interface{}, len(config))
+	for name, c := range config {
+		if len(c.Properties) == 0 && c.EnforcementLevel != "" {
+			document[name] = string(c.EnforcementLevel)
+			continue
+		}
+		props := make(map[string]interface{}, len(c.Properties)+1)
+		for k, v := range c.Properties {
+			props[k] = v
+		}
+		if c.EnforcementLevel != "" {
+			props["enforcementLevel"] = string(c.EnforcementLevel)
+		}
+		document[name] = props
+	}
+	return document
+}
+
+// CheckPolicyPackConfig validates the config for the given policies, as loaded from a file. In addition to the
+// validation performed by ReconcilePolicyPackConfig, it reports config for policies that do not exist and properties
+// that a policy does not declare, which are otherwise silently ignored.
+func CheckPolicyPackConfig(policies []plugin.AnalyzerPolicyInfo,
+	config map[string]plugin.AnalyzerPolicyConfig) ([]string, error) {
+
+	known := make(map[string]plugin.AnalyzerPolicyInfo, len(policies))
+	names := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		known[policy.Name] = policy
+		names = append(names, policy.Name)
+	}
+
+	configured := make([]string, 0, len(config))
+	for name := range config {
+		configured = append(configured, name)
+	}
+	sort.Strings(configured)
+
+	var errs []string
+	for _, name := range configured {
+		props := config[name].Properties
+		if name == "all" {
+			if len(props) > 0 {
+				errs = append(errs, "all: only the enforcement level can be configured for all policies")
+			}
+			continue
+		}
+
+		policy, ok := known[name]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("unknown policy %q%s", name, suggest(name, names)))
+			continue
+		}
+
+		var declared []string
+		if policy.ConfigSchema != nil {
+			for k := range policy.ConfigSchema.Properties {
+				declared = append(declared, k)
+			}
+			sort.Strings(declared)
+		}
+		var undeclared []string
+		for k := range props {
+			if policy.ConfigSchema == nil || policy.ConfigSchema.Properties[k] == nil {
+				undeclared = append(undeclared, k)
+			}
+		}
+		sort.Strings(undeclared)
+		for _, k := range undeclared {
+			errs = append(errs, fmt.Sprintf("%s: unknown property %q%s", name, k, suggest(k, declared)))
+		}
+	}
+
+	_, validationErrors, err := ReconcilePolicyPackConfig(policies, nil, config)
+	if err != nil {
+		return nil, err
+	}
+	return append(errs, validationErrors...), nil
+}
+
+// suggest returns a suffix for an error message that suggests the candidate closest to the given name, if any
+// candidate is close enough to be a likely typo.
+func suggest(name string, candidates []string) string {
+	const maxDistance = 2
+
+	best, bestDistance := "", maxDistance+1
+	for _, candidate := range candidates {
+		distance := levenshtein.DistanceForStrings([]rune(name), []rune(candidate), levenshtein.DefaultOptions)
+		if distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf("; did you mean %q?", best)
+}
+
+// isYAMLConfigFile returns true if the given config file should be parsed as YAML rather than JSON.
+func isYAMLConfigFile(file string) bool {
+	ext := strings.ToLower(filepath.Ext(file))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// policyPackConfigYAMLToJSON converts YAML config into the equivalent JSON.
+func policyPackConfigYAMLToJSON(b []byte) ([]byte, error) {
+	var document interface{}
+	if err := yaml.Unmarshal(b, &document); err != nil {
+		return nil, err
+	}
+	if document == nil {
+		return nil, nil
+	}
+	return json.Marshal(encoding.YAMLToJSON(document))
+}
+
 // ParsePolicyPackConfigFromAPI parses the config returned from the service.
 func ParsePolicyPackConfigFromAPI(config map[string]*json.RawMessage) (map[string]plugin.AnalyzerPolicyConfig, error) {
 	result := map[string]plugin.AnalyzerPolicyConfig{}
@@ -74,6 +250,11 @@ func ParsePolicyPackConfigFromAPI(config map[string]*json.RawMessage) (map[strin
 	return result, nil
 }
 
+// ParsePolicyPackConfig parses JSON policy pack config, in the format of the files passed to `--policy-pack-config`.
+func ParsePolicyPackConfig(b []byte) (map[string]plugin.AnalyzerPolicyConfig, error) {
+	return parsePolicyPackConfig(b)
+}
+
 func parsePolicyPackConfig(b []byte) (map[string]plugin.AnalyzerPolicyConfig, error) {
 	result := make(map[string]plugin.AnalyzerPolicyConfig)
 
@@ -224,6 +405,28 @@ func ValidatePolicyPackConfig(schemaMap map[string]apitype.PolicyConfigSchema,
 	return err
 }
 
+// ConvertPolicyConfigSchema converts a policy's config schema as reported by its analyzer into the form used when
+// publishing the Policy Pack.
+func ConvertPolicyConfigSchema(schema *plugin.AnalyzerPolicyConfigSchema) (*apitype.PolicyConfigSchema, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	properties := map[string]*json.RawMessage{}
+	for k, v := range schema.Properties {
+		bytes, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		raw := json.RawMessage(bytes)
+		properties[k] = &raw
+	}
+	return &apitype.PolicyConfigSchema{
+		Type:       apitype.Object,
+		Properties: properties,
+		Required:   schema.Required,
+	}, nil
+}
+
 func convertSchema(schema plugin.AnalyzerPolicyConfigSchema) plugin.JSONSchema {
 	result := plugin.JSONSchema{}
 	result["type"] = "object"
@@ -16,6 +16,7 @@ package analyzer
 import (
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
@@ -1190,3 +1191,90 @@ func TestReconcilePolicyPackConfigValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckPolicyPackConfig(t *testing.T) {
+	policies := []plugin.AnalyzerPolicyInfo{
+		{
+			Name:             "s3-encryption",
+			EnforcementLevel: "mandatory",
+			ConfigSchema: &plugin.AnalyzerPolicyConfigSchema{
+				Properties: map[string]plugin.JSONSchema{
+					"algorithm": {"type": "string", "enum": []string{"AES256", "aws:kms"}},
+				},
+				Required: []string{"algorithm"},
+			},
+		},
+		{
+			Name:             "no-public-buckets",
+			EnforcementLevel: "advisory",
+		},
+	}
+
+	errs, err := CheckPolicyPackConfig(policies, map[string]plugin.AnalyzerPolicyConfig{
+		"all":              {EnforcementLevel: "advisory"},
+		"s3-encryption":    {Properties: map[string]interface{}{"algorithm": "AES256"}},
+		"no-public-bucket": {EnforcementLevel: "disabled"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`unknown policy "no-public-bucket"; did you mean "no-public-buckets"?`}, errs)
+
+	errs, err = CheckPolicyPackConfig(policies, map[string]plugin.AnalyzerPolicyConfig{
+		"all":           {Properties: map[string]interface{}{"algorithm": "AES256"}},
+		"s3-encryption": {Properties: map[string]interface{}{"algoritm": "AES256"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"all: only the enforcement level can be configured for all policies",
+		`s3-encryption: unknown property "algoritm"; did you mean "algorithm"?`,
+		"s3-encryption: algorithm is required",
+	}, errs)
+}
+
+func TestPolicyPackConfigDocument(t *testing.T) {
+	policies := []plugin.AnalyzerPolicyInfo{
+		{
+			Name:             "s3-encryption",
+			EnforcementLevel: "mandatory",
+			ConfigSchema: &plugin.AnalyzerPolicyConfigSchema{
+				Properties: map[string]plugin.JSONSchema{
+					"algorithm": {"type": "string", "default": "AES256"},
+					"kmsKeyId":  {"type": "string"},
+				},
+			},
+		},
+		{
+			Name:             "no-public-buckets",
+			EnforcementLevel: "advisory",
+		},
+	}
+	initialConfig := map[string]plugin.AnalyzerPolicyConfig{
+		"no-public-buckets": {EnforcementLevel: "disabled"},
+	}
+
+	document := NewPolicyPackConfigDocument(policies, initialConfig)
+	assert.Equal(t, map[string]interface{}{
+		"s3-encryption":     map[string]interface{}{"enforcementLevel": "mandatory", "algorithm": "AES256"},
+		"no-public-buckets": "disabled",
+	}, document)
+
+	// The document round-trips through both JSON and YAML files.
+	dir := t.TempDir()
+	for _, name := range []string{"config.json", "config.yaml"} {
+		file := filepath.Join(dir, name)
+		assert.NoError(t, SavePolicyPackConfigDocument(file, document))
+
+		loaded, err := LoadPolicyPackConfigDocument(file)
+		assert.NoError(t, err)
+		assert.Equal(t, document, loaded)
+
+		config, err := LoadPolicyPackConfigFromFile(file)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]plugin.AnalyzerPolicyConfig{
+			"s3-encryption": {
+				EnforcementLevel: "mandatory",
+				Properties:       map[string]interface{}{"algorithm": "AES256"},
+			},
+			"no-public-buckets": {EnforcementLevel: "disabled"},
+		}, config)
+	}
+}
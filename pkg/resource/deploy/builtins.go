@@ -166,6 +166,15 @@ func (p *builtinProvider) Construct(info plugin.ConstructInfo, typ tokens.Type,
 	return plugin.ConstructResult{}, errors.New("builtin resources may not be constructed")
 }
 
+func (p *builtinProvider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
+	options plugin.CallOptions) (plugin.CallResult, error) {
+	return plugin.CallResult{}, errors.New("the builtin provider does not implement call")
+}
+
+func (p *builtinProvider) ListResources(types []tokens.Type, tags map[string]string) ([]plugin.ListedResource, error) {
+	return nil, plugin.ErrListResourcesNotSupported
+}
+
 const readStackOutputs = "pulumi:pulumi:readStackOutputs"
 const readStackResourceOutputs = "pulumi:pulumi:readStackResourceOutputs"
 const getResource = "pulumi:pulumi:getResource"
@@ -32,6 +32,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
 // BackendClient is used to retrieve information about stacks from a backend.
@@ -61,6 +62,13 @@ type Options struct {
 	TrustDependencies         bool           // whether or not to trust the resource dependency graph.
 	UseLegacyDiff             bool           // whether or not to use legacy diffing behavior.
 	DisableResourceReferences bool           // true to disable resource reference support.
+
+	// PolicyExemptions waive the policy violations of specific resources.
+	PolicyExemptions []workspace.PolicyExemption
+	// AnalyzePlanOnly is true if the deployment is a preview that is run before an update only so that the steps it
+	// plans can be analyzed. Analyzers' resource and stack policies are not run, and only the diagnostics reported by
+	// AnalyzePlan are sent to the events callback.
+	AnalyzePlanOnly bool
 }
 
 // DegreeOfParallelism returns the degree of parallelism that should be used during the
@@ -87,6 +95,8 @@ type StepExecutorEvents interface {
 // PolicyEvents is an interface that can be used to hook policy events.
 type PolicyEvents interface {
 	OnPolicyViolation(resource.URN, plugin.AnalyzeDiagnostic)
+	OnPolicyRemediation(urn resource.URN, remediation plugin.Remediation, before, after resource.PropertyMap)
+	OnPolicyExemption(urn resource.URN, d plugin.AnalyzeDiagnostic, exemption workspace.PolicyExemption)
 }
 
 // Events is an interface that can be used to hook interesting engine events.
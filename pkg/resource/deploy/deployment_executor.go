@@ -288,7 +288,8 @@ func (ex *deploymentExecutor) Execute(callerCtx context.Context, opts Options, p
 	if res != nil || ex.stepExec.Errored() || ex.stepGen.Errored() {
 		// TODO(cyrusn): We seem to be losing any information about the original 'res's errors.  Should
 		// we be doing a merge here?
-		ex.reportExecResult("failed", preview)
+		// A preview that only analyzes an update's plan fails the update it precedes.
+		ex.reportExecResult("failed", preview && !opts.AnalyzePlanOnly)
 		return result.Bail()
 	} else if canceled {
 		ex.reportExecResult("canceled", preview)
@@ -423,7 +424,8 @@ func (ex *deploymentExecutor) retirePendingDeletes(callerCtx context.Context, op
 	// cancelled.
 	canceled := callerCtx.Err() != nil
 	if stepExec.Errored() {
-		ex.reportExecResult("failed", preview)
+		// A preview that only analyzes an update's plan fails the update it precedes.
+		ex.reportExecResult("failed", preview && !opts.AnalyzePlanOnly)
 		return result.Bail()
 	} else if canceled {
 		ex.reportExecResult("canceled", preview)
@@ -509,7 +511,8 @@ func (ex *deploymentExecutor) refresh(callerCtx context.Context, opts Options, p
 	canceled := callerCtx.Err() != nil
 
 	if stepExec.Errored() {
-		ex.reportExecResult("failed", preview)
+		// A preview that only analyzes an update's plan fails the update it precedes.
+		ex.reportExecResult("failed", preview && !opts.AnalyzePlanOnly)
 		return result.Bail()
 	} else if canceled {
 		ex.reportExecResult("canceled", preview)
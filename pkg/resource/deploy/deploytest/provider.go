@@ -62,6 +62,11 @@ type Provider struct {
 	InvokeF func(tok tokens.ModuleMember,
 		inputs resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error)
 
+	CallF func(monitor *ResourceMonitor, tok tokens.ModuleMember, args resource.PropertyMap,
+		info plugin.CallInfo, options plugin.CallOptions) (plugin.CallResult, error)
+
+	ListResourcesF func(types []tokens.Type, tags map[string]string) ([]plugin.ListedResource, error)
+
 	CancelF func() error
 }
 
@@ -192,6 +197,26 @@ func (prov *Provider) Invoke(tok tokens.ModuleMember,
 	return prov.InvokeF(tok, args)
 }
 
+func (prov *Provider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
+	options plugin.CallOptions) (plugin.CallResult, error) {
+	if prov.CallF == nil {
+		return plugin.CallResult{}, nil
+	}
+	monitor, err := dialMonitor(context.Background(), info.MonitorAddress)
+	if err != nil {
+		return plugin.CallResult{}, err
+	}
+	return prov.CallF(monitor, tok, args, info, options)
+}
+
+func (prov *Provider) ListResources(types []tokens.Type,
+	tags map[string]string) ([]plugin.ListedResource, error) {
+	if prov.ListResourcesF == nil {
+		return nil, plugin.ErrListResourcesNotSupported
+	}
+	return prov.ListResourcesF(types, tags)
+}
+
 func (prov *Provider) StreamInvoke(
 	tok tokens.ModuleMember, args resource.PropertyMap,
 	onNext func(resource.PropertyMap) error) ([]plugin.CheckFailure, error) {
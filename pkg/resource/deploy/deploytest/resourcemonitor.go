@@ -100,6 +100,7 @@ type ResourceOptions struct {
 	CustomTimeouts        *resource.CustomTimeouts
 	SupportsPartialValues *bool
 	Remote                bool
+	SourcePosition        *resource.SourcePosition
 
 	DisableSecrets            bool
 	DisableResourceReferences bool
@@ -186,6 +187,13 @@ func (rm *ResourceMonitor) RegisterResource(t tokens.Type, name string, custom b
 		SupportsPartialValues:      supportsPartialValues,
 		Remote:                     opts.Remote,
 	}
+	if pos := opts.SourcePosition; pos != nil {
+		requestInput.SourcePosition = &pulumirpc.SourcePosition{
+			Uri:    pos.URI,
+			Line:   int32(pos.Line),
+			Column: int32(pos.Column),
+		}
+	}
 
 	// submit request
 	resp, err := rm.resmon.RegisterResource(context.Background(), requestInput)
@@ -304,6 +312,70 @@ func (rm *ResourceMonitor) Invoke(tok tokens.ModuleMember, inputs resource.Prope
 	return outs, nil, nil
 }
 
+func (rm *ResourceMonitor) Call(tok tokens.ModuleMember, args resource.PropertyMap,
+	argDependencies map[resource.PropertyKey][]resource.URN, provider string,
+	version string) (resource.PropertyMap, map[resource.PropertyKey][]resource.URN, []*pulumirpc.CheckFailure, error) {
+
+	// marshal args
+	margs, err := plugin.MarshalProperties(args, plugin.MarshalOptions{
+		KeepUnknowns:  true,
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// marshal arg dependencies
+	argDeps := map[string]*pulumirpc.CallRequest_ArgumentDependencies{}
+	for name, dependencies := range argDependencies {
+		urns := make([]string, len(dependencies))
+		for i, urn := range dependencies {
+			urns[i] = string(urn)
+		}
+		argDeps[string(name)] = &pulumirpc.CallRequest_ArgumentDependencies{Urns: urns}
+	}
+
+	// submit request
+	resp, err := rm.resmon.Call(context.Background(), &pulumirpc.CallRequest{
+		Tok:             string(tok),
+		Args:            margs,
+		ArgDependencies: argDeps,
+		Provider:        provider,
+		Version:         version,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// handle failures
+	if len(resp.Failures) != 0 {
+		return nil, nil, resp.Failures, nil
+	}
+
+	// unmarshal outputs
+	outs, err := plugin.UnmarshalProperties(resp.Return, plugin.MarshalOptions{
+		KeepUnknowns:  true,
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// unmarshal return deps
+	deps := map[resource.PropertyKey][]resource.URN{}
+	for k, returnDeps := range resp.ReturnDependencies {
+		var urns []resource.URN
+		for _, urn := range returnDeps.Urns {
+			urns = append(urns, resource.URN(urn))
+		}
+		deps[resource.PropertyKey(k)] = urns
+	}
+
+	return outs, deps, nil, nil
+}
+
 func prepareTestTimeout(timeout float64) string {
 	mins := int(timeout) / 60
 
@@ -38,6 +38,10 @@ type Import struct {
 	Provider resource.URN    // The specific provider to use for the resource, if any.
 	Version  *semver.Version // The provider version to use for the resource, if any.
 	Protect  bool            // Whether to mark the resource as protected after import
+
+	// The resources that the resource depends on, if any. Dependencies and parents may refer to other resources in the
+	// same set of imports, in which case those resources are imported first.
+	Dependencies []resource.URN
 }
 
 // ImportOptions controls the import process.
@@ -97,6 +101,7 @@ func NewImportDeployment(ctx *plugin.Context, target *Target, projectName tokens
 		source:       NewErrorSource(projectName),
 		preview:      preview,
 		providers:    reg,
+		news:         &resourceMap{},
 	}, nil
 }
 
@@ -292,10 +297,10 @@ func (i *importer) importResources(ctx context.Context) result.Result {
 		return res
 	}
 
-	// Create a step per resource to import and execute them in parallel. If there are duplicates, fail the import.
-	urns := map[resource.URN]struct{}{}
-	steps := make([]Step, 0, len(i.deployment.imports))
-	for _, imp := range i.deployment.imports {
+	// Create a step per resource to import. If there are duplicates, fail the import.
+	urns := map[resource.URN]int{}
+	steps := make([]Step, len(i.deployment.imports))
+	for idx, imp := range i.deployment.imports {
 		parent := imp.Parent
 		if parent == "" {
 			parent = stackURN
@@ -306,7 +311,7 @@ func (i *importer) importResources(ctx context.Context) result.Result {
 		if _, has := urns[urn]; has {
 			return result.Errorf("duplicate import '%v' of type '%v'", imp.Name, imp.Type)
 		}
-		urns[urn] = struct{}{}
+		urns[urn] = idx
 
 		// If the resource already exists and the ID matches the ID to import, skip this resource. If the ID does
 		// not match, the step itself will issue an error.
@@ -333,12 +338,24 @@ func (i *importer) importResources(ctx context.Context) result.Result {
 
 		// Create the new desired state. Note that the resource is protected.
 		new := resource.NewState(urn.Type(), urn, true, false, imp.ID, resource.PropertyMap{}, nil, parent, imp.Protect,
-			false, nil, nil, provider, nil, false, nil, nil, nil, "")
-		steps = append(steps, newImportDeploymentStep(i.deployment, new))
+			false, imp.Dependencies, nil, provider, nil, false, nil, nil, nil, "")
+		steps[idx] = newImportDeploymentStep(i.deployment, new)
 	}
 
-	if !i.executeParallel(ctx, steps...) {
-		return nil
+	// Resources that are parented to or depend upon other resources in the set of imports must be imported after
+	// those resources. Execute the steps in waves, each of which contains the steps whose parents and dependencies
+	// have been imported by an earlier wave.
+	waves, res := importWaves(steps, urns)
+	if res != nil {
+		return res
+	}
+	for _, wave := range waves {
+		if !i.executeParallel(ctx, wave...) {
+			return nil
+		}
+		for _, step := range wave {
+			i.deployment.news.set(step.URN(), step.New())
+		}
 	}
 
 	if createdStack {
@@ -347,3 +364,64 @@ func (i *importer) importResources(ctx context.Context) result.Result {
 
 	return nil
 }
+
+// importWaves partitions the given import steps into waves such that the parent and dependencies of each step's
+// resource are imported by an earlier wave if they are part of the same set of imports. The urns map records the index
+// of the step for each resource; steps that are nil are skipped.
+func importWaves(steps []Step, urns map[resource.URN]int) ([][]Step, result.Result) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state, levels := make([]int, len(steps)), make([]int, len(steps))
+	var visit func(idx int) result.Result
+	visit = func(idx int) result.Result {
+		switch state[idx] {
+		case visiting:
+			return result.Errorf("the resource '%v' depends upon itself", steps[idx].URN())
+		case visited:
+			return nil
+		}
+		state[idx] = visiting
+
+		level := 0
+		new := steps[idx].New()
+		for _, urn := range append([]resource.URN{new.Parent}, new.Dependencies...) {
+			dep, ok := urns[urn]
+			if !ok || steps[dep] == nil {
+				continue
+			}
+			if res := visit(dep); res != nil {
+				return res
+			}
+			if levels[dep]+1 > level {
+				level = levels[dep] + 1
+			}
+		}
+
+		levels[idx], state[idx] = level, visited
+		return nil
+	}
+
+	var waves [][]Step
+	for idx, step := range steps {
+		if step == nil {
+			continue
+		}
+		if res := visit(idx); res != nil {
+			return nil, res
+		}
+	}
+	for idx, step := range steps {
+		if step == nil {
+			continue
+		}
+		for len(waves) <= levels[idx] {
+			waves = append(waves, nil)
+		}
+		waves[levels[idx]] = append(waves[levels[idx]], step)
+	}
+	return waves, nil
+}
@@ -0,0 +1,316 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/pulumi/pulumi/pkg/v3/resource/analyzer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+)
+
+// Fixture is a policy test case: a set of synthetic resources, along with the policy violations that analyzing them
+// is expected to report. Fixtures are stored in JSON or YAML files.
+type Fixture struct {
+	// Name is the name of the test case. Defaults to the name of the fixture file.
+	Name string `json:"name,omitempty"`
+	// Path is the path of the file from which the fixture was loaded.
+	Path string `json:"-"`
+	// Config is the optional configuration of the policy pack, in the format accepted by `--policy-pack-config`.
+	Config map[string]interface{} `json:"config,omitempty"`
+	// Resources are the resources to analyze.
+	Resources []FixtureResource `json:"resources"`
+	// Expect lists the policy violations that analyzing the resources is expected to report. Any other violations
+	// cause the test case to fail.
+	Expect []ExpectedViolation `json:"expect,omitempty"`
+}
+
+// FixtureResource describes a synthetic resource in a fixture. Parents, providers, and dependencies refer to other
+// resources in the fixture by name.
+type FixtureResource struct {
+	Type                 string                 `json:"type"`
+	Name                 string                 `json:"name"`
+	Properties           map[string]interface{} `json:"properties,omitempty"`
+	Parent               string                 `json:"parent,omitempty"`
+	Provider             string                 `json:"provider,omitempty"`
+	DependsOn            []string               `json:"dependsOn,omitempty"`
+	PropertyDependencies map[string][]string    `json:"propertyDependencies,omitempty"`
+	Protect              bool                   `json:"protect,omitempty"`
+}
+
+// ExpectedViolation describes a policy violation that a fixture expects to be reported.
+type ExpectedViolation struct {
+	// Policy is the name of the violated policy, optionally qualified by the name of its policy pack as in
+	// `pack/policy`.
+	Policy string `json:"policy"`
+	// Resource is the name of the resource that violates the policy. If empty, the violation may be reported for any
+	// resource or for the stack as a whole.
+	Resource string `json:"resource,omitempty"`
+	// EnforcementLevel is the expected enforcement level of the violation, if any.
+	EnforcementLevel string `json:"enforcementLevel,omitempty"`
+	// Message is text that the message of the violation is expected to contain, if any.
+	Message string `json:"message,omitempty"`
+}
+
+func (e ExpectedViolation) String() string {
+	if e.Resource == "" {
+		return fmt.Sprintf("violation of %s", e.Policy)
+	}
+	return fmt.Sprintf("violation of %s by %s", e.Policy, e.Resource)
+}
+
+// Matches returns true if the given diagnostic satisfies the expectation.
+func (e ExpectedViolation) Matches(d plugin.AnalyzeDiagnostic) bool {
+	policy := e.Policy
+	if i := strings.LastIndex(policy, "/"); i != -1 {
+		if policy[:i] != d.PolicyPackName {
+			return false
+		}
+		policy = policy[i+1:]
+	}
+	switch {
+	case policy != d.PolicyName:
+		return false
+	case e.Resource != "" && (d.URN == "" || string(d.URN.Name()) != e.Resource):
+		return false
+	case e.EnforcementLevel != "" && e.EnforcementLevel != string(d.EnforcementLevel):
+		return false
+	case e.Message != "" && !strings.Contains(d.Message, e.Message):
+		return false
+	}
+	return true
+}
+
+// LoadFixture loads a fixture from the given JSON or YAML file.
+func LoadFixture(path string) (*Fixture, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+	case ".yaml", ".yml":
+		var document interface{}
+		if err := yaml.Unmarshal(b, &document); err != nil {
+			return nil, errors.Wrapf(err, "parsing %s", path)
+		}
+		if b, err = json.Marshal(encoding.YAMLToJSON(document)); err != nil {
+			return nil, errors.Wrapf(err, "parsing %s", path)
+		}
+	default:
+		return nil, errors.Errorf("%s: unsupported fixture format; expected a .json, .yaml, or .yml file", path)
+	}
+
+	var f Fixture
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, errors.Wrapf(err, "parsing %s", path)
+	}
+	f.Path = path
+	if f.Name == "" {
+		f.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return &f, nil
+}
+
+// LoadFixtures loads the fixtures at the given paths. Directories are searched recursively for fixture files, which
+// are loaded in lexical order.
+func LoadFixtures(paths ...string) ([]*Fixture, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		var found []string
+		err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			switch strings.ToLower(filepath.Ext(p)) {
+			case ".json", ".yaml", ".yml":
+				if !info.IsDir() {
+					found = append(found, p)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(found)
+		files = append(files, found...)
+	}
+
+	fixtures := make([]*Fixture, len(files))
+	for i, file := range files {
+		f, err := LoadFixture(file)
+		if err != nil {
+			return nil, err
+		}
+		fixtures[i] = f
+	}
+	return fixtures, nil
+}
+
+// NewResources returns the synthetic resources described by the fixture, in the order in which they appear.
+func (f *Fixture) NewResources() ([]*Resource, error) {
+	resources := make([]*Resource, len(f.Resources))
+	byName := map[string]*Resource{}
+	for i, r := range f.Resources {
+		if r.Type == "" || r.Name == "" {
+			return nil, errors.Errorf("resource %d is missing a type or name", i)
+		}
+		if _, ok := byName[r.Name]; ok {
+			return nil, errors.Errorf("resource name %q is not unique", r.Name)
+		}
+		resources[i] = &Resource{
+			Type:       tokens.Type(r.Type),
+			Name:       r.Name,
+			Properties: resource.NewPropertyMapFromMap(r.Properties),
+			Options:    plugin.AnalyzerResourceOptions{Protect: r.Protect},
+		}
+		byName[r.Name] = resources[i]
+	}
+
+	lookup := func(from, name string) (*Resource, error) {
+		res, ok := byName[name]
+		if !ok {
+			return nil, errors.Errorf("resource %q refers to unknown resource %q", from, name)
+		}
+		return res, nil
+	}
+	lookupAll := func(from string, names []string) ([]*Resource, error) {
+		var result []*Resource
+		for _, name := range names {
+			res, err := lookup(from, name)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, res)
+		}
+		return result, nil
+	}
+
+	var err error
+	for i, r := range f.Resources {
+		res := resources[i]
+		if r.Parent != "" {
+			if res.Parent, err = lookup(r.Name, r.Parent); err != nil {
+				return nil, err
+			}
+		}
+		if r.Provider != "" {
+			if res.Provider, err = lookup(r.Name, r.Provider); err != nil {
+				return nil, err
+			}
+		}
+		if res.Dependencies, err = lookupAll(r.Name, r.DependsOn); err != nil {
+			return nil, err
+		}
+		if len(r.PropertyDependencies) != 0 {
+			res.PropertyDependencies = map[resource.PropertyKey][]*Resource{}
+			for k, names := range r.PropertyDependencies {
+				if res.PropertyDependencies[resource.PropertyKey(k)], err = lookupAll(r.Name, names); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return resources, nil
+}
+
+// FixtureResult is the result of running a fixture.
+type FixtureResult struct {
+	// Diagnostics are the diagnostics reported by the analyzer.
+	Diagnostics []plugin.AnalyzeDiagnostic
+	// Failures describe each way in which the diagnostics differ from the fixture's expectations.
+	Failures []string
+}
+
+// Passed returns true if the diagnostics matched the fixture's expectations.
+func (r *FixtureResult) Passed() bool {
+	return len(r.Failures) == 0
+}
+
+// RunFixture configures the harness's policy pack with the fixture's configuration, if any, analyzes the fixture's
+// resources, and compares the resulting diagnostics to the fixture's expectations.
+func (h *Harness) RunFixture(f *Fixture) (*FixtureResult, error) {
+	if f.Config != nil {
+		b, err := json.Marshal(f.Config)
+		if err != nil {
+			return nil, err
+		}
+		config, err := analyzer.ParsePolicyPackConfig(b)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing policy pack config")
+		}
+		if err := h.Configure(config); err != nil {
+			return nil, errors.Wrap(err, "configuring policy pack")
+		}
+	}
+
+	resources, err := f.NewResources()
+	if err != nil {
+		return nil, err
+	}
+	diags, err := h.AnalyzeAll(resources...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FixtureResult{Diagnostics: diags}
+	matched := make([]bool, len(diags))
+	for _, e := range f.Expect {
+		found := false
+		for i, d := range diags {
+			if !matched[i] && e.Matches(d) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			result.Failures = append(result.Failures, fmt.Sprintf("expected %v was not reported", e))
+		}
+	}
+	for i, d := range diags {
+		if !matched[i] {
+			subject := "the stack"
+			if d.URN != "" {
+				subject = string(d.URN.Name())
+			}
+			result.Failures = append(result.Failures, fmt.Sprintf("unexpected violation of %s/%s by %s: %s",
+				d.PolicyPackName, d.PolicyName, subject, strings.TrimSpace(d.Message)))
+		}
+	}
+	return result, nil
+}
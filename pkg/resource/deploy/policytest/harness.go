@@ -0,0 +1,230 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policytest provides a harness for testing policy packs against synthetic resources without running a
+// Pulumi program or loading any resource providers.
+package policytest
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+)
+
+// Resource describes a synthetic resource to analyze. Parents, providers, and dependencies refer to other synthetic
+// resources, and are sent to the analyzer using the URNs that the harness computes for them.
+type Resource struct {
+	// Type is the type of the resource. Provider resources have types of the form `pulumi:providers:<package>`.
+	Type tokens.Type
+	// Name is the name of the resource.
+	Name string
+	// Properties are the properties of the resource.
+	Properties resource.PropertyMap
+	// Options are the resource options of the resource.
+	Options plugin.AnalyzerResourceOptions
+
+	// Parent is the optional parent of the resource. Resources without a parent are children of the stack.
+	Parent *Resource
+	// Provider is the optional provider of the resource.
+	Provider *Resource
+	// Dependencies are the resources on which the resource depends.
+	Dependencies []*Resource
+	// PropertyDependencies maps each property of the resource to the resources on which it depends.
+	PropertyDependencies map[resource.PropertyKey][]*Resource
+}
+
+const (
+	// DefaultProject is the name of the project used by harnesses that are not given one.
+	DefaultProject = "project"
+	// DefaultStack is the name of the stack used by harnesses that are not given one.
+	DefaultStack = "stack"
+)
+
+// Harness runs an analyzer against synthetic resources.
+type Harness struct {
+	project  tokens.PackageName
+	stack    tokens.QName
+	analyzer plugin.Analyzer
+	plugctx  *plugin.Context
+}
+
+// New creates a harness that runs the given analyzer against resources in the given project and stack.
+func New(analyzer plugin.Analyzer, project tokens.PackageName, stack tokens.QName) *Harness {
+	contract.Require(analyzer != nil, "analyzer")
+	return &Harness{project: project, stack: stack, analyzer: analyzer}
+}
+
+// Load creates a harness that runs the local policy pack at the given path. The policy pack's language runtime
+// must be installed, along with the analyzer plugin that boots policy packs written in that language. The project
+// and stack names default to DefaultProject and DefaultStack.
+func Load(policyPackPath string, opts plugin.PolicyAnalyzerOptions, sink diag.Sink) (*Harness, error) {
+	if opts.Project == "" {
+		opts.Project = DefaultProject
+	}
+	if opts.Stack == "" {
+		opts.Stack = DefaultStack
+	}
+	if sink == nil {
+		sink = diag.DefaultSink(os.Stdout, os.Stderr, diag.FormatOptions{Color: colors.Never})
+	}
+
+	plugctx, err := plugin.NewContext(sink, sink, nil, nil, policyPackPath, nil, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	analyzer, err := plugin.NewPolicyAnalyzer(plugctx.Host, plugctx, "policytest", policyPackPath, &opts)
+	if err != nil {
+		contract.IgnoreClose(plugctx)
+		return nil, err
+	}
+
+	h := New(analyzer, tokens.PackageName(opts.Project), tokens.QName(opts.Stack))
+	h.plugctx = plugctx
+	return h, nil
+}
+
+// Close shuts down the harness's analyzer.
+func (h *Harness) Close() error {
+	err := h.analyzer.Close()
+	if h.plugctx != nil {
+		if cerr := h.plugctx.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// Analyzer returns the harness's analyzer.
+func (h *Harness) Analyzer() plugin.Analyzer {
+	return h.analyzer
+}
+
+// Configure sets the configuration of the harness's policy pack.
+func (h *Harness) Configure(config map[string]plugin.AnalyzerPolicyConfig) error {
+	return h.analyzer.Configure(config)
+}
+
+// URN returns the URN of the given resource. As in a deployment, the URN's type is qualified by the types of all of
+// the resource's ancestors other than the stack.
+func (h *Harness) URN(r *Resource) resource.URN {
+	var parentType tokens.Type
+	if r.Parent != nil && r.Parent.Type != resource.RootStackType {
+		parentType = h.URN(r.Parent).QualifiedType()
+	}
+	return resource.NewURN(h.stack, h.project, parentType, r.Type, tokens.QName(r.Name))
+}
+
+// AnalyzerResource returns the value sent to the analyzer to analyze the given resource.
+func (h *Harness) AnalyzerResource(r *Resource) plugin.AnalyzerResource {
+	result := plugin.AnalyzerResource{
+		URN:        h.URN(r),
+		Type:       r.Type,
+		Name:       tokens.QName(r.Name),
+		Properties: r.Properties,
+		Options:    r.Options,
+	}
+	if r.Provider != nil {
+		result.Provider = &plugin.AnalyzerProviderResource{
+			URN:        h.URN(r.Provider),
+			Type:       r.Provider.Type,
+			Name:       tokens.QName(r.Provider.Name),
+			Properties: r.Provider.Properties,
+		}
+	}
+	return result
+}
+
+// AnalyzerStackResource returns the value sent to the analyzer to represent the given resource when analyzing a
+// stack.
+func (h *Harness) AnalyzerStackResource(r *Resource) plugin.AnalyzerStackResource {
+	result := plugin.AnalyzerStackResource{
+		AnalyzerResource: h.AnalyzerResource(r),
+		Dependencies:     h.urns(r.Dependencies),
+	}
+	if r.Parent != nil {
+		result.Parent = h.URN(r.Parent)
+	}
+	if len(r.PropertyDependencies) != 0 {
+		result.PropertyDependencies = map[resource.PropertyKey][]resource.URN{}
+		for k, deps := range r.PropertyDependencies {
+			result.PropertyDependencies[k] = h.urns(deps)
+		}
+	}
+	return result
+}
+
+func (h *Harness) urns(resources []*Resource) []resource.URN {
+	if len(resources) == 0 {
+		return nil
+	}
+	urns := make([]resource.URN, len(resources))
+	for i, r := range resources {
+		urns[i] = h.URN(r)
+	}
+	return urns
+}
+
+// Analyze runs the analyzer's resource policies against the given resource. Diagnostics that do not name a resource
+// are attributed to the analyzed resource.
+func (h *Harness) Analyze(r *Resource) ([]plugin.AnalyzeDiagnostic, error) {
+	urn := h.URN(r)
+	diags, err := h.analyzer.Analyze(h.AnalyzerResource(r))
+	if err != nil {
+		return nil, errors.Wrapf(err, "analyzing %v", urn)
+	}
+	for i := range diags {
+		if diags[i].URN == "" {
+			diags[i].URN = urn
+		}
+	}
+	return diags, nil
+}
+
+// AnalyzeStack runs the analyzer's stack policies against a stack that contains the given resources.
+func (h *Harness) AnalyzeStack(resources ...*Resource) ([]plugin.AnalyzeDiagnostic, error) {
+	stackResources := make([]plugin.AnalyzerStackResource, len(resources))
+	for i, r := range resources {
+		stackResources[i] = h.AnalyzerStackResource(r)
+	}
+	diags, err := h.analyzer.AnalyzeStack(stackResources)
+	if err != nil {
+		return nil, errors.Wrap(err, "analyzing stack")
+	}
+	return diags, nil
+}
+
+// AnalyzeAll runs the analyzer's resource policies against each of the given resources, followed by its stack
+// policies against a stack that contains all of them, and returns the combined diagnostics.
+func (h *Harness) AnalyzeAll(resources ...*Resource) ([]plugin.AnalyzeDiagnostic, error) {
+	var diagnostics []plugin.AnalyzeDiagnostic
+	for _, r := range resources {
+		diags, err := h.Analyze(r)
+		if err != nil {
+			return nil, err
+		}
+		diagnostics = append(diagnostics, diags...)
+	}
+	diags, err := h.AnalyzeStack(resources...)
+	if err != nil {
+		return nil, err
+	}
+	return append(diagnostics, diags...), nil
+}
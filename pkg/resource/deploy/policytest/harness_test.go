@@ -0,0 +1,175 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policytest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
+)
+
+// encryptionAnalyzer requires that buckets are encrypted, and records the resources that it analyzes.
+type encryptionAnalyzer struct {
+	config    map[string]plugin.AnalyzerPolicyConfig
+	resources []plugin.AnalyzerResource
+	stack     []plugin.AnalyzerStackResource
+}
+
+func (a *encryptionAnalyzer) Close() error       { return nil }
+func (a *encryptionAnalyzer) Name() tokens.QName { return "security" }
+
+func (a *encryptionAnalyzer) Analyze(r plugin.AnalyzerResource) ([]plugin.AnalyzeDiagnostic, error) {
+	a.resources = append(a.resources, r)
+	if r.Type != "aws:s3/bucket:Bucket" || r.Properties["encrypted"].IsBool() && r.Properties["encrypted"].BoolValue() {
+		return nil, nil
+	}
+	level := apitype.Advisory
+	if c, ok := a.config["s3-encryption"]; ok {
+		level = c.EnforcementLevel
+	}
+	return []plugin.AnalyzeDiagnostic{{
+		PolicyName:       "s3-encryption",
+		PolicyPackName:   "security",
+		Message:          "Bucket '" + string(r.Name) + "' is not encrypted.",
+		EnforcementLevel: level,
+	}}, nil
+}
+
+func (a *encryptionAnalyzer) AnalyzeStack(
+	resources []plugin.AnalyzerStackResource) ([]plugin.AnalyzeDiagnostic, error) {
+	a.stack = resources
+	return nil, nil
+}
+
+func (a *encryptionAnalyzer) AnalyzePlan(steps []plugin.AnalyzerPlannedStep) ([]plugin.AnalyzeDiagnostic, error) {
+	return nil, nil
+}
+
+func (a *encryptionAnalyzer) Remediate(r plugin.AnalyzerResource) ([]plugin.Remediation, error) {
+	return nil, nil
+}
+
+func (a *encryptionAnalyzer) GetAnalyzerInfo() (plugin.AnalyzerInfo, error) {
+	return plugin.AnalyzerInfo{Name: "security"}, nil
+}
+
+func (a *encryptionAnalyzer) GetPluginInfo() (workspace.PluginInfo, error) {
+	return workspace.PluginInfo{Name: "security", Kind: workspace.AnalyzerPlugin}, nil
+}
+
+func (a *encryptionAnalyzer) Configure(config map[string]plugin.AnalyzerPolicyConfig) error {
+	a.config = config
+	return nil
+}
+
+func TestHarness(t *testing.T) {
+	analyzer := &encryptionAnalyzer{}
+	h := New(analyzer, "app", "dev")
+
+	provider := &Resource{
+		Type:       "pulumi:providers:aws",
+		Name:       "east",
+		Properties: resource.PropertyMap{"region": resource.NewStringProperty("us-east-1")},
+	}
+	component := &Resource{Type: "my:index:Component", Name: "component"}
+	bucket := &Resource{
+		Type:       "aws:s3/bucket:Bucket",
+		Name:       "logs",
+		Parent:     component,
+		Provider:   provider,
+		Properties: resource.PropertyMap{"encrypted": resource.NewBoolProperty(false)},
+		PropertyDependencies: map[resource.PropertyKey][]*Resource{
+			"encrypted": {component},
+		},
+	}
+
+	bucketURN := resource.URN("urn:pulumi:dev::app::my:index:Component$aws:s3/bucket:Bucket::logs")
+	assert.Equal(t, bucketURN, h.URN(bucket))
+
+	diags, err := h.Analyze(bucket)
+	assert.NoError(t, err)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, bucketURN, diags[0].URN)
+		assert.Equal(t, apitype.Advisory, diags[0].EnforcementLevel)
+	}
+	if assert.Len(t, analyzer.resources, 1) && assert.NotNil(t, analyzer.resources[0].Provider) {
+		assert.Equal(t, resource.URN("urn:pulumi:dev::app::pulumi:providers:aws::east"),
+			analyzer.resources[0].Provider.URN)
+		assert.Equal(t, provider.Properties, analyzer.resources[0].Provider.Properties)
+	}
+
+	_, err = h.AnalyzeStack(provider, component, bucket)
+	assert.NoError(t, err)
+	if assert.Len(t, analyzer.stack, 3) {
+		assert.Equal(t, h.URN(component), analyzer.stack[2].Parent)
+		assert.Equal(t, map[resource.PropertyKey][]resource.URN{"encrypted": {h.URN(component)}},
+			analyzer.stack[2].PropertyDependencies)
+	}
+}
+
+func TestHarnessNestedURNs(t *testing.T) {
+	h := New(&encryptionAnalyzer{}, "app", "dev")
+
+	stack := &Resource{Type: resource.RootStackType, Name: "app-dev"}
+	outer := &Resource{Type: "my:index:Outer", Name: "outer", Parent: stack}
+	inner := &Resource{Type: "my:index:Inner", Name: "inner", Parent: outer}
+	bucket := &Resource{Type: "aws:s3/bucket:Bucket", Name: "logs", Parent: inner}
+
+	// Children of the stack are not qualified by its type, but each further ancestor's type is included.
+	assert.Equal(t, resource.URN("urn:pulumi:dev::app::my:index:Outer::outer"), h.URN(outer))
+	assert.Equal(t, resource.URN("urn:pulumi:dev::app::my:index:Outer$my:index:Inner::inner"), h.URN(inner))
+	assert.Equal(t, resource.URN("urn:pulumi:dev::app::my:index:Outer$my:index:Inner$aws:s3/bucket:Bucket::logs"),
+		h.URN(bucket))
+	assert.Equal(t, h.URN(inner), h.AnalyzerStackResource(bucket).Parent)
+}
+
+func TestRunFixture(t *testing.T) {
+	f, err := LoadFixture("testdata/unencrypted.yaml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "unencrypted buckets are reported", f.Name)
+
+	analyzer := &encryptionAnalyzer{}
+	h := New(analyzer, DefaultProject, DefaultStack)
+
+	result, err := h.RunFixture(f)
+	assert.NoError(t, err)
+	assert.True(t, result.Passed(), "%v", result.Failures)
+	if assert.Len(t, analyzer.stack, 4) {
+		assert.Equal(t, []resource.URN{analyzer.stack[2].URN}, analyzer.stack[3].Dependencies)
+		assert.Equal(t, analyzer.stack[1].URN, analyzer.stack[2].Parent)
+	}
+
+	// Missing and unexpected violations should both fail the fixture.
+	f.Expect[0].Resource = "data"
+	result, err = h.RunFixture(f)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"expected violation of security/s3-encryption by data was not reported",
+		"unexpected violation of security/s3-encryption by logs: Bucket 'logs' is not encrypted.",
+	}, result.Failures)
+
+	// References to unknown resources are errors.
+	f.Resources[2].Parent = "missing"
+	_, err = h.RunFixture(f)
+	assert.EqualError(t, err, `resource "logs" refers to unknown resource "missing"`)
+}
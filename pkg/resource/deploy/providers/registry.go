@@ -392,6 +392,19 @@ func (r *Registry) Invoke(tok tokens.ModuleMember,
 	return nil, nil, errors.New("the provider registry is not invokable")
 }
 
+func (r *Registry) Call(tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
+	options plugin.CallOptions) (plugin.CallResult, error) {
+
+	// It is the responsibility of the eval source to ensure that we never attempt a call using the provider
+	// registry.
+	contract.Fail()
+	return plugin.CallResult{}, errors.New("the provider registry is not callable")
+}
+
+func (r *Registry) ListResources(types []tokens.Type, tags map[string]string) ([]plugin.ListedResource, error) {
+	return nil, plugin.ErrListResourcesNotSupported
+}
+
 func (r *Registry) StreamInvoke(
 	tok tokens.ModuleMember, args resource.PropertyMap,
 	onNext func(resource.PropertyMap) error) ([]plugin.CheckFailure, error) {
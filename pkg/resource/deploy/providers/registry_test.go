@@ -151,6 +151,14 @@ func (prov *testProvider) Invoke(tok tokens.ModuleMember,
 	args resource.PropertyMap) (resource.PropertyMap, []plugin.CheckFailure, error) {
 	return nil, nil, errors.New("unsupported")
 }
+func (prov *testProvider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info plugin.CallInfo,
+	options plugin.CallOptions) (plugin.CallResult, error) {
+	return plugin.CallResult{}, errors.New("unsupported")
+}
+func (prov *testProvider) ListResources(types []tokens.Type,
+	tags map[string]string) ([]plugin.ListedResource, error) {
+	return nil, plugin.ErrListResourcesNotSupported
+}
 func (prov *testProvider) StreamInvoke(
 	tok tokens.ModuleMember, args resource.PropertyMap,
 	onNext func(resource.PropertyMap) error) ([]plugin.CheckFailure, error) {
@@ -398,6 +398,11 @@ func (rm *queryResmon) StreamInvoke(
 	return nil
 }
 
+// Call dynamically executes a method in the provider associated with a component resource.
+func (rm *queryResmon) Call(ctx context.Context, req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+	return nil, fmt.Errorf("Query mode does not support calling resource methods")
+}
+
 // ReadResource reads the current state associated with a resource from its provider plugin.
 func (rm *queryResmon) ReadResource(ctx context.Context,
 	req *pulumirpc.ReadResourceRequest) (*pulumirpc.ReadResourceResponse, error) {
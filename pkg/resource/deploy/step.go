@@ -864,7 +864,9 @@ func (s *ImportStep) Apply(preview bool) (resource.Status, StepCompleteFunc, err
 			return resource.StatusOK, nil, errors.Errorf("resource '%v' already exists", s.new.URN)
 		}
 		if s.new.Parent.Type() != resource.RootStackType {
-			if _, ok := s.deployment.olds[s.new.Parent]; !ok {
+			_, hasOld := s.deployment.olds[s.new.Parent]
+			_, hasNew := s.deployment.news.get(s.new.Parent)
+			if !hasOld && !hasNew {
 				return resource.StatusOK, nil, errors.Errorf("unknown parent '%v' for resource '%v'",
 					s.new.Parent, s.new.URN)
 			}
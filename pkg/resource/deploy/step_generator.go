@@ -28,6 +28,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/result"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
 // stepGenerator is responsible for turning resource events into steps that can be fed to the deployment executor.
@@ -66,6 +67,12 @@ type stepGenerator struct {
 
 	// a map from old names (aliased URNs) to the new URN that aliased to them.
 	aliased map[resource.URN]resource.URN
+
+	// a map from URN to the position of the code that registered the resource, if known.
+	sourcePositions map[resource.URN]*resource.SourcePosition
+
+	// the steps generated by this deployment, in the order in which they were generated.
+	steps []Step
 }
 
 func (sg *stepGenerator) isTargetedUpdate() bool {
@@ -87,6 +94,15 @@ func (sg *stepGenerator) Errored() bool {
 // GenerateReadSteps is responsible for producing one or more steps required to service
 // a ReadResourceEvent coming from the language host.
 func (sg *stepGenerator) GenerateReadSteps(event ReadResourceEvent) ([]Step, result.Result) {
+	steps, res := sg.generateReadSteps(event)
+	if res != nil {
+		return nil, res
+	}
+	sg.steps = append(sg.steps, steps...)
+	return steps, nil
+}
+
+func (sg *stepGenerator) generateReadSteps(event ReadResourceEvent) ([]Step, result.Result) {
 	urn := sg.deployment.generateURN(event.Parent(), event.Type(), event.Name())
 	newState := resource.NewState(event.Type(),
 		urn,
@@ -154,6 +170,7 @@ func (sg *stepGenerator) GenerateSteps(event RegisterResourceEvent) ([]Step, res
 		contract.Assert(len(steps) == 0)
 		return nil, res
 	}
+	sg.steps = append(sg.steps, steps...)
 	if !sg.isTargetedUpdate() {
 		return steps, nil
 	}
@@ -209,6 +226,9 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 		sg.deployment.Diag().Errorf(diag.GetDuplicateResourceURNError(urn), urn)
 	}
 	sg.urns[urn] = true
+	if goal.SourcePosition != nil {
+		sg.sourcePositions[urn] = goal.SourcePosition
+	}
 
 	// Check for an old resource so that we can figure out if this is a create, delete, etc., and/or
 	// to diff.  We look up first by URN and then by any provided aliases.  If it is found using an
@@ -300,6 +320,7 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 
 	// Ensure the provider is okay with this resource and fetch the inputs to pass to subsequent methods.
 	var err error
+	checkOlds := oldInputs
 	if prov != nil {
 		var failures []plugin.CheckFailure
 
@@ -308,6 +329,7 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 		// don't consider those inputs since Pulumi does not own them. Finally, if the resource has been
 		// targeted for replacement, ignore its old state.
 		if recreating || wasExternal || sg.isTargetedReplace(urn) {
+			checkOlds = nil
 			inputs, failures, err = prov.Check(urn, nil, goal.Properties, allowUnknowns)
 		} else {
 			inputs, failures, err = prov.Check(urn, oldInputs, inputs, allowUnknowns)
@@ -321,46 +343,74 @@ func (sg *stepGenerator) generateSteps(event RegisterResourceEvent) ([]Step, res
 		new.Inputs = inputs
 	}
 
-	// Send the resource off to any Analyzers before being operated on.
+	// Send the resource off to any Analyzers before being operated on. Analyzers may first remediate the resource's
+	// inputs, in which case the remediated inputs replace those returned by the provider's Check.
 	analyzers := sg.deployment.ctx.Host.ListAnalyzers()
+	remediated := false
 	for _, analyzer := range analyzers {
-		r := plugin.AnalyzerResource{
-			URN:        new.URN,
-			Type:       new.Type,
-			Name:       new.URN.Name(),
-			Properties: inputs,
-			Options: plugin.AnalyzerResourceOptions{
-				Protect:                 new.Protect,
-				IgnoreChanges:           goal.IgnoreChanges,
-				DeleteBeforeReplace:     goal.DeleteBeforeReplace,
-				AdditionalSecretOutputs: new.AdditionalSecretOutputs,
-				Aliases:                 new.Aliases,
-				CustomTimeouts:          new.CustomTimeouts,
-			},
+		r := sg.analyzerResource(new, goal, inputs)
+		remediations, err := analyzer.Remediate(r)
+		if err != nil {
+			return nil, result.FromError(err)
 		}
-		providerResource := sg.getProviderResource(new.URN, new.Provider)
-		if providerResource != nil {
-			r.Provider = &plugin.AnalyzerProviderResource{
-				URN:        providerResource.URN,
-				Type:       providerResource.Type,
-				Name:       providerResource.URN.Name(),
-				Properties: providerResource.Inputs,
+		for _, remediation := range remediations {
+			if remediation.Diagnostic != "" && !sg.opts.AnalyzePlanOnly {
+				sg.reportPolicyViolation(new.URN, plugin.AnalyzeDiagnostic{
+					PolicyName:        remediation.PolicyName,
+					PolicyPackName:    remediation.PolicyPackName,
+					PolicyPackVersion: remediation.PolicyPackVersion,
+					Description:       remediation.Description,
+					Message:           remediation.Diagnostic,
+					EnforcementLevel:  apitype.Advisory,
+					URN:               new.URN,
+				})
+			}
+			if remediation.Properties == nil {
+				continue
 			}
+
+			before := inputs
+			inputs = remediation.Properties
+			new.Inputs = inputs
+			remediated = true
+			if !sg.opts.AnalyzePlanOnly {
+				sg.opts.Events.OnPolicyRemediation(new.URN, remediation, before, inputs)
+			}
+		}
+	}
+
+	// Remediations may produce inputs that the provider would not accept, so check the remediated inputs again before
+	// they are analyzed and diffed.
+	if remediated && prov != nil {
+		var failures []plugin.CheckFailure
+		inputs, failures, err = prov.Check(urn, checkOlds, inputs, allowUnknowns)
+		if err != nil {
+			return nil, result.FromError(err)
+		} else if issueCheckErrors(sg.deployment, new, urn, failures) {
+			invalid = true
 		}
+		new.Inputs = inputs
+	}
 
+	// A deployment that only analyzes its plan leaves the resource policies to the update that follows it.
+	resourceAnalyzers := analyzers
+	if sg.opts.AnalyzePlanOnly {
+		resourceAnalyzers = nil
+	}
+	for _, analyzer := range resourceAnalyzers {
+		r := sg.analyzerResource(new, goal, inputs)
 		diagnostics, err := analyzer.Analyze(r)
 		if err != nil {
 			return nil, result.FromError(err)
 		}
 		for _, d := range diagnostics {
-			if d.EnforcementLevel == apitype.Mandatory {
+			// For now, we always use the URN we have here rather than a URN specified with the diagnostic.
+			if sg.reportPolicyViolation(new.URN, d) {
 				if !sg.deployment.preview {
 					invalid = true
 				}
 				sg.sawError = true
 			}
-			// For now, we always use the URN we have here rather than a URN specified with the diagnostic.
-			sg.opts.Events.OnPolicyViolation(new.URN, d)
 		}
 	}
 
@@ -757,6 +807,7 @@ func (sg *stepGenerator) GenerateDeletes(targetsOpt map[resource.URN]bool) ([]St
 		return nil, result.Bail()
 	}
 
+	sg.steps = append(sg.steps, dels...)
 	return dels, nil
 }
 
@@ -1079,6 +1130,36 @@ func diffResource(urn resource.URN, id resource.ID, oldInputs, oldOutputs,
 	return diff, nil
 }
 
+// analyzerResource returns the view of the given resource and inputs that is sent to analyzers.
+func (sg *stepGenerator) analyzerResource(new *resource.State, goal *resource.Goal,
+	inputs resource.PropertyMap) plugin.AnalyzerResource {
+
+	r := plugin.AnalyzerResource{
+		URN:        new.URN,
+		Type:       new.Type,
+		Name:       new.URN.Name(),
+		Properties: inputs,
+		Options: plugin.AnalyzerResourceOptions{
+			Protect:                 new.Protect,
+			IgnoreChanges:           goal.IgnoreChanges,
+			DeleteBeforeReplace:     goal.DeleteBeforeReplace,
+			AdditionalSecretOutputs: new.AdditionalSecretOutputs,
+			Aliases:                 new.Aliases,
+			CustomTimeouts:          new.CustomTimeouts,
+		},
+	}
+	providerResource := sg.getProviderResource(new.URN, new.Provider)
+	if providerResource != nil {
+		r.Provider = &plugin.AnalyzerProviderResource{
+			URN:        providerResource.URN,
+			Type:       providerResource.Type,
+			Name:       providerResource.URN.Name(),
+			Properties: providerResource.Inputs,
+		}
+	}
+	return r
+}
+
 // issueCheckErrors prints any check errors to the diagnostics sink.
 func issueCheckErrors(deployment *Deployment, new *resource.State, urn resource.URN,
 	failures []plugin.CheckFailure) bool {
@@ -1292,69 +1373,168 @@ func (sg *stepGenerator) calculateDependentReplacements(root *resource.State) ([
 func (sg *stepGenerator) AnalyzeResources() result.Result {
 	var resources []plugin.AnalyzerStackResource
 	sg.deployment.news.mapRange(func(urn resource.URN, v *resource.State) bool {
-		goal, ok := sg.deployment.goals.get(urn)
+		_, ok := sg.deployment.goals.get(urn)
 		contract.Assertf(ok, "failed to load goal for %s", urn)
-		resource := plugin.AnalyzerStackResource{
-			AnalyzerResource: plugin.AnalyzerResource{
-				URN:  v.URN,
-				Type: v.Type,
-				Name: v.URN.Name(),
-				// Unlike Analyze, AnalyzeStack is called on the final outputs of each resource,
-				// to verify the final stack is in a compliant state.
-				Properties: v.Outputs,
-				Options: plugin.AnalyzerResourceOptions{
-					Protect:                 v.Protect,
-					IgnoreChanges:           goal.IgnoreChanges,
-					DeleteBeforeReplace:     goal.DeleteBeforeReplace,
-					AdditionalSecretOutputs: v.AdditionalSecretOutputs,
-					Aliases:                 v.Aliases,
-					CustomTimeouts:          v.CustomTimeouts,
-				},
-			},
-			Parent:               v.Parent,
-			Dependencies:         v.Dependencies,
-			PropertyDependencies: v.PropertyDependencies,
-		}
-		providerResource := sg.getProviderResource(v.URN, v.Provider)
-		if providerResource != nil {
-			resource.Provider = &plugin.AnalyzerProviderResource{
-				URN:        providerResource.URN,
-				Type:       providerResource.Type,
-				Name:       providerResource.URN.Name(),
-				Properties: providerResource.Inputs,
-			}
-		}
-		resources = append(resources, resource)
+		// Unlike Analyze, AnalyzeStack is called on the final outputs of each resource,
+		// to verify the final stack is in a compliant state.
+		resources = append(resources, sg.analyzerStackResource(v, v.Outputs))
 		return true
 	})
 
+	// AnalyzePlan is called on each step that changes the stack, so that policies can govern the changes as well
+	// as the final state. The steps are only analyzed by previews: before an update, the engine previews it with
+	// AnalyzePlanOnly set so that a mandatory violation stops the update before any of its steps are applied.
+	var steps []plugin.AnalyzerPlannedStep
+	stepURNs := map[resource.URN]bool{}
+	for _, step := range sg.steps {
+		if step.Op() == OpSame {
+			continue
+		}
+		steps = append(steps, sg.analyzerPlannedStep(step))
+		stepURNs[step.URN()] = true
+	}
+
 	analyzers := sg.deployment.ctx.Host.ListAnalyzers()
 	for _, analyzer := range analyzers {
-		diagnostics, aErr := analyzer.AnalyzeStack(resources)
-		if aErr != nil {
-			return result.FromError(aErr)
+		var diagnostics []plugin.AnalyzeDiagnostic
+		if !sg.opts.AnalyzePlanOnly {
+			stackDiagnostics, aErr := analyzer.AnalyzeStack(resources)
+			if aErr != nil {
+				return result.FromError(aErr)
+			}
+			diagnostics = stackDiagnostics
+		}
+		if sg.deployment.preview {
+			planDiagnostics, aErr := analyzer.AnalyzePlan(steps)
+			if aErr != nil {
+				return result.FromError(aErr)
+			}
+			diagnostics = append(diagnostics, planDiagnostics...)
 		}
 		for _, d := range diagnostics {
-			sg.sawError = sg.sawError || (d.EnforcementLevel == apitype.Mandatory)
-			// If a URN was provided and it is a URN associated with a resource in the stack, use it.
-			// Otherwise, if the URN is empty or is not associated with a resource in the stack, use
-			// the default root stack URN.
+			// If a URN was provided and it is a URN associated with a resource in the stack or with one of the
+			// deployment's steps, use it. Otherwise, if the URN is empty or is not associated with a resource in
+			// the stack, use the default root stack URN.
 			var urn resource.URN
 			if d.URN != "" {
-				if _, ok := sg.deployment.news.get(d.URN); ok {
+				if _, ok := sg.deployment.news.get(d.URN); ok || stepURNs[d.URN] {
 					urn = d.URN
 				}
 			}
 			if urn == "" {
 				urn = resource.DefaultRootStackURN(sg.deployment.Target().Name, sg.deployment.source.Project())
 			}
-			sg.opts.Events.OnPolicyViolation(urn, d)
+			if sg.reportPolicyViolation(urn, d) {
+				sg.sawError = true
+			}
 		}
 	}
 
 	return nil
 }
 
+// analyzerStackResource returns the view of the given resource that is sent to analyzers, with the given properties.
+func (sg *stepGenerator) analyzerStackResource(v *resource.State,
+	props resource.PropertyMap) plugin.AnalyzerStackResource {
+
+	resource := plugin.AnalyzerStackResource{
+		AnalyzerResource: plugin.AnalyzerResource{
+			URN:        v.URN,
+			Type:       v.Type,
+			Name:       v.URN.Name(),
+			Properties: props,
+			Options: plugin.AnalyzerResourceOptions{
+				Protect:                 v.Protect,
+				AdditionalSecretOutputs: v.AdditionalSecretOutputs,
+				Aliases:                 v.Aliases,
+				CustomTimeouts:          v.CustomTimeouts,
+			},
+		},
+		Parent:               v.Parent,
+		Dependencies:         v.Dependencies,
+		PropertyDependencies: v.PropertyDependencies,
+	}
+	// Some options are only recorded in the goal state of resources that are registered by this deployment.
+	if goal, ok := sg.deployment.goals.get(v.URN); ok {
+		resource.Options.IgnoreChanges = goal.IgnoreChanges
+		resource.Options.DeleteBeforeReplace = goal.DeleteBeforeReplace
+	}
+	if providerResource := sg.lookupProviderResource(v.Provider); providerResource != nil {
+		resource.Provider = &plugin.AnalyzerProviderResource{
+			URN:        providerResource.URN,
+			Type:       providerResource.Type,
+			Name:       providerResource.URN.Name(),
+			Properties: providerResource.Inputs,
+		}
+	}
+	return resource
+}
+
+// lookupProviderResource returns the provider resource for the given provider reference, if any. Unlike
+// getProviderResource, it also finds providers of old resources that this deployment has not registered, such as the
+// providers of resources that are being deleted.
+func (sg *stepGenerator) lookupProviderResource(provider string) *resource.State {
+	if provider == "" {
+		return nil
+	}
+	ref, err := providers.ParseReference(provider)
+	if err != nil {
+		return nil
+	}
+	if result, ok := sg.providers[ref.URN()]; ok {
+		return result
+	}
+	return sg.deployment.olds[ref.URN()]
+}
+
+// analyzerPlannedStep returns the view of the given step that is sent to analyzers. The old resource is described by
+// its outputs, and the new resource by its inputs, which are all that is known about it during a preview.
+func (sg *stepGenerator) analyzerPlannedStep(step Step) plugin.AnalyzerPlannedStep {
+	planned := plugin.AnalyzerPlannedStep{
+		Op:   string(step.Op()),
+		URN:  step.URN(),
+		Type: step.Type(),
+	}
+	if old := step.Old(); old != nil {
+		r := sg.analyzerStackResource(old, old.Outputs)
+		planned.Old = &r
+	}
+	if goal := step.New(); goal != nil {
+		r := sg.analyzerStackResource(goal, goal.Inputs)
+		planned.New = &r
+	}
+	if keyer, hasKeys := step.(interface{ Keys() []resource.PropertyKey }); hasKeys {
+		planned.ReplaceKeys = keyer.Keys()
+	}
+	if differ, hasDiffs := step.(interface{ Diffs() []resource.PropertyKey }); hasDiffs {
+		planned.Diffs = differ.Diffs()
+	}
+	if detailedDiffer, hasDetailedDiff := step.(interface {
+		DetailedDiff() map[string]plugin.PropertyDiff
+	}); hasDetailedDiff {
+		planned.DetailedDiff = detailedDiffer.DetailedDiff()
+	}
+	return planned
+}
+
+// reportPolicyViolation reports a policy violation by the resource with the given URN. Violations that are waived by
+// one of the deployment's policy exemptions are reported as exempted rather than as violations. Returns true if the
+// violation is of a mandatory policy and is not exempted.
+func (sg *stepGenerator) reportPolicyViolation(urn resource.URN, d plugin.AnalyzeDiagnostic) bool {
+	if d.SourcePosition == nil {
+		d.SourcePosition = sg.sourcePositions[urn]
+	}
+
+	exemption := workspace.FindPolicyExemption(sg.opts.PolicyExemptions, d.PolicyPackName, d.PolicyName, string(urn))
+	if exemption != nil {
+		sg.opts.Events.OnPolicyExemption(urn, d, *exemption)
+		return false
+	}
+
+	sg.opts.Events.OnPolicyViolation(urn, d)
+	return d.EnforcementLevel == apitype.Mandatory
+}
+
 // newStepGenerator creates a new step generator that operates on the given deployment.
 func newStepGenerator(
 	deployment *Deployment, opts Options, updateTargetsOpt, replaceTargetsOpt map[resource.URN]bool) *stepGenerator {
@@ -1376,5 +1556,6 @@ func newStepGenerator(
 		providers:            make(map[resource.URN]*resource.State),
 		dependentReplaceKeys: make(map[resource.URN][]resource.PropertyKey),
 		aliased:              make(map[resource.URN]resource.URN),
+		sourcePositions:      make(map[resource.URN]*resource.SourcePosition),
 	}
 }
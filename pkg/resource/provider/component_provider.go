@@ -32,6 +32,7 @@ type componentProvider struct {
 	version   string
 	schema    []byte
 	construct provider.ConstructFunc
+	call      provider.CallFunc
 }
 
 // ComponentMain is an entrypoint for a resource provider plugin that implements `Construct` for component resources.
@@ -49,6 +50,22 @@ func ComponentMain(name, version string, schema []byte, construct provider.Const
 	})
 }
 
+// ComponentMainWithCall is like ComponentMain, but additionally implements `Call` for the methods of component
+// resources.
+func ComponentMainWithCall(name, version string, schema []byte, construct provider.ConstructFunc,
+	call provider.CallFunc) error {
+	return Main(name, func(host *HostClient) (pulumirpc.ResourceProviderServer, error) {
+		return &componentProvider{
+			host:      host,
+			name:      name,
+			version:   version,
+			schema:    schema,
+			construct: construct,
+			call:      call,
+		}, nil
+	})
+}
+
 // GetPluginInfo returns generic information about this plugin, like its version.
 func (p *componentProvider) GetPluginInfo(context.Context, *pbempty.Empty) (*pulumirpc.PluginInfo, error) {
 	return &pulumirpc.PluginInfo{
@@ -85,6 +102,21 @@ func (p *componentProvider) Construct(ctx context.Context,
 	return provider.Construct(ctx, req, p.host.conn, p.construct)
 }
 
+// Call dynamically executes a method in the provider associated with a component resource.
+func (p *componentProvider) Call(ctx context.Context,
+	req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+	if p.call == nil {
+		return nil, status.Error(codes.Unimplemented, "Call is not yet implemented")
+	}
+	return provider.Call(ctx, req, p.host.conn, p.call)
+}
+
+// ListResources is not supported by component providers.
+func (p *componentProvider) ListResources(ctx context.Context,
+	req *pulumirpc.ListResourcesRequest) (*pulumirpc.ListResourcesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "ListResources is not supported by component providers")
+}
+
 // CheckConfig validates the configuration for this provider.
 func (p *componentProvider) CheckConfig(ctx context.Context,
 	req *pulumirpc.CheckRequest) (*pulumirpc.CheckResponse, error) {
@@ -49,6 +49,25 @@ namespace Pulumi
         public Task InvokeAsync(string token, InvokeArgs args, InvokeOptions? options = null)
             => _deployment.InvokeAsync(token, args, options);
 
+        /// <summary>
+        /// Dynamically calls the function '<paramref name="token"/>', which is offered by a
+        /// provider plugin.
+        /// <para/>
+        /// The result of <see cref="Call{T}"/> will be a <see cref="Output{T}"/> resolved to the
+        /// result value of the provider plugin.
+        /// <para/>
+        /// The <paramref name="args"/> inputs can be a bag of computed values(including, `T`s,
+        /// <see cref="Task{TResult}"/>s, <see cref="Output{T}"/>s etc.).
+        /// </summary>
+        public Output<T> Call<T>(string token, CallArgs args, Resource? self = null)
+            => _deployment.Call<T>(token, args, self);
+
+        /// <summary>
+        /// Same as <see cref="Call{T}(string, CallArgs, Resource)"/>, however the return value is ignored.
+        /// </summary>
+        public void Call(string token, CallArgs args, Resource? self = null)
+            => _deployment.Call(token, args, self);
+
         internal IDeploymentInternal Internal => (IDeploymentInternal)_deployment;
     }
 }
@@ -0,0 +1,121 @@
+﻿// Copyright 2016-2021, Pulumi Corporation
+
+using System;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Google.Protobuf.WellKnownTypes;
+using Pulumi.Serialization;
+using Pulumirpc;
+
+namespace Pulumi
+{
+    public sealed partial class Deployment
+    {
+        void IDeployment.Call(string token, CallArgs args, Resource? self)
+            => Call<object>(token, args, self, convertResult: false);
+
+        Output<T> IDeployment.Call<T>(string token, CallArgs args, Resource? self)
+            => Call<T>(token, args, self, convertResult: true);
+
+        private Output<T> Call<T>(string token, CallArgs args, Resource? self, bool convertResult)
+            => new Output<T>(CallAsync<T>(token, args, self, convertResult));
+
+        private async Task<OutputData<T>> CallAsync<T>(
+            string token, CallArgs args, Resource? self, bool convertResult)
+        {
+            var (result, deps) = await CallRawAsync(token, args, self).ConfigureAwait(false);
+            if (convertResult)
+            {
+                var converted = Converter.ConvertValue<T>($"{token} result", new Value { StructValue = result });
+                return new OutputData<T>(deps, converted.Value, converted.IsKnown, converted.IsSecret);
+            }
+
+            return new OutputData<T>(ImmutableHashSet<Resource>.Empty, default!, isKnown: true, isSecret: false);
+        }
+
+        private async Task<(Struct Return, ImmutableHashSet<Resource> Dependencies)> CallRawAsync(
+            string token, CallArgs args, Resource? self)
+        {
+            var label = $"Calling function: token={token} asynchronously";
+            Log.Debug(label);
+
+            // Be resilient to misbehaving callers.
+            args ??= CallArgs.Empty;
+
+            // Wait for all values to be available.
+            var argsDict = await args.ToDictionaryAsync().ConfigureAwait(false);
+
+            // If we have a self arg, include it in the args.
+            if (self != null)
+            {
+                argsDict = argsDict.SetItem("__self__", self);
+            }
+
+            var (serialized, propertyToDependentResources) = await SerializeFilteredPropertiesAsync(
+                    $"call:{token}", argsDict, _ => true,
+                    await this.MonitorSupportsResourceReferences().ConfigureAwait(false)).ConfigureAwait(false);
+            Log.Debug($"Call RPC prepared: token={token}" +
+                (_excessiveDebugOutput ? $", obj={serialized}" : ""));
+
+            // Determine the provider to use.
+            var provider = await ProviderResource.RegisterAsync(self?.GetProvider(token)).ConfigureAwait(false);
+
+            // Create the request.
+            var request = new CallRequest
+            {
+                Tok = token,
+                Provider = provider ?? "",
+                Args = serialized,
+            };
+
+            // Add arg dependencies to the request.
+            foreach (var (argName, directDependencies) in propertyToDependentResources)
+            {
+                var urns = await GetAllTransitivelyReferencedCustomResourceURNsAsync(directDependencies).ConfigureAwait(false);
+                var deps = new CallRequest.Types.ArgumentDependencies();
+                deps.Urns.AddRange(urns);
+                request.ArgDependencies.Add(argName, deps);
+            }
+
+            // Kick off the call.
+            var result = await this.Monitor.CallAsync(request).ConfigureAwait(false);
+
+            // Handle failures.
+            if (result.Failures.Count > 0)
+            {
+                var reasons = "";
+                foreach (var reason in result.Failures)
+                {
+                    if (reasons != "")
+                    {
+                        reasons += "; ";
+                    }
+
+                    reasons += $"{reason.Reason} ({reason.Property})";
+                }
+
+                throw new CallException($"Call of '{token}' failed: {reasons}");
+            }
+
+            // Unmarshal return dependencies.
+            var dependencies = ImmutableHashSet.CreateBuilder<Resource>();
+            foreach (var (_, returnDependencies) in result.ReturnDependencies)
+            {
+                foreach (var urn in returnDependencies.Urns)
+                {
+                    dependencies.Add(new DependencyResource(urn));
+                }
+            }
+
+            return (result.Return, dependencies.ToImmutable());
+        }
+
+        private class CallException : Exception
+        {
+            public CallException(string error)
+                : base(error)
+            {
+            }
+        }
+    }
+}
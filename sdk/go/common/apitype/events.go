@@ -57,6 +57,25 @@ type PolicyEvent struct {
 
 	// EnforcementLevel is one of "warning" or "mandatory".
 	EnforcementLevel string `json:"enforcementLevel"`
+
+	// Exempted is true if the violation is waived by a policy exemption, in which case ExemptionJustification
+	// explains why.
+	Exempted               bool   `json:"exempted,omitempty"`
+	ExemptionJustification string `json:"exemptionJustification,omitempty"`
+}
+
+// PolicyRemediationEvent is emitted whenever a policy remediation transforms a resource's inputs.
+type PolicyRemediationEvent struct {
+	ResourceURN          string `json:"resourceUrn,omitempty"`
+	Color                string `json:"color"`
+	PolicyName           string `json:"policyName"`
+	PolicyPackName       string `json:"policyPackName"`
+	PolicyPackVersion    string `json:"policyPackVersion"`
+	PolicyPackVersionTag string `json:"policyPackVersionTag"`
+
+	// Before and After contain the resource's inputs before and after the remediation was applied.
+	Before map[string]interface{} `json:"before,omitempty"`
+	After  map[string]interface{} `json:"after,omitempty"`
 }
 
 // PreludeEvent is emitted at the start of an update.
@@ -206,6 +225,8 @@ type EngineEvent struct {
 	ResOutputsEvent  *ResOutputsEvent   `json:"resOutputsEvent,omitempty"`
 	ResOpFailedEvent *ResOpFailedEvent  `json:"resOpFailedEvent,omitempty"`
 	PolicyEvent      *PolicyEvent       `json:"policyEvent,omitempty"`
+
+	PolicyRemediationEvent *PolicyRemediationEvent `json:"policyRemediationEvent,omitempty"`
 }
 
 // EngineEventBatch is a group of engine events.
@@ -16,6 +16,7 @@ package encoding
 
 import (
 	"encoding/json"
+	"fmt"
 	"path/filepath"
 
 	yaml "gopkg.in/yaml.v2"
@@ -108,3 +109,24 @@ func (m *yamlMarshaler) Unmarshal(data []byte, v interface{}) error {
 
 	return yaml.Unmarshal(data, v)
 }
+
+// YAMLToJSON converts a value decoded from YAML into a value that can be marshaled as JSON. The YAML decoder produces
+// maps with keys of any type, which are converted into maps with string keys.
+func YAMLToJSON(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			result[fmt.Sprintf("%v", k)] = YAMLToJSON(v)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, v := range value {
+			result[i] = YAMLToJSON(v)
+		}
+		return result
+	default:
+		return value
+	}
+}
@@ -37,6 +37,13 @@ type Analyzer interface {
 	// AnalyzeStack analyzes all resources after a successful preview or update.
 	// Is called after all resources have been processed, and all changes applied.
 	AnalyzeStack(resources []AnalyzerStackResource) ([]AnalyzeDiagnostic, error)
+	// AnalyzePlan analyzes the steps planned by a preview of a deployment.
+	// Is called after all resources have been processed. Before an update, the engine previews it and calls
+	// AnalyzePlan with its planned steps, so that mandatory violations stop the update before any step is applied.
+	AnalyzePlan(steps []AnalyzerPlannedStep) ([]AnalyzeDiagnostic, error)
+	// Remediate is given the opportunity to optionally transform a single resource's properties.
+	// Is called after the resource's inputs have been checked and before it is analyzed.
+	Remediate(r AnalyzerResource) ([]Remediation, error)
 	// GetAnalyzerInfo returns metadata about the analyzer (e.g., list of policies contained).
 	GetAnalyzerInfo() (AnalyzerInfo, error)
 	// GetPluginInfo returns this plugin's information.
@@ -63,6 +70,18 @@ type AnalyzerStackResource struct {
 	PropertyDependencies map[resource.PropertyKey][]resource.URN // the set of dependencies that affect each property.
 }
 
+// AnalyzerPlannedStep mirrors a step of a deployment that is passed to `AnalyzePlan`.
+type AnalyzerPlannedStep struct {
+	Op           string                  // the operation, e.g. "create", "update", "replace" or "delete".
+	URN          resource.URN            // the URN of the resource.
+	Type         tokens.Type             // the type token of the resource.
+	Old          *AnalyzerStackResource  // the resource before the step, if any; the properties are its outputs.
+	New          *AnalyzerStackResource  // the resource after the step, if any; the properties are its inputs.
+	ReplaceKeys  []resource.PropertyKey  // the properties that caused the resource to be replaced, if any.
+	Diffs        []resource.PropertyKey  // the properties that changed, if known.
+	DetailedDiff map[string]PropertyDiff // a detailed diff of the properties, if the provider supports it.
+}
+
 // AnalyzerResourceOptions mirrors resource options sent to the analyzer.
 type AnalyzerResourceOptions struct {
 	Protect                 bool                    // true to protect this resource from deletion.
@@ -92,6 +111,21 @@ type AnalyzeDiagnostic struct {
 	Tags              []string
 	EnforcementLevel  apitype.EnforcementLevel
 	URN               resource.URN
+
+	// SourcePosition is the position of the code that registered the resource that violated the policy, if known.
+	// It is filled in by the engine rather than by the analyzer.
+	SourcePosition *resource.SourcePosition
+}
+
+// Remediation indicates that a resource remediation took place, and contains the resulting
+// transformed properties and associated metadata.
+type Remediation struct {
+	PolicyName        string
+	PolicyPackName    string
+	PolicyPackVersion string
+	Description       string
+	Properties        resource.PropertyMap
+	Diagnostic        string
 }
 
 // AnalyzerInfo provides metadata about a PolicyPack inside an analyzer.
@@ -54,8 +54,8 @@ var _ Analyzer = (*analyzer)(nil)
 // could not be found by name on the PATH, or an error occurs while creating the child process, an error is returned.
 func NewAnalyzer(host Host, ctx *Context, name tokens.QName) (Analyzer, error) {
 	// Load the plugin's path by using the standard workspace logic.
-	_, path, err := workspace.GetPluginPath(
-		workspace.AnalyzerPlugin, strings.Replace(string(name), tokens.QNameDelimiter, "_", -1), nil)
+	_, path, err := workspace.GetPluginPathWithOverrides(
+		workspace.AnalyzerPlugin, strings.Replace(string(name), tokens.QNameDelimiter, "_", -1), nil, ctx.ProjectPlugins)
 	if err != nil {
 		return nil, rpcerror.Convert(err)
 	} else if path == "" {
@@ -98,8 +98,8 @@ func NewPolicyAnalyzer(
 	}
 
 	// Load the policy-booting analyzer plugin (i.e., `pulumi-analyzer-${policyAnalyzerName}`).
-	_, pluginPath, err := workspace.GetPluginPath(
-		workspace.AnalyzerPlugin, policyAnalyzerName, nil)
+	_, pluginPath, err := workspace.GetPluginPathWithOverrides(
+		workspace.AnalyzerPlugin, policyAnalyzerName, nil, ctx.ProjectPlugins)
 	if err != nil {
 		return nil, rpcerror.Convert(err)
 	} else if pluginPath == "" {
@@ -207,44 +207,11 @@ func (a *analyzer) AnalyzeStack(resources []AnalyzerStackResource) ([]AnalyzeDia
 
 	protoResources := make([]*pulumirpc.AnalyzerResource, len(resources))
 	for idx, resource := range resources {
-		props, err := MarshalProperties(resource.Properties,
-			MarshalOptions{KeepUnknowns: true, KeepSecrets: true, SkipInternalKeys: true})
-		if err != nil {
-			return nil, errors.Wrap(err, "marshalling properties")
-		}
-
-		provider, err := marshalProvider(resource.Provider)
+		r, err := marshalStackResource(resource)
 		if err != nil {
 			return nil, err
 		}
-
-		propertyDeps := make(map[string]*pulumirpc.AnalyzerPropertyDependencies)
-		for pk, pd := range resource.PropertyDependencies {
-			// Skip properties that have no dependencies.
-			if len(pd) == 0 {
-				continue
-			}
-
-			pdeps := []string{}
-			for _, d := range pd {
-				pdeps = append(pdeps, string(d))
-			}
-			propertyDeps[string(pk)] = &pulumirpc.AnalyzerPropertyDependencies{
-				Urns: pdeps,
-			}
-		}
-
-		protoResources[idx] = &pulumirpc.AnalyzerResource{
-			Urn:                  string(resource.URN),
-			Type:                 string(resource.Type),
-			Name:                 string(resource.Name),
-			Properties:           props,
-			Options:              marshalResourceOptions(resource.Options),
-			Provider:             provider,
-			Parent:               string(resource.Parent),
-			Dependencies:         convertURNs(resource.Dependencies),
-			PropertyDependencies: propertyDeps,
-		}
+		protoResources[idx] = r
 	}
 
 	resp, err := a.client.AnalyzeStack(a.ctx.Request(), &pulumirpc.AnalyzeStackRequest{
@@ -274,6 +241,93 @@ func (a *analyzer) AnalyzeStack(resources []AnalyzerStackResource) ([]AnalyzeDia
 	return diags, nil
 }
 
+// AnalyzePlan analyzes the steps of a deployment at the end of the update operation.
+func (a *analyzer) AnalyzePlan(steps []AnalyzerPlannedStep) ([]AnalyzeDiagnostic, error) {
+	logging.V(7).Infof("%s.AnalyzePlan(#steps=%d) executing", a.label(), len(steps))
+
+	protoSteps := make([]*pulumirpc.AnalyzerPlannedStep, len(steps))
+	for idx, step := range steps {
+		s, err := marshalPlannedStep(step)
+		if err != nil {
+			return nil, err
+		}
+		protoSteps[idx] = s
+	}
+
+	resp, err := a.client.AnalyzePlan(a.ctx.Request(), &pulumirpc.AnalyzePlanRequest{
+		Steps: protoSteps,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		// Policy packs that predate plan analysis do not implement this method; treat this as if no
+		// policies were violated.
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s.AnalyzePlan(...) is unimplemented, skipping: err=%v", a.label(), rpcError)
+			return nil, nil
+		}
+
+		logging.V(7).Infof("%s.AnalyzePlan(...) failed: err=%v", a.label(), rpcError)
+		return nil, rpcError
+	}
+
+	failures := resp.GetDiagnostics()
+	logging.V(7).Infof("%s.AnalyzePlan(...) success: failures=#%d", a.label(), len(failures))
+
+	diags, err := convertDiagnostics(failures, a.version)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting analysis results")
+	}
+	return diags, nil
+}
+
+// Remediate is given the opportunity to optionally transform a single resource's properties.
+func (a *analyzer) Remediate(r AnalyzerResource) ([]Remediation, error) {
+	urn, t, name, props := r.URN, r.Type, r.Name, r.Properties
+
+	label := fmt.Sprintf("%s.Remediate(%s)", a.label(), t)
+	logging.V(7).Infof("%s executing (#props=%d)", label, len(props))
+	mprops, err := MarshalProperties(props,
+		MarshalOptions{KeepUnknowns: true, KeepSecrets: true, SkipInternalKeys: true})
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := marshalProvider(r.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Remediate(a.ctx.Request(), &pulumirpc.AnalyzeRequest{
+		Urn:        string(urn),
+		Type:       string(t),
+		Name:       string(name),
+		Properties: mprops,
+		Options:    marshalResourceOptions(r.Options),
+		Provider:   provider,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		// Policy packs that predate remediations do not implement this method; treat this as if no
+		// remediations were applied.
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s is unimplemented, skipping: err=%v", label, rpcError)
+			return nil, nil
+		}
+
+		logging.V(7).Infof("%s failed: err=%v", label, rpcError)
+		return nil, rpcError
+	}
+
+	remediations := resp.GetRemediations()
+	logging.V(7).Infof("%s success: remediations=#%d", label, len(remediations))
+
+	results, err := convertRemediations(remediations, a.version)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting remediation results")
+	}
+	return results, nil
+}
+
 // GetAnalyzerInfo returns metadata about the policies contained in this analyzer plugin.
 func (a *analyzer) GetAnalyzerInfo() (AnalyzerInfo, error) {
 	label := fmt.Sprintf("%s.GetAnalyzerInfo()", a.label())
@@ -306,7 +360,7 @@ func (a *analyzer) GetAnalyzerInfo() (AnalyzerInfo, error) {
 			}
 			schema.Properties["enforcementLevel"] = JSONSchema{
 				"type": "string",
-				"enum": []string{"advisory", "mandatory", "disabled"},
+				"enum": []string{"advisory", "mandatory", "remediate", "disabled"},
 			}
 		}
 
@@ -463,6 +517,92 @@ func marshalProvider(provider *AnalyzerProviderResource) (*pulumirpc.AnalyzerPro
 	}, nil
 }
 
+func marshalStackResource(resource AnalyzerStackResource) (*pulumirpc.AnalyzerResource, error) {
+	props, err := MarshalProperties(resource.Properties,
+		MarshalOptions{KeepUnknowns: true, KeepSecrets: true, SkipInternalKeys: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling properties")
+	}
+
+	provider, err := marshalProvider(resource.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	propertyDeps := make(map[string]*pulumirpc.AnalyzerPropertyDependencies)
+	for pk, pd := range resource.PropertyDependencies {
+		// Skip properties that have no dependencies.
+		if len(pd) == 0 {
+			continue
+		}
+
+		pdeps := []string{}
+		for _, d := range pd {
+			pdeps = append(pdeps, string(d))
+		}
+		propertyDeps[string(pk)] = &pulumirpc.AnalyzerPropertyDependencies{
+			Urns: pdeps,
+		}
+	}
+
+	return &pulumirpc.AnalyzerResource{
+		Urn:                  string(resource.URN),
+		Type:                 string(resource.Type),
+		Name:                 string(resource.Name),
+		Properties:           props,
+		Options:              marshalResourceOptions(resource.Options),
+		Provider:             provider,
+		Parent:               string(resource.Parent),
+		Dependencies:         convertURNs(resource.Dependencies),
+		PropertyDependencies: propertyDeps,
+	}, nil
+}
+
+func marshalPlannedStep(step AnalyzerPlannedStep) (*pulumirpc.AnalyzerPlannedStep, error) {
+	result := &pulumirpc.AnalyzerPlannedStep{
+		Op:          step.Op,
+		Urn:         string(step.URN),
+		Type:        string(step.Type),
+		ReplaceKeys: convertPropertyKeys(step.ReplaceKeys),
+		Diffs:       convertPropertyKeys(step.Diffs),
+	}
+
+	var err error
+	if step.Old != nil {
+		if result.Old, err = marshalStackResource(*step.Old); err != nil {
+			return nil, err
+		}
+	}
+	if step.New != nil {
+		if result.New, err = marshalStackResource(*step.New); err != nil {
+			return nil, err
+		}
+	}
+
+	if step.DetailedDiff != nil {
+		result.DetailedDiff = make(map[string]*pulumirpc.PropertyDiff)
+		for path, diff := range step.DetailedDiff {
+			var kind pulumirpc.PropertyDiff_Kind
+			switch diff.Kind {
+			case DiffAdd:
+				kind = pulumirpc.PropertyDiff_ADD
+			case DiffAddReplace:
+				kind = pulumirpc.PropertyDiff_ADD_REPLACE
+			case DiffDelete:
+				kind = pulumirpc.PropertyDiff_DELETE
+			case DiffDeleteReplace:
+				kind = pulumirpc.PropertyDiff_DELETE_REPLACE
+			case DiffUpdate:
+				kind = pulumirpc.PropertyDiff_UPDATE
+			case DiffUpdateReplace:
+				kind = pulumirpc.PropertyDiff_UPDATE_REPLACE
+			}
+			result.DetailedDiff[path] = &pulumirpc.PropertyDiff{Kind: kind, InputDiff: diff.InputDiff}
+		}
+	}
+	return result, nil
+}
+
 func marshalEnforcementLevel(el apitype.EnforcementLevel) pulumirpc.EnforcementLevel {
 	switch el {
 	case apitype.Advisory:
@@ -471,6 +611,8 @@ func marshalEnforcementLevel(el apitype.EnforcementLevel) pulumirpc.EnforcementL
 		return pulumirpc.EnforcementLevel_MANDATORY
 	case apitype.Disabled:
 		return pulumirpc.EnforcementLevel_DISABLED
+	case apitype.Remediate:
+		return pulumirpc.EnforcementLevel_REMEDIATE
 	}
 	contract.Failf("Unrecognized enforcement level %s", el)
 	return 0
@@ -582,6 +724,14 @@ func convertURNs(urns []resource.URN) []string {
 	return result
 }
 
+func convertPropertyKeys(keys []resource.PropertyKey) []string {
+	result := make([]string, len(keys))
+	for idx := range keys {
+		result[idx] = string(keys[idx])
+	}
+	return result
+}
+
 func convertEnforcementLevel(el pulumirpc.EnforcementLevel) (apitype.EnforcementLevel, error) {
 	switch el {
 	case pulumirpc.EnforcementLevel_ADVISORY:
@@ -590,6 +740,8 @@ func convertEnforcementLevel(el pulumirpc.EnforcementLevel) (apitype.Enforcement
 		return apitype.Mandatory, nil
 	case pulumirpc.EnforcementLevel_DISABLED:
 		return apitype.Disabled, nil
+	case pulumirpc.EnforcementLevel_REMEDIATE:
+		return apitype.Remediate, nil
 
 	default:
 		return "", fmt.Errorf("Invalid enforcement level %d", el)
@@ -644,6 +796,38 @@ func convertDiagnostics(protoDiagnostics []*pulumirpc.AnalyzeDiagnostic, version
 	return diagnostics, nil
 }
 
+func convertRemediations(protoRemediations []*pulumirpc.Remediation, version string) ([]Remediation, error) {
+	remediations := make([]Remediation, len(protoRemediations))
+	for idx, protoR := range protoRemediations {
+		// The version from PulumiPolicy.yaml is used, if set, over the version from the remediation.
+		policyPackVersion := protoR.PolicyPackVersion
+		if version != "" {
+			policyPackVersion = version
+		}
+
+		var props resource.PropertyMap
+		if protoR.Properties != nil {
+			var err error
+			props, err = UnmarshalProperties(protoR.Properties,
+				MarshalOptions{KeepUnknowns: true, KeepSecrets: true, SkipInternalKeys: true})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		remediations[idx] = Remediation{
+			PolicyName:        protoR.PolicyName,
+			PolicyPackName:    protoR.PolicyPackName,
+			PolicyPackVersion: policyPackVersion,
+			Description:       protoR.Description,
+			Properties:        props,
+			Diagnostic:        protoR.Diagnostic,
+		}
+	}
+
+	return remediations, nil
+}
+
 // constructEnv creates a slice of key/value pairs to be used as the environment for the policy pack process. Each entry
 // is of the form "key=value". Config is passed as an environment variable (including unecrypted secrets), similar to
 // how config is passed to each language runtime plugin.
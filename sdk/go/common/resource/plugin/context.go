@@ -23,6 +23,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/diag/colors"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/rpcutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
 // Context is used to group related operations together so that
@@ -35,6 +36,9 @@ type Context struct {
 	Pwd        string    // the working directory to spawn all plugins in.
 	Root       string    // the root directory of the project.
 
+	// ProjectPlugins are the local plugins that override the installed plugins for the project.
+	ProjectPlugins []workspace.ProjectPlugin
+
 	tracingSpan opentracing.Span // the OpenTracing span to parent requests within.
 }
 
@@ -67,6 +71,7 @@ func NewContextWithRoot(d, statusD diag.Sink, host Host, cfg ConfigSource,
 		StatusDiag:  statusD,
 		Host:        host,
 		Pwd:         pwd,
+		Root:        root,
 		tracingSpan: parentSpan,
 	}
 	if host == nil {
@@ -45,8 +45,8 @@ type langhost struct {
 func NewLanguageRuntime(host Host, ctx *Context, runtime string,
 	options map[string]interface{}) (LanguageRuntime, error) {
 
-	_, path, err := workspace.GetPluginPath(
-		workspace.LanguagePlugin, strings.Replace(runtime, tokens.QNameDelimiter, "_", -1), nil)
+	_, path, err := workspace.GetPluginPathWithOverrides(
+		workspace.LanguagePlugin, strings.Replace(runtime, tokens.QNameDelimiter, "_", -1), nil, ctx.ProjectPlugins)
 	if err != nil {
 		return nil, err
 	} else if path == "" {
@@ -56,17 +56,10 @@ func NewLanguageRuntime(host Host, ctx *Context, runtime string,
 		})
 	}
 
-	var args []string
-	for k, v := range options {
-		args = append(args, fmt.Sprintf("-%s=%v", k, v))
-	}
-
-	root, err := filepath.Abs(ctx.Root)
+	args, err := languageRuntimeArgs(ctx, options)
 	if err != nil {
 		return nil, err
 	}
-	args = append(args, fmt.Sprintf("-root=%s", filepath.Clean(root)))
-
 	args = append(args, host.ServerAddr())
 
 	plug, err := newPlugin(ctx, ctx.Pwd, path, runtime, args, nil /*env*/)
@@ -83,6 +76,22 @@ func NewLanguageRuntime(host Host, ctx *Context, runtime string,
 	}, nil
 }
 
+// languageRuntimeArgs returns the flags passed to a language runtime plugin. The `-root` flag is the absolute path of
+// the context's project root, i.e. the directory that contains Pulumi.yaml, which may differ from the directory the
+// plugin is spawned in.
+func languageRuntimeArgs(ctx *Context, options map[string]interface{}) ([]string, error) {
+	var args []string
+	for k, v := range options {
+		args = append(args, fmt.Sprintf("-%s=%v", k, v))
+	}
+
+	root, err := filepath.Abs(ctx.Root)
+	if err != nil {
+		return nil, err
+	}
+	return append(args, fmt.Sprintf("-root=%s", filepath.Clean(root))), nil
+}
+
 func NewLanguageRuntimeClient(ctx *Context, runtime string, client pulumirpc.LanguageRuntimeClient) LanguageRuntime {
 	return &langhost{
 		ctx:     ctx,
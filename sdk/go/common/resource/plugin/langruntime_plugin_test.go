@@ -0,0 +1,59 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nopHost is a Host that is never called; it keeps NewContextWithRoot from starting a default host.
+type nopHost struct {
+	Host
+}
+
+func TestLanguageRuntimeArgsUseProjectRoot(t *testing.T) {
+	root, err := ioutil.TempDir("", "langhost-root")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	// The language host is spawned in a subdirectory of the project, but must be told the project root.
+	pwd := filepath.Join(root, "infra")
+	ctx, err := NewContextWithRoot(nil, nil, nopHost{}, nil, pwd, root, nil, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, root, ctx.Root)
+
+	args, err := languageRuntimeArgs(ctx, map[string]interface{}{"typescript": true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-typescript=true", "-root=" + filepath.Clean(root)}, args)
+}
+
+func TestLanguageRuntimeArgsDefaultToWorkingDirectory(t *testing.T) {
+	// Contexts without a project root pass the absolute working directory, as before.
+	ctx, err := NewContext(nil, nil, nopHost{}, nil, "", nil, false, nil)
+	require.NoError(t, err)
+
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	args, err := languageRuntimeArgs(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-root=" + filepath.Clean(cwd)}, args)
+}
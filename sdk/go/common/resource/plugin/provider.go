@@ -81,6 +81,13 @@ type Provider interface {
 
 	// Invoke dynamically executes a built-in function in the provider.
 	Invoke(tok tokens.ModuleMember, args resource.PropertyMap) (resource.PropertyMap, []CheckFailure, error)
+	// Call dynamically executes a method in the provider associated with a component resource.
+	Call(tok tokens.ModuleMember, args resource.PropertyMap, info CallInfo,
+		options CallOptions) (CallResult, error)
+	// ListResources enumerates the existing resources that the provider is able to manage. If types is non-empty,
+	// only resources of those types are listed; if tags is non-empty, only resources that carry all of the given tags
+	// are listed. Providers that do not support listing resources return ErrListResourcesNotSupported.
+	ListResources(types []tokens.Type, tags map[string]string) ([]ListedResource, error)
 	// StreamInvoke dynamically executes a built-in function in the provider, which returns a stream
 	// of responses.
 	StreamInvoke(
@@ -253,3 +260,42 @@ type ConstructResult struct {
 	// The resources that each output property depends on.
 	OutputDependencies map[resource.PropertyKey][]resource.URN
 }
+
+// CallInfo contains all of the information required to register resources as part of a call to Call.
+type CallInfo struct {
+	Project        string                // the project name housing the program being run.
+	Stack          string                // the stack name being evaluated.
+	Config         map[config.Key]string // the configuration variables to apply before running.
+	DryRun         bool                  // true if we are performing a dry-run (preview).
+	Parallel       int                   // the degree of parallelism for resource operations (<=1 for serial).
+	MonitorAddress string                // the RPC address to the host resource monitor.
+}
+
+// CallOptions captures options for a call to Call.
+type CallOptions struct {
+	// ArgDependencies is a map from argument keys to a list of resources that the argument depends on.
+	ArgDependencies map[resource.PropertyKey][]resource.URN
+}
+
+// CallResult is the result of a call to Call.
+type CallResult struct {
+	// The returned values, if the call was successful.
+	Return resource.PropertyMap
+	// A map from return value keys to the dependencies of the return value.
+	ReturnDependencies map[resource.PropertyKey][]resource.URN
+	// The failures if any arguments didn't pass verification.
+	Failures []CheckFailure
+}
+
+// ErrListResourcesNotSupported is returned by providers that are unable to enumerate their resources.
+var ErrListResourcesNotSupported = errors.New("the provider does not support listing resources")
+
+// ListedResource describes an existing resource that was enumerated by a call to ListResources.
+type ListedResource struct {
+	Type       tokens.Type          // the type token of the resource.
+	ID         resource.ID          // the ID of the resource, suitable for import.
+	Name       string               // an optional suggested name for the resource.
+	Properties resource.PropertyMap // the current state of the resource.
+	Tags       map[string]string    // the tags applied to the resource, if any.
+	Parent     resource.ID          // the ID of the resource that contains this resource, if any.
+}
@@ -72,8 +72,8 @@ type provider struct {
 func NewProvider(host Host, ctx *Context, pkg tokens.Package, version *semver.Version,
 	options map[string]interface{}, disableProviderPreview bool) (Provider, error) {
 	// Load the plugin's path by using the standard workspace logic.
-	_, path, err := workspace.GetPluginPath(
-		workspace.ResourcePlugin, strings.Replace(string(pkg), tokens.QNameDelimiter, "_", -1), version)
+	_, path, err := workspace.GetPluginPathWithOverrides(
+		workspace.ResourcePlugin, strings.Replace(string(pkg), tokens.QNameDelimiter, "_", -1), version, ctx.ProjectPlugins)
 	if err != nil {
 		return nil, err
 	} else if path == "" {
@@ -1209,6 +1209,156 @@ func (p *provider) Invoke(tok tokens.ModuleMember, args resource.PropertyMap) (r
 	return ret, failures, nil
 }
 
+// Call dynamically executes a method in the provider associated with a component resource.
+func (p *provider) Call(tok tokens.ModuleMember, args resource.PropertyMap, info CallInfo,
+	options CallOptions) (CallResult, error) {
+	contract.Assert(tok != "")
+
+	label := fmt.Sprintf("%s.Call(%s)", p.label(), tok)
+	logging.V(7).Infof("%s executing (#args=%d)", label, len(args))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient()
+	if err != nil {
+		return CallResult{}, err
+	}
+
+	// If the provider is not fully configured, return an empty property map.
+	if !p.cfgknown {
+		return CallResult{}, nil
+	}
+
+	if !p.acceptSecrets {
+		return CallResult{}, fmt.Errorf("plugins that can call methods must support secrets")
+	}
+
+	margs, err := MarshalProperties(args, MarshalOptions{
+		Label:         fmt.Sprintf("%s.args", label),
+		KeepUnknowns:  true,
+		KeepSecrets:   p.acceptSecrets,
+		KeepResources: p.acceptResources,
+	})
+	if err != nil {
+		return CallResult{}, err
+	}
+
+	// Marshal the arg dependencies.
+	argDependencies := map[string]*pulumirpc.CallRequest_ArgumentDependencies{}
+	for name, dependencies := range options.ArgDependencies {
+		urns := make([]string, len(dependencies))
+		for i, urn := range dependencies {
+			urns[i] = string(urn)
+		}
+		argDependencies[string(name)] = &pulumirpc.CallRequest_ArgumentDependencies{Urns: urns}
+	}
+
+	// Marshal the config.
+	config := map[string]string{}
+	for k, v := range info.Config {
+		config[k.String()] = v
+	}
+
+	resp, err := client.Call(p.requestContext(), &pulumirpc.CallRequest{
+		Tok:             string(tok),
+		Args:            margs,
+		ArgDependencies: argDependencies,
+		Project:         info.Project,
+		Stack:           info.Stack,
+		Config:          config,
+		DryRun:          info.DryRun,
+		Parallel:        int32(info.Parallel),
+		MonitorEndpoint: info.MonitorAddress,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		return CallResult{}, rpcError
+	}
+
+	// Unmarshal any return values.
+	ret, err := UnmarshalProperties(resp.GetReturn(), MarshalOptions{
+		Label:         fmt.Sprintf("%s.returns", label),
+		KeepUnknowns:  info.DryRun,
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return CallResult{}, err
+	}
+
+	returnDependencies := map[resource.PropertyKey][]resource.URN{}
+	for k, rpcDeps := range resp.GetReturnDependencies() {
+		urns := make([]resource.URN, len(rpcDeps.Urns))
+		for i, d := range rpcDeps.Urns {
+			urns[i] = resource.URN(d)
+		}
+		returnDependencies[resource.PropertyKey(k)] = urns
+	}
+
+	// And now any properties that failed verification.
+	var failures []CheckFailure
+	for _, failure := range resp.GetFailures() {
+		failures = append(failures, CheckFailure{resource.PropertyKey(failure.Property), failure.Reason})
+	}
+
+	logging.V(7).Infof("%s success (#ret=%d,#failures=%d) success", label, len(ret), len(failures))
+	return CallResult{Return: ret, ReturnDependencies: returnDependencies, Failures: failures}, nil
+}
+
+// ListResources enumerates the existing resources that the provider is able to manage.
+func (p *provider) ListResources(types []tokens.Type, tags map[string]string) ([]ListedResource, error) {
+	label := fmt.Sprintf("%s.ListResources()", p.label())
+	logging.V(7).Infof("%s executing (#types=%d,#tags=%d)", label, len(types), len(tags))
+
+	// Get the RPC client and ensure it's configured.
+	client, err := p.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcTypes := make([]string, len(types))
+	for i, t := range types {
+		rpcTypes[i] = string(t)
+	}
+
+	resp, err := client.ListResources(p.requestContext(), &pulumirpc.ListResourcesRequest{
+		Types: rpcTypes,
+		Tags:  tags,
+	})
+	if err != nil {
+		rpcError := rpcerror.Convert(err)
+		if rpcError.Code() == codes.Unimplemented {
+			logging.V(7).Infof("%s unimplemented rpc", label)
+			return nil, ErrListResourcesNotSupported
+		}
+		logging.V(7).Infof("%s failed: %v", label, rpcError.Message())
+		return nil, rpcError
+	}
+
+	listed := make([]ListedResource, len(resp.GetResources()))
+	for i, r := range resp.GetResources() {
+		props, err := UnmarshalProperties(r.GetProperties(), MarshalOptions{
+			Label:         fmt.Sprintf("%s.properties(%s)", label, r.GetId()),
+			KeepSecrets:   true,
+			KeepResources: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		listed[i] = ListedResource{
+			Type:       tokens.Type(r.GetType()),
+			ID:         resource.ID(r.GetId()),
+			Name:       r.GetName(),
+			Properties: props,
+			Tags:       r.GetTags(),
+			Parent:     resource.ID(r.GetParent()),
+		}
+	}
+
+	logging.V(7).Infof("%s success (#resources=%d)", label, len(listed))
+	return listed, nil
+}
+
 // StreamInvoke dynamically executes a built-in function in the provider, which returns a stream of
 // responses.
 func (p *provider) StreamInvoke(
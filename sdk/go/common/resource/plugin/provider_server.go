@@ -495,6 +495,107 @@ func (p *providerServer) Invoke(ctx context.Context, req *pulumirpc.InvokeReques
 	}, nil
 }
 
+func (p *providerServer) Call(ctx context.Context, req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+	args, err := UnmarshalProperties(req.GetArgs(), p.unmarshalOptions("args"))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := map[config.Key]string{}
+	for k, v := range req.GetConfig() {
+		configKey, err := config.ParseKey(k)
+		if err != nil {
+			return nil, err
+		}
+		cfg[configKey] = v
+	}
+	info := CallInfo{
+		Project:        req.GetProject(),
+		Stack:          req.GetStack(),
+		Config:         cfg,
+		DryRun:         req.GetDryRun(),
+		Parallel:       int(req.GetParallel()),
+		MonitorAddress: req.GetMonitorEndpoint(),
+	}
+
+	argDependencies := map[resource.PropertyKey][]resource.URN{}
+	for name, deps := range req.GetArgDependencies() {
+		urns := make([]resource.URN, len(deps.Urns))
+		for i, urn := range deps.Urns {
+			urns[i] = resource.URN(urn)
+		}
+		argDependencies[resource.PropertyKey(name)] = urns
+	}
+	options := CallOptions{
+		ArgDependencies: argDependencies,
+	}
+
+	result, err := p.provider.Call(tokens.ModuleMember(req.GetTok()), args, info, options)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResult, err := MarshalProperties(result.Return, p.marshalOptions("result"))
+	if err != nil {
+		return nil, err
+	}
+
+	returnDependencies := map[string]*pulumirpc.CallResponse_ReturnDependencies{}
+	for name, deps := range result.ReturnDependencies {
+		urns := make([]string, len(deps))
+		for i, urn := range deps {
+			urns[i] = string(urn)
+		}
+		returnDependencies[string(name)] = &pulumirpc.CallResponse_ReturnDependencies{Urns: urns}
+	}
+
+	rpcFailures := make([]*pulumirpc.CheckFailure, len(result.Failures))
+	for i, f := range result.Failures {
+		rpcFailures[i] = &pulumirpc.CheckFailure{Property: string(f.Property), Reason: f.Reason}
+	}
+
+	return &pulumirpc.CallResponse{
+		Return:             rpcResult,
+		ReturnDependencies: returnDependencies,
+		Failures:           rpcFailures,
+	}, nil
+}
+
+func (p *providerServer) ListResources(ctx context.Context,
+	req *pulumirpc.ListResourcesRequest) (*pulumirpc.ListResourcesResponse, error) {
+
+	types := make([]tokens.Type, len(req.GetTypes()))
+	for i, t := range req.GetTypes() {
+		types[i] = tokens.Type(t)
+	}
+
+	listed, err := p.provider.ListResources(types, req.GetTags())
+	if err != nil {
+		if err == ErrListResourcesNotSupported {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		return nil, err
+	}
+
+	rpcResources := make([]*pulumirpc.ListResourcesResponse_ListedResource, len(listed))
+	for i, r := range listed {
+		rpcProperties, err := MarshalProperties(r.Properties, p.marshalOptions("properties"))
+		if err != nil {
+			return nil, err
+		}
+		rpcResources[i] = &pulumirpc.ListResourcesResponse_ListedResource{
+			Type:       string(r.Type),
+			Id:         string(r.ID),
+			Name:       r.Name,
+			Properties: rpcProperties,
+			Tags:       r.Tags,
+			Parent:     string(r.Parent),
+		}
+	}
+
+	return &pulumirpc.ListResourcesResponse{Resources: rpcResources}, nil
+}
+
 func (p *providerServer) StreamInvoke(req *pulumirpc.InvokeRequest,
 	server pulumirpc.ResourceProvider_StreamInvokeServer) error {
 
@@ -37,6 +37,7 @@ type Goal struct {
 	Aliases                 []URN                 // additional URNs that should be aliased to this resource.
 	ID                      ID                    // the expected ID of the resource, if any.
 	CustomTimeouts          CustomTimeouts        // an optional config object for resource options
+	SourcePosition          *SourcePosition       // the position of the code that registered the resource, if known.
 }
 
 // NewGoal allocates a new resource goal state.
@@ -0,0 +1,32 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import "fmt"
+
+// SourcePosition is a position in the source code of a program, e.g. the position of the code that registered a
+// resource.
+type SourcePosition struct {
+	URI    string // the URI of the source file. Files on the local filesystem use the file scheme.
+	Line   int    // the line number, starting from 1.
+	Column int    // the column number, starting from 1, or zero if unknown.
+}
+
+func (p SourcePosition) String() string {
+	if p.Column == 0 {
+		return fmt.Sprintf("%s:%d", p.URI, p.Line)
+	}
+	return fmt.Sprintf("%s:%d:%d", p.URI, p.Line, p.Column)
+}
@@ -61,10 +61,12 @@ func TGZ(dir, prefixPathInsideTar string, useDefaultExcludes bool) ([]byte, erro
 }
 
 func extractFile(r *tar.Reader, header *tar.Header, dir string) error {
-	// TODO: check the name to ensure that it does not contain path traversal characters.
-	//
-	//nolint: gosec
-	path := filepath.Join(dir, header.Name)
+	// Archives may come from untrusted sources, so refuse any entry that would be written outside of dir.
+	path := filepath.Join(dir, header.Name) //nolint: gosec
+	if rel, err := filepath.Rel(dir, path); err != nil || rel == ".." ||
+		strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return errors.Errorf("archive entry %s is outside of the destination directory", header.Name)
+	}
 
 	switch header.Typeflag {
 	case tar.TypeDir:
@@ -97,6 +99,9 @@ func extractFile(r *tar.Reader, header *tar.Header, dir string) error {
 		if _, err = io.Copy(dst, r); err != nil {
 			return errors.Wrapf(err, "untarring file %s", path)
 		}
+	case tar.TypeSymlink, tar.TypeLink:
+		// Links could point outside of the destination directory, so they are never extracted.
+		return errors.Errorf("archive entry %s is a link, which is not supported", header.Name)
 	default:
 		return errors.Errorf("unexpected plugin file type %s (%v)", header.Name, header.Typeflag)
 	}
@@ -81,6 +81,53 @@ func TestIgnoreContentOfDotGit(t *testing.T) {
 		fileContents{name: "requirements.txt", shouldRetain: true})
 }
 
+func TestExtractTGZRejectsUnsafeEntries(t *testing.T) {
+	tests := []struct {
+		name   string
+		header tar.Header
+	}{
+		{"parent directory", tar.Header{Name: "../evil.txt", Typeflag: tar.TypeReg, Mode: 0600}},
+		{"nested parent directory", tar.Header{Name: "a/../../evil.txt", Typeflag: tar.TypeReg, Mode: 0600}},
+		{"symlink", tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc/passwd"}},
+		{"hardlink", tar.Header{Name: "link", Typeflag: tar.TypeLink, Linkname: "../evil.txt"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			gw := gzip.NewWriter(&buffer)
+			w := tar.NewWriter(gw)
+			assert.NoError(t, w.WriteHeader(&tt.header))
+			assert.NoError(t, w.Close())
+			assert.NoError(t, gw.Close())
+
+			dir, err := ioutil.TempDir("", "archive-test")
+			assert.NoError(t, err)
+			defer os.RemoveAll(dir)
+			dest := filepath.Join(dir, "dest")
+
+			assert.Error(t, ExtractTGZ(&buffer, dest))
+			_, err = os.Lstat(filepath.Join(dir, "evil.txt"))
+			assert.True(t, os.IsNotExist(err))
+			_, err = os.Lstat(filepath.Join(dest, "link"))
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+
+	// Names that merely contain dots are extracted as usual.
+	var buffer bytes.Buffer
+	gw := gzip.NewWriter(&buffer)
+	w := tar.NewWriter(gw)
+	assert.NoError(t, w.WriteHeader(&tar.Header{Name: "a..b/..c", Typeflag: tar.TypeReg, Mode: 0600}))
+	assert.NoError(t, w.Close())
+	assert.NoError(t, gw.Close())
+
+	dir, err := ioutil.TempDir("", "archive-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, ExtractTGZ(&buffer, dir))
+	assert.FileExists(t, filepath.Join(dir, "a..b", "..c"))
+}
+
 func doArchiveTest(t *testing.T, files ...fileContents) {
 	doTest := func(prefixPathInsideTar string) {
 		tarball, err := archiveContents(prefixPathInsideTar, files...)
@@ -27,6 +27,7 @@ import (
 	git "gopkg.in/src-d/go-git.v4"
 	"gopkg.in/src-d/go-git.v4/config"
 	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
 	"gopkg.in/src-d/go-git.v4/storage/memory"
 )
 
@@ -254,6 +255,14 @@ func GitCloneAndCheckoutCommit(url string, commit plumbing.Hash, path string) er
 
 // GitCloneOrPull clones or updates the specified referenceName (branch or tag) of a Git repository.
 func GitCloneOrPull(url string, referenceName plumbing.ReferenceName, path string, shallow bool) error {
+	return GitCloneOrPullWithAuth(url, referenceName, path, shallow, nil)
+}
+
+// GitCloneOrPullWithAuth clones or updates the specified referenceName (branch or tag) of a Git repository using the
+// given authentication method. If auth is nil, SSH URLs are authenticated with the SSH agent.
+func GitCloneOrPullWithAuth(url string, referenceName plumbing.ReferenceName, path string, shallow bool,
+	auth transport.AuthMethod) error {
+
 	// For shallow clones, use a depth of 1.
 	depth := 0
 	if shallow {
@@ -263,6 +272,7 @@ func GitCloneOrPull(url string, referenceName plumbing.ReferenceName, path strin
 	// Attempt to clone the repo.
 	_, cloneErr := git.PlainClone(path, false, &git.CloneOptions{
 		URL:           url,
+		Auth:          auth,
 		ReferenceName: referenceName,
 		SingleBranch:  true,
 		Depth:         depth,
@@ -282,6 +292,7 @@ func GitCloneOrPull(url string, referenceName plumbing.ReferenceName, path strin
 			}
 
 			if err = w.Pull(&git.PullOptions{
+				Auth:          auth,
 				ReferenceName: referenceName,
 				SingleBranch:  true,
 				Force:         true,
@@ -428,6 +439,12 @@ func GetGitReferenceNameOrHashAndSubDirectory(url string, urlPath string) (
 // GitListBranchesAndTags fetches a remote Git repository's branch and tag references
 // (including HEAD), sorted by the length of the short name descending.
 func GitListBranchesAndTags(url string) ([]plumbing.ReferenceName, error) {
+	return GitListBranchesAndTagsWithAuth(url, nil)
+}
+
+// GitListBranchesAndTagsWithAuth fetches a remote Git repository's branch and tag references (including HEAD) using
+// the given authentication method, sorted by the length of the short name descending.
+func GitListBranchesAndTagsWithAuth(url string, auth transport.AuthMethod) ([]plumbing.ReferenceName, error) {
 	// We're only listing the references, so just use in-memory storage.
 	repo, err := git.Init(memory.NewStorage(), nil)
 	if err != nil {
@@ -442,7 +459,7 @@ func GitListBranchesAndTags(url string) ([]plumbing.ReferenceName, error) {
 		return nil, err
 	}
 
-	refs, err := remote.List(&git.ListOptions{})
+	refs, err := remote.List(&git.ListOptions{Auth: auth})
 	if err != nil {
 		return nil, err
 	}
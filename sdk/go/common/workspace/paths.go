@@ -51,6 +51,8 @@ const (
 	TemplateDir = "templates"
 	// TemplatePolicyDir is the name of the directory containing templates for Policy Packs.
 	TemplatePolicyDir = "templates-policy"
+	// TemplateSourcesDir is the name of the directory containing clones of Git template sources.
+	TemplateSourcesDir = "template-sources"
 	// WorkspaceDir is the name of the directory that holds workspace information for projects.
 	WorkspaceDir = "workspaces"
 
@@ -65,6 +67,12 @@ const (
 	WorkspaceFile = "workspace.json"
 	// CachedVersionFile is the name of the file we use to store when we last checked if the CLI was out of date
 	CachedVersionFile = ".cachedVersionInfo"
+	// PluginSettingsFile is the name of the file that holds settings for downloading plugins.
+	PluginSettingsFile = "plugins.json"
+	// PluginReferencesFile is the name of the file that records the installed plugins used by each project.
+	PluginReferencesFile = "plugin-references.json"
+	// TemplateSettingsFile is the name of the file that holds settings for retrieving templates.
+	TemplateSettingsFile = "templates.json"
 
 	// PulumiHomeEnvVar is a path to the '.pulumi' folder with plugins, access token, etc.
 	// The folder can have any name, not necessarily '.pulumi'.
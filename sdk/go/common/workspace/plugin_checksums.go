@@ -0,0 +1,269 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+const (
+	// PluginSigningKeyEnvVar is an environment variable that holds the base64-encoded Ed25519 public key used to
+	// verify the signatures of plugin checksum manifests. If set, plugins are only downloaded from servers that
+	// publish a checksum manifest signed with this key.
+	PluginSigningKeyEnvVar = "PULUMI_PLUGIN_SIGNING_KEY"
+	// PluginRequireChecksumsEnvVar is an environment variable that, if truthy, requires that plugins are only
+	// downloaded from servers that publish a checksum manifest.
+	PluginRequireChecksumsEnvVar = "PULUMI_PLUGIN_REQUIRE_CHECKSUMS"
+
+	// PluginChecksumFile is the name of the file in a plugin's directory that records the SHA-256 checksum of the
+	// tarball the plugin was installed from.
+	PluginChecksumFile = ".checksum.sha256"
+)
+
+// ChecksumMismatchError is returned when a plugin tarball does not match its expected checksum.
+type ChecksumMismatchError struct {
+	// Info contains information about the plugin whose tarball did not match.
+	Info PluginInfo
+	// Expected is the expected SHA-256 checksum of the tarball.
+	Expected string
+	// Actual is the SHA-256 checksum of the tarball.
+	Actual string
+}
+
+func (err *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch for %s plugin %s: expected sha256:%s, got sha256:%s",
+		err.Info.Kind, err.Info, err.Expected, err.Actual)
+}
+
+// checksumManifestName returns the name of the checksum manifest published alongside this plugin's tarballs.
+func (info PluginInfo) checksumManifestName() string {
+	return fmt.Sprintf("pulumi-%s-%s-v%s-checksums.txt", info.Kind, info.Name, info.Version)
+}
+
+// InstalledChecksum returns the SHA-256 checksum of the tarball this plugin was installed from, or an empty string if
+// the plugin was installed before checksums were recorded.
+func (info PluginInfo) InstalledChecksum() (string, error) {
+	dir, err := info.DirPath()
+	if err != nil {
+		return "", err
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, PluginChecksumFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// downloadChecksum fetches the checksum manifest published alongside the given tarball by a plugin source and returns
+// the tarball's checksum. If the source does not publish a manifest, an empty checksum is returned unless checksums
+// are required. If a signing key is configured, the manifest's signature is verified as well.
+func (info PluginInfo) downloadChecksum(source, tarball string) (string, error) {
+	key, err := pluginSigningKey()
+	if err != nil {
+		return "", err
+	}
+	required := key != nil || cmdutil.IsTruthy(os.Getenv(PluginRequireChecksumsEnvVar))
+
+	manifestName := info.checksumManifestName()
+	manifest, found, err := readPluginSourceFile(source, manifestName)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		if required {
+			return "", errors.Errorf("%s plugin %s has no checksum manifest %s at %s",
+				info.Kind, info, manifestName, source)
+		}
+		logging.V(1).Infof("%s has no checksum manifest at %s; skipping checksum verification", info.Name, source)
+		return "", nil
+	}
+
+	if key != nil {
+		signatureName := manifestName + ".sig"
+		signature, found, err := readPluginSourceFile(source, signatureName)
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			return "", errors.Errorf("%s plugin %s has no checksum manifest signature %s at %s",
+				info.Kind, info, signatureName, source)
+		}
+		if err = verifyChecksumManifest(manifest, signature, key); err != nil {
+			return "", errors.Wrapf(err, "verifying checksum manifest for %s plugin %s", info.Kind, info)
+		}
+	}
+
+	checksums, err := parseChecksumManifest(manifest)
+	if err != nil {
+		return "", errors.Wrapf(err, "parsing checksum manifest %s from %s", manifestName, source)
+	}
+	checksum, ok := checksums[tarball]
+	if !ok {
+		return "", errors.Errorf("checksum manifest %s from %s does not list %s", manifestName, source, tarball)
+	}
+	return checksum, nil
+}
+
+// pluginSigningKey returns the configured public key for plugin checksum manifests, if any.
+func pluginSigningKey() (ed25519.PublicKey, error) {
+	encoded := strings.TrimSpace(os.Getenv(PluginSigningKeyEnvVar))
+	if encoded == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, errors.Errorf("%s must be a base64-encoded Ed25519 public key", PluginSigningKeyEnvVar)
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// verifyChecksumManifest verifies an Ed25519 signature over a checksum manifest. The signature may be either raw or
+// base64-encoded.
+func verifyChecksumManifest(manifest, signature []byte, key ed25519.PublicKey) error {
+	if len(signature) != ed25519.SignatureSize {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+		if err != nil {
+			return errors.New("malformed signature")
+		}
+		signature = decoded
+	}
+	if !ed25519.Verify(key, manifest, signature) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// parseChecksumManifest parses a checksum manifest in the format written by `sha256sum`, i.e. lines of the form
+// `<checksum>  <file>`, into a map from file names to checksums.
+func parseChecksumManifest(manifest []byte) (map[string]string, error) {
+	checksums := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(manifest))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, errors.Errorf("line %d: expected a checksum and a file name", line)
+		}
+		checksum, file := strings.ToLower(fields[0]), strings.TrimPrefix(fields[1], "*")
+		if b, err := hex.DecodeString(checksum); err != nil || len(b) != sha256.Size {
+			return nil, errors.Errorf("line %d: %q is not a SHA-256 checksum", line, fields[0])
+		}
+		checksums[file] = checksum
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return checksums, nil
+}
+
+// stagePluginTarball copies a plugin tarball to a temporary file and returns the file, positioned at its start, along
+// with the tarball's SHA-256 checksum. The caller is responsible for closing and removing the file.
+func stagePluginTarball(tgz io.Reader) (*os.File, string, error) {
+	f, err := ioutil.TempFile("", "pulumi-plugin-")
+	if err != nil {
+		return nil, "", err
+	}
+
+	hash := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(f, hash), tgz); err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		contract.IgnoreClose(f)
+		contract.IgnoreError(os.Remove(f.Name()))
+		return nil, "", err
+	}
+	return f, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// checksumReader reads a plugin's tarball and verifies its SHA-256 checksum. The read that reaches the end of the
+// tarball returns a ChecksumMismatchError if the checksum does not match.
+type checksumReader struct {
+	io.ReadCloser
+
+	info     PluginInfo
+	expected string
+	hash     hash.Hash
+}
+
+func newChecksumReader(r io.ReadCloser, info PluginInfo, expected string) io.ReadCloser {
+	return &checksumReader{ReadCloser: r, info: info, expected: expected, hash: sha256.New()}
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.hash.Write(p[:n])
+	if err == io.EOF {
+		if actual := hex.EncodeToString(r.hash.Sum(nil)); !strings.EqualFold(r.expected, actual) {
+			return n, &ChecksumMismatchError{Info: r.info, Expected: strings.ToLower(r.expected), Actual: actual}
+		}
+	}
+	return n, err
+}
+
+// GetPluginChecksums returns the SHA-256 checksums of the plugin's tarballs for the given platforms, in the form
+// <os>-<arch>. Each checksum is read from the checksum manifest published by the first plugin source that has the
+// tarball, or computed by downloading the tarball if the source does not publish a manifest.
+func GetPluginChecksums(info PluginInfo, platforms []string) (map[string]string, error) {
+	checksums := make(map[string]string, len(platforms))
+	for _, platform := range platforms {
+		parts := strings.Split(platform, "-")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid platform %q; expected <os>-<arch>, e.g. linux-amd64", platform)
+		}
+
+		download := info
+		download.Checksum = ""
+		stream, _, _, checksum, err := download.download(parts[0], parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if checksum == "" {
+			hash := sha256.New()
+			_, err = io.Copy(hash, stream)
+			checksum = hex.EncodeToString(hash.Sum(nil))
+		}
+		contract.IgnoreClose(stream)
+		if err != nil {
+			return nil, errors.Wrapf(err, "downloading %s plugin %s for %s", info.Kind, info, platform)
+		}
+		checksums[platform] = checksum
+	}
+	return checksums, nil
+}
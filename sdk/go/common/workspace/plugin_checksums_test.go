@@ -0,0 +1,148 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/archive"
+)
+
+// newChecksumTestServer serves a plugin tarball along with the given files, and returns the plugin to download.
+func newChecksumTestServer(t *testing.T, files map[string][]byte) (PluginInfo, []byte, map[string][]byte) {
+	src := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(src, "pulumi-resource-test"), nil, 0700))
+	tgz, err := archive.TGZ(src, "", true)
+	require.NoError(t, err)
+
+	version := semver.MustParse("1.2.3")
+	tarball := fmt.Sprintf("pulumi-resource-test-v1.2.3-%s-%s.tar.gz", runtime.GOOS, runtime.GOARCH)
+	if files == nil {
+		files = map[string][]byte{}
+	}
+	files[tarball] = tgz
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if b, ok := files[filepath.Base(r.URL.Path)]; ok {
+			_, err := w.Write(b)
+			assert.NoError(t, err)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	info := PluginInfo{
+		Name:      "test",
+		Kind:      ResourcePlugin,
+		Version:   &version,
+		ServerURL: server.URL,
+		PluginDir: t.TempDir(),
+	}
+	return info, tgz, files
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestPluginChecksumVerification(t *testing.T) {
+	info, tgz, files := newChecksumTestServer(t, nil)
+	checksum := sha256Hex(tgz)
+	manifest := []byte(
+		fmt.Sprintf("%s  pulumi-resource-test-v1.2.3-%s-%s.tar.gz\n", checksum, runtime.GOOS, runtime.GOARCH))
+	files["pulumi-resource-test-v1.2.3-checksums.txt"] = manifest
+
+	// A matching tarball is installed and its checksum recorded.
+	stream, _, err := info.Download()
+	require.NoError(t, err)
+	require.NoError(t, info.Install(stream))
+	installed, err := info.InstalledChecksum()
+	require.NoError(t, err)
+	assert.Equal(t, checksum, installed)
+	require.NoError(t, info.Delete())
+
+	// A tarball that does not match the manifest is refused.
+	files[filepath.Base(info.checksumManifestName())] = []byte(
+		fmt.Sprintf("%s  pulumi-resource-test-v1.2.3-%s-%s.tar.gz\n", sha256Hex(nil), runtime.GOOS, runtime.GOARCH))
+	stream, _, err = info.Download()
+	require.NoError(t, err)
+	err = info.Install(stream)
+	assert.IsType(t, &ChecksumMismatchError{}, errors.Cause(err))
+	_, err = os.Stat(filepath.Join(info.PluginDir, info.Dir()))
+	assert.True(t, os.IsNotExist(err))
+
+	// Signed manifests are verified with the configured key.
+	public, private, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	os.Setenv(PluginSigningKeyEnvVar, base64.StdEncoding.EncodeToString(public))
+	defer os.Unsetenv(PluginSigningKeyEnvVar)
+
+	files["pulumi-resource-test-v1.2.3-checksums.txt"] = manifest
+	_, _, err = info.Download()
+	assert.Error(t, err)
+
+	files["pulumi-resource-test-v1.2.3-checksums.txt.sig"] = ed25519.Sign(private, []byte("tampered"))
+	_, _, err = info.Download()
+	assert.Error(t, err)
+
+	files["pulumi-resource-test-v1.2.3-checksums.txt.sig"] = []byte(
+		base64.StdEncoding.EncodeToString(ed25519.Sign(private, manifest)))
+	stream, _, err = info.Download()
+	require.NoError(t, err)
+	assert.NoError(t, info.Install(stream))
+}
+
+func TestPluginChecksumManifestMissing(t *testing.T) {
+	info, _, _ := newChecksumTestServer(t, nil)
+
+	stream, _, err := info.Download()
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(stream)
+	assert.NoError(t, err)
+	require.NoError(t, stream.Close())
+
+	os.Setenv(PluginRequireChecksumsEnvVar, "true")
+	defer os.Unsetenv(PluginRequireChecksumsEnvVar)
+	_, _, err = info.Download()
+	assert.Error(t, err)
+}
+
+func TestParseChecksumManifest(t *testing.T) {
+	checksum := sha256Hex([]byte("plugin"))
+	checksums, err := parseChecksumManifest([]byte("# checksums\n" + checksum + " *plugin.tar.gz\n\n"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"plugin.tar.gz": checksum}, checksums)
+
+	_, err = parseChecksumManifest([]byte("abc plugin.tar.gz\n"))
+	assert.EqualError(t, err, `line 1: "abc" is not a SHA-256 checksum`)
+}
@@ -0,0 +1,142 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"sort"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
+)
+
+// PluginLockFile is the name of the file, next to a project's Pulumi.yaml, that records the exact plugins the project
+// uses.
+const PluginLockFile = "Pulumi.lock"
+
+// PluginLock records the exact version, source, and checksums of each plugin a project uses, so that every machine
+// that deploys the project uses the same plugins.
+type PluginLock struct {
+	Plugins []LockedPlugin `json:"plugins" yaml:"plugins"`
+}
+
+// LockedPlugin records the exact version, source, and checksums of a plugin.
+type LockedPlugin struct {
+	// Kind is the kind of the plugin.
+	Kind PluginKind `json:"kind" yaml:"kind"`
+	// Name is the name of the plugin.
+	Name string `json:"name" yaml:"name"`
+	// Version is the exact version of the plugin.
+	Version string `json:"version" yaml:"version"`
+	// Server is the URL of the server the plugin is downloaded from.
+	Server string `json:"server,omitempty" yaml:"server,omitempty"`
+	// Checksums maps platforms, in the form <os>-<arch>, to the SHA-256 checksum of the plugin's tarball for that
+	// platform.
+	Checksums map[string]string `json:"checksums,omitempty" yaml:"checksums,omitempty"`
+}
+
+// CurrentPlatform returns the platform of the running CLI, in the form used by LockedPlugin.Checksums.
+func CurrentPlatform() string {
+	return runtime.GOOS + "-" + runtime.GOARCH
+}
+
+// LoadPluginLock reads a plugin lock file.
+func LoadPluginLock(path string) (*PluginLock, error) {
+	b, err := readFileStripUTF8BOM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock PluginLock
+	if err = encoding.YAML.Unmarshal(b, &lock); err != nil {
+		return nil, errors.Wrapf(err, "reading %s", path)
+	}
+	for _, p := range lock.Plugins {
+		if !IsPluginKind(string(p.Kind)) {
+			return nil, errors.Errorf("%s: unrecognized kind %q for plugin %s", path, p.Kind, p.Name)
+		}
+		if _, err = semver.Parse(p.Version); err != nil {
+			return nil, errors.Wrapf(err, "%s: invalid version for %s plugin %s", path, p.Kind, p.Name)
+		}
+	}
+	return &lock, nil
+}
+
+// Save writes the plugin lock to a file, with its plugins sorted by kind and name.
+func (lock *PluginLock) Save(path string) error {
+	sort.Slice(lock.Plugins, func(i, j int) bool {
+		pi, pj := lock.Plugins[i], lock.Plugins[j]
+		if pi.Kind != pj.Kind {
+			return pi.Kind < pj.Kind
+		}
+		return pi.Name < pj.Name
+	})
+
+	b, err := encoding.YAML.Marshal(lock)
+	if err != nil {
+		return err
+	}
+	b = append([]byte("# This file is generated by `pulumi plugin lock`. Do not edit it by hand.\n"), b...)
+
+	// Changing the permissions on these file is ~ a breaking change, so disable golint.
+	//nolint: gosec
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+// Get returns the locked plugin of the given kind and name, if any.
+func (lock *PluginLock) Get(kind PluginKind, name string) (LockedPlugin, bool) {
+	if lock != nil {
+		for _, p := range lock.Plugins {
+			if p.Kind == kind && p.Name == name {
+				return p, true
+			}
+		}
+	}
+	return LockedPlugin{}, false
+}
+
+// Set adds or replaces the locked plugin with the same kind and name as the given plugin.
+func (lock *PluginLock) Set(plugin LockedPlugin) {
+	for i, p := range lock.Plugins {
+		if p.Kind == plugin.Kind && p.Name == plugin.Name {
+			lock.Plugins[i] = plugin
+			return
+		}
+	}
+	lock.Plugins = append(lock.Plugins, plugin)
+}
+
+// PluginInfo returns the plugin described by this locked plugin, with the checksum expected for the current platform.
+func (p LockedPlugin) PluginInfo() PluginInfo {
+	version := semver.MustParse(p.Version)
+	info := PluginInfo{
+		Kind:     p.Kind,
+		Name:     p.Name,
+		Version:  &version,
+		Checksum: p.Checksums[CurrentPlatform()],
+	}
+	if p.Server != DefaultPluginServerURL {
+		info.ServerURL = p.Server
+	}
+	return info
+}
+
+func (p LockedPlugin) String() string {
+	return fmt.Sprintf("%s plugin %s-%s", p.Kind, p.Name, p.Version)
+}
@@ -0,0 +1,80 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginLockRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PluginLockFile)
+
+	lock := &PluginLock{}
+	lock.Set(LockedPlugin{Kind: ResourcePlugin, Name: "random", Version: "4.0.0", Server: DefaultPluginServerURL,
+		Checksums: map[string]string{"linux-amd64": "aaaa"}})
+	lock.Set(LockedPlugin{Kind: AnalyzerPlugin, Name: "policy", Version: "1.0.0",
+		Server: "https://plugins.example.com"})
+	lock.Set(LockedPlugin{Kind: ResourcePlugin, Name: "aws", Version: "3.0.0"})
+	lock.Set(LockedPlugin{Kind: ResourcePlugin, Name: "random", Version: "4.1.0", Server: DefaultPluginServerURL,
+		Checksums: map[string]string{CurrentPlatform(): "bbbb"}})
+	require.NoError(t, lock.Save(path))
+
+	loaded, err := LoadPluginLock(path)
+	require.NoError(t, err)
+	require.Len(t, loaded.Plugins, 3)
+	assert.Equal(t, "policy", loaded.Plugins[0].Name)
+	assert.Equal(t, "aws", loaded.Plugins[1].Name)
+	assert.Equal(t, "random", loaded.Plugins[2].Name)
+
+	random, ok := loaded.Get(ResourcePlugin, "random")
+	require.True(t, ok)
+	assert.Equal(t, "4.1.0", random.Version)
+
+	info := random.PluginInfo()
+	assert.Equal(t, "4.1.0", info.Version.String())
+	assert.Equal(t, "bbbb", info.Checksum)
+	assert.Empty(t, info.ServerURL)
+
+	policy, ok := loaded.Get(AnalyzerPlugin, "policy")
+	require.True(t, ok)
+	assert.Equal(t, "https://plugins.example.com", policy.PluginInfo().ServerURL)
+
+	_, ok = loaded.Get(ResourcePlugin, "policy")
+	assert.False(t, ok)
+
+	var missing *PluginLock
+	_, ok = missing.Get(ResourcePlugin, "random")
+	assert.False(t, ok)
+}
+
+func TestLoadInvalidPluginLock(t *testing.T) {
+	dir := t.TempDir()
+
+	badKind := filepath.Join(dir, "kind.lock")
+	require.NoError(t, ioutil.WriteFile(badKind, []byte("plugins:\n- kind: bogus\n  name: a\n  version: 1.0.0\n"), 0600))
+	_, err := LoadPluginLock(badKind)
+	assert.Error(t, err)
+
+	badVersion := filepath.Join(dir, "version.lock")
+	badVersionLock := []byte("plugins:\n- kind: resource\n  name: a\n  version: v1\n")
+	require.NoError(t, ioutil.WriteFile(badVersion, badVersionLock, 0600))
+	_, err = LoadPluginLock(badVersion)
+	assert.Error(t, err)
+}
@@ -0,0 +1,204 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/fsutil"
+)
+
+// PluginCacheMaxSizeEnvVar is an environment variable that holds the maximum size of the plugin cache, e.g. `10GB`. If
+// set, it overrides the maxCacheSize in the plugin settings file.
+const PluginCacheMaxSizeEnvVar = "PULUMI_PLUGIN_CACHE_MAX_SIZE"
+
+// ProjectPluginReferences records the installed plugins that a project used when it was last deployed.
+type ProjectPluginReferences struct {
+	// LastUsedTime is the last time the project was deployed.
+	LastUsedTime time.Time `json:"lastUsedTime"`
+	// Plugins are the directory names of the installed plugins the project used, e.g. `resource-aws-v4.0.0`.
+	Plugins []string `json:"plugins,omitempty"`
+}
+
+// GetPluginCacheMaxSize returns the configured maximum size of the plugin cache, if any. It is read from the
+// PULUMI_PLUGIN_CACHE_MAX_SIZE environment variable if it is set, and from the plugin settings file otherwise.
+func GetPluginCacheMaxSize() (string, error) {
+	if env, ok := os.LookupEnv(PluginCacheMaxSizeEnvVar); ok {
+		return env, nil
+	}
+	settings, err := GetPluginSettings()
+	if err != nil {
+		return "", err
+	}
+	return settings.MaxCacheSize, nil
+}
+
+// GetPluginReferences returns the installed plugins used by each project, keyed by the project's root directory.
+func GetPluginReferences() (map[string]ProjectPluginReferences, error) {
+	path, err := GetPulumiPath(PluginReferencesFile)
+	if err != nil {
+		return nil, err
+	}
+	return readPluginReferences(path)
+}
+
+// RecordPluginReferences records that the project in the given root directory used the installed plugins that the
+// given plugins resolve to. Projects whose root directories no longer exist are forgotten.
+func RecordPluginReferences(root string, plugins []PluginInfo, projectPlugins []ProjectPlugin) error {
+	pluginDir, err := GetPluginDir()
+	if err != nil {
+		return err
+	}
+	var dirs []string
+	for _, plugin := range plugins {
+		dir, _, err := GetPluginPathWithOverrides(plugin.Kind, plugin.Name, plugin.Version, projectPlugins)
+		if err == nil && dir != "" && filepath.Dir(dir) == pluginDir {
+			dirs = append(dirs, filepath.Base(dir))
+		}
+	}
+	sort.Strings(dirs)
+
+	path, err := GetPulumiPath(PluginReferencesFile)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	mutex := fsutil.NewFileMutex(path + ".lock")
+	if err = mutex.Lock(); err != nil {
+		return err
+	}
+	defer func() {
+		contract.IgnoreError(mutex.Unlock())
+	}()
+
+	refs, err := readPluginReferences(path)
+	if err != nil {
+		return err
+	}
+	for projectRoot := range refs {
+		if _, err := os.Stat(projectRoot); os.IsNotExist(err) {
+			delete(refs, projectRoot)
+		}
+	}
+	refs[root] = ProjectPluginReferences{LastUsedTime: time.Now().UTC(), Plugins: dirs}
+
+	b, err := json.MarshalIndent(refs, "", "    ")
+	if err != nil {
+		return err
+	}
+	return writePluginSourceFile(filepath.Dir(path), filepath.Base(path), bytes.NewReader(b))
+}
+
+// readPluginReferences reads a plugin references file, returning no references if it does not exist.
+func readPluginReferences(path string) (map[string]ProjectPluginReferences, error) {
+	refs := make(map[string]ProjectPluginReferences)
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return refs, nil
+		}
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &refs); err != nil {
+		return nil, errors.Wrapf(err, "reading %s", path)
+	}
+	return refs, nil
+}
+
+// PluginPruneOptions controls which plugins SelectPluginsToPrune removes from the plugin cache.
+type PluginPruneOptions struct {
+	// ProjectAge is how recently a project must have been used for the plugins it requires to be kept. Zero treats
+	// every recorded project as recently used.
+	ProjectAge time.Duration
+	// MaxAge removes plugins that have not been used for longer than this. Zero disables age-based pruning.
+	MaxAge time.Duration
+	// MaxSize removes the least recently used plugins until the plugin cache takes up no more than this many bytes.
+	// Zero disables the size cap.
+	MaxSize uint64
+	// Keep are plugins that must not be removed, such as those loaded by a running deployment. A plugin without a
+	// version keeps every installed version of that plugin.
+	Keep []PluginInfo
+}
+
+// SelectPluginsToPrune returns the installed plugins that should be removed from the plugin cache, ordered from least
+// to most recently used. Plugins required by a recently used project are never removed. Of the remaining plugins,
+// those not used within the maximum age are removed, and then, if the plugins that are left are larger than the
+// maximum size, the least recently used of them are removed until they fit. The plugins in opts.Keep are never
+// removed, but still count toward the size. The installed plugins must include their size and last used time.
+func SelectPluginsToPrune(installed []PluginInfo, refs map[string]ProjectPluginReferences, now time.Time,
+	opts PluginPruneOptions) []PluginInfo {
+
+	referenced := make(map[string]bool)
+	for _, ref := range refs {
+		if opts.ProjectAge == 0 || now.Sub(ref.LastUsedTime) <= opts.ProjectAge {
+			for _, dir := range ref.Plugins {
+				referenced[dir] = true
+			}
+		}
+	}
+	keep := func(plugin PluginInfo) bool {
+		for _, k := range opts.Keep {
+			if k.Kind == plugin.Kind && k.Name == plugin.Name &&
+				(k.Version == nil || plugin.Version != nil && k.Version.EQ(*plugin.Version)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// A plugin was last used no earlier than it was installed, even if its access time was not updated.
+	lastUsed := func(plugin PluginInfo) time.Time {
+		if plugin.InstallTime.After(plugin.LastUsedTime) {
+			return plugin.InstallTime
+		}
+		return plugin.LastUsedTime
+	}
+
+	var size uint64
+	var candidates []PluginInfo
+	for _, plugin := range installed {
+		size += uint64(plugin.Size)
+		if !referenced[plugin.Dir()] && !keep(plugin) {
+			candidates = append(candidates, plugin)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return lastUsed(candidates[i]).Before(lastUsed(candidates[j]))
+	})
+
+	var prune []PluginInfo
+	for _, plugin := range candidates {
+		stale := opts.MaxAge != 0 && now.Sub(lastUsed(plugin)) > opts.MaxAge
+		oversize := opts.MaxSize != 0 && size > opts.MaxSize
+		if !stale && !oversize {
+			// The remaining candidates were used more recently, so they are neither stale nor needed to fit.
+			break
+		}
+		prune = append(prune, plugin)
+		size -= uint64(plugin.Size)
+	}
+	return prune
+}
@@ -0,0 +1,111 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordPluginReferences(t *testing.T) {
+	home := t.TempDir()
+	os.Setenv(PulumiHomeEnvVar, home)
+	defer os.Unsetenv(PulumiHomeEnvVar)
+	os.Setenv("PULUMI_IGNORE_AMBIENT_PLUGINS", "true")
+	defer os.Unsetenv("PULUMI_IGNORE_AMBIENT_PLUGINS")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, PluginDir, "resource-aws-v4.0.0"), 0700))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, PluginDir, "resource-aws-v4.1.0"), 0700))
+
+	refs, err := GetPluginReferences()
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+
+	project, deleted := t.TempDir(), t.TempDir()
+	v4 := semver.MustParse("4.0.0")
+	require.NoError(t, RecordPluginReferences(deleted, nil, nil))
+	require.NoError(t, os.RemoveAll(deleted))
+	require.NoError(t, RecordPluginReferences(project, []PluginInfo{
+		{Kind: ResourcePlugin, Name: "aws", Version: &v4},
+		{Kind: ResourcePlugin, Name: "random"},
+	}, nil))
+
+	refs, err = GetPluginReferences()
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	assert.Equal(t, []string{"resource-aws-v4.0.0"}, refs[project].Plugins)
+	assert.WithinDuration(t, time.Now(), refs[project].LastUsedTime, time.Minute)
+}
+
+func TestSelectPluginsToPrune(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	plugin := func(name, version string, size int64, lastUsed time.Duration) PluginInfo {
+		v := semver.MustParse(version)
+		return PluginInfo{Kind: ResourcePlugin, Name: name, Version: &v, Size: size, LastUsedTime: now.Add(-lastUsed)}
+	}
+	installed := []PluginInfo{
+		plugin("aws", "3.0.0", 100, 90*day),
+		plugin("aws", "4.0.0", 100, 60*day),
+		plugin("aws", "4.1.0", 100, 1*day),
+		plugin("random", "1.0.0", 10, 40*day),
+		plugin("gcp", "5.0.0", 50, 10*day),
+	}
+	refs := map[string]ProjectPluginReferences{
+		"/recent": {LastUsedTime: now.Add(-5 * day), Plugins: []string{"resource-random-v1.0.0"}},
+		"/old":    {LastUsedTime: now.Add(-100 * day), Plugins: []string{"resource-aws-v3.0.0"}},
+	}
+	names := func(plugins []PluginInfo) []string {
+		var result []string
+		for _, p := range plugins {
+			result = append(result, p.String())
+		}
+		return result
+	}
+
+	// Plugins that are stale and not required by a recent project are removed, oldest first.
+	prune := SelectPluginsToPrune(installed, refs, now, PluginPruneOptions{ProjectAge: 30 * day, MaxAge: 30 * day})
+	assert.Equal(t, []string{"aws-3.0.0", "aws-4.0.0"}, names(prune))
+
+	// Without an age, every recorded project protects its plugins.
+	prune = SelectPluginsToPrune(installed, refs, now, PluginPruneOptions{MaxSize: 250})
+	assert.Equal(t, []string{"aws-4.0.0", "gcp-5.0.0"}, names(prune))
+
+	// The size cap removes the least recently used plugins until the cache fits.
+	prune = SelectPluginsToPrune(installed, refs, now, PluginPruneOptions{ProjectAge: 30 * day, MaxSize: 250})
+	assert.Equal(t, []string{"aws-3.0.0", "aws-4.0.0"}, names(prune))
+
+	// Kept plugins are never removed, but still count toward the size.
+	v4 := semver.MustParse("4.0.0")
+	prune = SelectPluginsToPrune(installed, refs, now, PluginPruneOptions{
+		ProjectAge: 30 * day,
+		MaxSize:    250,
+		Keep: []PluginInfo{
+			{Kind: ResourcePlugin, Name: "aws", Version: &v4},
+			{Kind: ResourcePlugin, Name: "gcp"},
+		},
+	})
+	assert.Equal(t, []string{"aws-3.0.0", "aws-4.1.0"}, names(prune))
+
+	// Nothing is removed if the cache fits and no plugin is stale.
+	prune = SelectPluginsToPrune(installed, refs, now, PluginPruneOptions{MaxAge: 365 * day, MaxSize: 1000})
+	assert.Empty(t, prune)
+}
@@ -0,0 +1,233 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+)
+
+// PluginSourcesEnvVar is an environment variable that holds a comma-separated list of plugin sources. If set, it
+// overrides the sources in the plugin settings file.
+const PluginSourcesEnvVar = "PULUMI_PLUGIN_SOURCES"
+
+// DefaultPluginServerURL is the server that plugins are downloaded from if they do not specify a server of their own.
+const DefaultPluginServerURL = "https://get.pulumi.com/releases/plugins"
+
+// PluginSettings holds settings for downloading plugins, stored in ~/.pulumi/plugins.json.
+type PluginSettings struct {
+	// Sources is a list of plugin sources that are searched in order for plugin tarballs before the server of each
+	// plugin. Each source is either the URL of a plugin mirror, which serves the same files as the default plugin
+	// server, or a local directory that contains plugin tarballs, such as one populated by `pulumi plugin mirror`.
+	Sources []string `json:"sources,omitempty" yaml:"sources,omitempty"`
+	// MaxCacheSize is an optional maximum size of the plugin cache, e.g. `10GB`. If set, the least recently used
+	// plugins that are not required by a recently used project are removed after each deployment finishes to keep the
+	// cache below this size.
+	MaxCacheSize string `json:"maxCacheSize,omitempty" yaml:"maxCacheSize,omitempty"`
+}
+
+// GetPluginSettings reads the plugin settings file, returning empty settings if it does not exist.
+func GetPluginSettings() (*PluginSettings, error) {
+	path, err := GetPulumiPath(PluginSettingsFile)
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &PluginSettings{}, nil
+		}
+		return nil, err
+	}
+
+	var settings PluginSettings
+	if err = json.Unmarshal(b, &settings); err != nil {
+		return nil, errors.Wrapf(err, "reading %s", path)
+	}
+	return &settings, nil
+}
+
+// GetPluginSources returns the plugin sources that are searched, in order, for plugin tarballs before the server of
+// each plugin. They are read from the PULUMI_PLUGIN_SOURCES environment variable if it is set, and from the plugin
+// settings file otherwise.
+func GetPluginSources() ([]string, error) {
+	if env, ok := os.LookupEnv(PluginSourcesEnvVar); ok {
+		var sources []string
+		for _, source := range strings.Split(env, ",") {
+			if source = strings.TrimSpace(source); source != "" {
+				sources = append(sources, source)
+			}
+		}
+		return sources, nil
+	}
+
+	settings, err := GetPluginSettings()
+	if err != nil {
+		return nil, err
+	}
+	return settings.Sources, nil
+}
+
+// isPluginServer returns true if the given plugin source is the URL of a plugin server rather than a local directory.
+func isPluginServer(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+// pluginSourceDir returns the local directory named by a plugin source, which may be a path or a file URL.
+func pluginSourceDir(source string) string {
+	if strings.HasPrefix(source, "file://") {
+		if u, err := url.Parse(source); err == nil {
+			return filepath.FromSlash(u.Path)
+		}
+	}
+	return source
+}
+
+// errPluginSourceFileNotFound is returned by openPluginSourceFile if the source does not have the requested file.
+var errPluginSourceFileNotFound = errors.New("not found")
+
+// openPluginSourceFile opens a file from a plugin source, returning the file and its size, if known.
+func openPluginSourceFile(source, name string) (io.ReadCloser, int64, error) {
+	if !isPluginServer(source) {
+		f, err := os.Open(filepath.Join(pluginSourceDir(source), name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, -1, errPluginSourceFileNotFound
+			}
+			return nil, -1, err
+		}
+		stat, err := f.Stat()
+		if err != nil {
+			contract.IgnoreClose(f)
+			return nil, -1, err
+		}
+		return f, stat.Size(), nil
+	}
+
+	// URL escape the path value to ensure we have the correct path for S3/CloudFront.
+	endpoint := fmt.Sprintf("%s/%s", strings.TrimSuffix(source, "/"), url.QueryEscape(name))
+	logging.V(9).Infof("full plugin download url: %s", endpoint)
+
+	resp, err := getPluginResource(endpoint)
+	if err != nil {
+		return nil, -1, err
+	}
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden {
+		// S3 reports missing objects as forbidden unless the bucket can be listed.
+		contract.IgnoreClose(resp.Body)
+		return nil, -1, errPluginSourceFileNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		contract.IgnoreClose(resp.Body)
+		return nil, -1, errors.Errorf("%d HTTP error fetching %s", resp.StatusCode, endpoint)
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+// readPluginSourceFile reads a small file from a plugin source, returning false if the source does not have it.
+func readPluginSourceFile(source, name string) ([]byte, bool, error) {
+	f, _, err := openPluginSourceFile(source, name)
+	if err != nil {
+		if err == errPluginSourceFileNotFound {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	defer contract.IgnoreClose(f)
+
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "reading %s from %s", name, source)
+	}
+	return b, true, nil
+}
+
+// MirrorPlugin downloads the tarball of a plugin for the given platform into a directory, along with its checksum
+// manifest and signature, if any, so that the directory can be used as a plugin source. The tarball's checksum is
+// verified before it is written.
+func MirrorPlugin(info PluginInfo, dir, goos, goarch string) error {
+	stream, _, source, expected, err := info.download(goos, goarch)
+	if err != nil {
+		return err
+	}
+	defer contract.IgnoreClose(stream)
+
+	staged, checksum, err := stagePluginTarball(stream)
+	if err != nil {
+		return errors.Wrap(err, "downloading plugin")
+	}
+	defer func() {
+		contract.IgnoreClose(staged)
+		contract.IgnoreError(os.Remove(staged.Name()))
+	}()
+	if expected != "" && !strings.EqualFold(expected, checksum) {
+		return &ChecksumMismatchError{Info: info, Expected: strings.ToLower(expected), Actual: checksum}
+	}
+
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	if err = writePluginSourceFile(dir, info.tarballName(goos, goarch), staged); err != nil {
+		return err
+	}
+
+	// Copy the checksum manifest and its signature, so that the mirrored tarballs can be verified in turn.
+	manifestName := info.checksumManifestName()
+	for _, name := range []string{manifestName, manifestName + ".sig"} {
+		b, found, err := readPluginSourceFile(source, name)
+		if err != nil {
+			return err
+		}
+		if found {
+			if err = writePluginSourceFile(dir, name, bytes.NewReader(b)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writePluginSourceFile atomically writes a file into a local plugin source.
+func writePluginSourceFile(dir, name string, contents io.Reader) error {
+	f, err := ioutil.TempFile(dir, name+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, contents)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), filepath.Join(dir, name))
+	}
+	if err != nil {
+		contract.IgnoreError(os.Remove(f.Name()))
+		return errors.Wrapf(err, "writing %s", filepath.Join(dir, name))
+	}
+	return nil
+}
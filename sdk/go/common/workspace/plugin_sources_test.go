@@ -0,0 +1,89 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPluginSources(t *testing.T) {
+	home := t.TempDir()
+	os.Setenv(PulumiHomeEnvVar, home)
+	defer os.Unsetenv(PulumiHomeEnvVar)
+
+	sources, err := GetPluginSources()
+	require.NoError(t, err)
+	assert.Empty(t, sources)
+
+	settings := `{"sources": ["/opt/pulumi/plugins", "https://mirror.example.com/plugins"]}`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(home, PluginSettingsFile), []byte(settings), 0600))
+	sources, err = GetPluginSources()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/opt/pulumi/plugins", "https://mirror.example.com/plugins"}, sources)
+
+	// The environment overrides the settings file.
+	os.Setenv(PluginSourcesEnvVar, "file:///var/cache/plugins, ,https://mirror.internal/plugins")
+	defer os.Unsetenv(PluginSourcesEnvVar)
+	sources, err = GetPluginSources()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"file:///var/cache/plugins", "https://mirror.internal/plugins"}, sources)
+	assert.Equal(t, filepath.FromSlash("/var/cache/plugins"), pluginSourceDir(sources[0]))
+}
+
+func TestMirrorPlugin(t *testing.T) {
+	info, tgz, files := newChecksumTestServer(t, nil)
+	manifestName := info.checksumManifestName()
+	files[manifestName] = []byte(fmt.Sprintf("%s  %s\n", sha256Hex(tgz), info.tarballName(runtime.GOOS, runtime.GOARCH)))
+
+	// Mirror the plugin for this platform into a local cache.
+	cache := t.TempDir()
+	require.NoError(t, MirrorPlugin(info, cache, runtime.GOOS, runtime.GOARCH))
+	assert.FileExists(t, filepath.Join(cache, info.tarballName(runtime.GOOS, runtime.GOARCH)))
+	assert.FileExists(t, filepath.Join(cache, manifestName))
+
+	// Platforms the server does not have are reported.
+	err := MirrorPlugin(info, cache, "windows", "arm64")
+	assert.Error(t, err)
+
+	// Once mirrored, the plugin is installed from the cache without contacting its server.
+	os.Setenv(PluginSourcesEnvVar, cache)
+	defer os.Unsetenv(PluginSourcesEnvVar)
+	info.ServerURL = "http://127.0.0.1:1"
+
+	stream, _, err := info.Download()
+	require.NoError(t, err)
+	require.NoError(t, info.Install(stream))
+	installed, err := info.InstalledChecksum()
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex(tgz), installed)
+	assert.True(t, HasPlugin(info))
+
+	// The checksums of mirrored tarballs are verified.
+	require.NoError(t, info.Delete())
+	require.NoError(t, ioutil.WriteFile(
+		filepath.Join(cache, info.tarballName(runtime.GOOS, runtime.GOARCH)), []byte("tampered"), 0600))
+	stream, _, err = info.Download()
+	require.NoError(t, err)
+	assert.IsType(t, &ChecksumMismatchError{}, errors.Cause(info.Install(stream)))
+}
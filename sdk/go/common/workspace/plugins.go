@@ -20,7 +20,6 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
-	"net/url"
 	"os"
 	"os/exec"
 	"path"
@@ -92,6 +91,7 @@ type PluginInfo struct {
 	LastUsedTime time.Time       // the last time the plugin was used.
 	ServerURL    string          // an optional server to use when downloading this plugin.
 	PluginDir    string          // if set, will be used as the root plugin dir instead of ~/.pulumi/plugins.
+	Checksum     string          // if set, the expected SHA-256 checksum of the plugin's tarball.
 }
 
 // Dir gets the expected plugin directory for this plugin.
@@ -181,6 +181,26 @@ func (info PluginInfo) Delete() error {
 	return nil
 }
 
+// Prune removes the plugin from the cache while holding its installation lock, so that a plugin that is being
+// installed concurrently is not removed part way through. Unlike Delete, it leaves the lock file in place.
+func (info PluginInfo) Prune() error {
+	unlock, err := info.installLock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	dir, err := info.DirPath()
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	contract.IgnoreError(os.Remove(fmt.Sprintf("%s.partial", dir)))
+	return nil
+}
+
 // SetFileMetadata adds extra metadata from the given file, representing this plugin's directory.
 func (info *PluginInfo) SetFileMetadata(path string) error {
 	// Get the file info.
@@ -207,44 +227,88 @@ func (info *PluginInfo) SetFileMetadata(path string) error {
 	return nil
 }
 
-// Download fetches an io.ReadCloser for this plugin and also returns the size of the response (if known).
+// Download fetches an io.ReadCloser for this plugin and also returns the size of the response (if known). The plugin
+// sources returned by GetPluginSources are searched for the plugin's tarball before the plugin's server. The tarball
+// is verified against info.Checksum or, if that is not set, against the checksum manifest published alongside the
+// tarball by its source, if any: the read that reaches the end of the tarball fails if its checksum does not match.
 func (info PluginInfo) Download() (io.ReadCloser, int64, error) {
-	// Figure out the OS/ARCH pair for the download URL.
-	var os string
-	switch runtime.GOOS {
+	stream, size, _, checksum, err := info.download(runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return nil, -1, err
+	}
+	if checksum != "" {
+		stream = newChecksumReader(stream, info, checksum)
+	}
+	return stream, size, nil
+}
+
+// tarballName returns the name of the plugin's tarball for the given platform.
+func (info PluginInfo) tarballName(goos, goarch string) string {
+	return fmt.Sprintf("pulumi-%s-%s-v%s-%s-%s.tar.gz", info.Kind, info.Name, info.Version, goos, goarch)
+}
+
+// download fetches the plugin's tarball for the given platform from the first plugin source that has it, and returns
+// the tarball, its size (if known), its source, and its expected checksum. The expected checksum is info.Checksum if
+// that is set, and is otherwise read from the checksum manifest published by the source, if any.
+func (info PluginInfo) download(goos, goarch string) (io.ReadCloser, int64, string, string, error) {
+	// Check the OS/ARCH pair for the download URL.
+	switch goos {
 	case "darwin", "linux", "windows":
-		os = runtime.GOOS
 	default:
-		return nil, -1, errors.Errorf("unsupported plugin OS: %s", runtime.GOOS)
+		return nil, -1, "", "", errors.Errorf("unsupported plugin OS: %s", goos)
 	}
-	var arch string
-	switch runtime.GOARCH {
+	switch goarch {
 	case "amd64", "arm64":
-		arch = runtime.GOARCH
 	default:
-		return nil, -1, errors.Errorf("unsupported plugin architecture: %s", runtime.GOARCH)
+		return nil, -1, "", "", errors.Errorf("unsupported plugin architecture: %s", goarch)
+	}
+
+	sources, err := GetPluginSources()
+	if err != nil {
+		return nil, -1, "", "", err
 	}
 
 	// If the plugin has a server, associated with it, download from there.  Otherwise use the "default" location, which
-	// is hosted by Pulumi.
+	// is hosted by Pulumi. Either is searched after any configured plugin sources.
 	serverURL := info.ServerURL
 	if serverURL == "" {
-		serverURL = "https://get.pulumi.com/releases/plugins"
+		serverURL = DefaultPluginServerURL
 	}
-	serverURL = strings.TrimSuffix(serverURL, "/")
+	sources = append(sources, strings.TrimSuffix(serverURL, "/"))
 
-	logging.V(1).Infof("%s downloading from %s", info.Name, serverURL)
+	tarball := info.tarballName(goos, goarch)
+	var failures []string
+	for _, source := range sources {
+		logging.V(1).Infof("%s downloading from %s", info.Name, source)
+
+		stream, size, err := openPluginSourceFile(source, tarball)
+		if err != nil {
+			if err == errPluginSourceFileNotFound {
+				err = errors.Errorf("%s not found", tarball)
+			}
+			logging.V(1).Infof("%s could not be downloaded from %s: %v", info.Name, source, err)
+			failures = append(failures, fmt.Sprintf("%s: %v", source, err))
+			continue
+		}
 
-	// URL escape the path value to ensure we have the correct path for S3/CloudFront.
-	endpoint := fmt.Sprintf("%s/%s",
-		serverURL,
-		url.QueryEscape(fmt.Sprintf("pulumi-%s-%s-v%s-%s-%s.tar.gz", info.Kind, info.Name, info.Version, os, arch)))
+		checksum := info.Checksum
+		if checksum == "" {
+			if checksum, err = info.downloadChecksum(source, tarball); err != nil {
+				contract.IgnoreClose(stream)
+				return nil, -1, "", "", err
+			}
+		}
+		return stream, size, source, checksum, nil
+	}
 
-	logging.V(9).Infof("full plugin download url: %s", endpoint)
+	return nil, -1, "", "", errors.Errorf("fetching %s plugin %s:\n  %s", info.Kind, info, strings.Join(failures, "\n  "))
+}
 
+// getPluginResource issues a GET request for a file on a plugin server.
+func getPluginResource(endpoint string) (*http.Response, error) {
 	req, err := http.NewRequest("GET", endpoint, nil)
 	if err != nil {
-		return nil, -1, err
+		return nil, err
 	}
 
 	userAgent := fmt.Sprintf("pulumi-cli/1 (%s; %s)", version.Version, runtime.GOOS)
@@ -254,16 +318,12 @@ func (info PluginInfo) Download() (io.ReadCloser, int64, error) {
 
 	resp, err := httputil.DoWithRetry(req, http.DefaultClient)
 	if err != nil {
-		return nil, -1, err
+		return nil, err
 	}
 
 	logging.V(9).Infof("plugin install response headers: %v", resp.Header)
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return nil, -1, errors.Errorf("%d HTTP error fetching plugin from %s", resp.StatusCode, endpoint)
-	}
-
-	return resp.Body, resp.ContentLength, nil
+	return resp, nil
 }
 
 // installLock acquires a file lock used to prevent concurrent installs.
@@ -298,6 +358,8 @@ func (info PluginInfo) installLock() (unlock func(), err error) {
 // If a failure occurs during installation, the `.partial` file will remain, indicating the plugin wasn't fully
 // installed. The next time the plugin is installed, the old installation directory will be removed and replaced with
 // a fresh install.
+// The tarball is staged in a temporary file and its SHA-256 checksum is verified against info.Checksum, if set, before
+// it is extracted. The checksum is recorded in the plugin's directory.
 func (info PluginInfo) Install(tgz io.ReadCloser) error {
 	defer contract.IgnoreClose(tgz)
 
@@ -349,6 +411,24 @@ func (info PluginInfo) Install(tgz io.ReadCloser) error {
 		return finalDirStatErr
 	}
 
+	// Stage the tarball so that its checksum can be verified before anything is extracted.
+	staged, checksum, err := stagePluginTarball(tgz)
+	if err != nil {
+		return errors.Wrap(err, "downloading plugin")
+	}
+	defer func() {
+		contract.IgnoreClose(staged)
+		contract.IgnoreError(os.Remove(staged.Name()))
+	}()
+	if info.Checksum != "" && !strings.EqualFold(info.Checksum, checksum) {
+		return &ChecksumMismatchError{Info: info, Expected: strings.ToLower(info.Checksum), Actual: checksum}
+	}
+
+	// Even though we deferred closing the tarball at the beginning of this function, go ahead and explicitly close
+	// it now since we're finished reading it, to prevent subsequent output from being displayed oddly with
+	// the progress bar.
+	contract.IgnoreClose(tgz)
+
 	// Create an empty partial file to indicate installation is in-progress.
 	if err := ioutil.WriteFile(partialFilePath, nil, 0600); err != nil {
 		return err
@@ -359,15 +439,13 @@ func (info PluginInfo) Install(tgz io.ReadCloser) error {
 		return err
 	}
 
-	// Uncompress the plugin.
-	if err := archive.ExtractTGZ(tgz, finalDir); err != nil {
+	// Uncompress the plugin and record its checksum.
+	if err := archive.ExtractTGZ(staged, finalDir); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(finalDir, PluginChecksumFile), []byte(checksum+"\n"), 0600); err != nil {
 		return err
 	}
-
-	// Even though we deferred closing the tarball at the beginning of this function, go ahead and explicitly close
-	// it now since we're finished extracting it, to prevent subsequent output from being displayed oddly with
-	// the progress bar.
-	contract.IgnoreClose(tgz)
 
 	// Install dependencies, if needed.
 	proj, err := LoadPluginProject(filepath.Join(finalDir, "PulumiPlugin.yaml"))
@@ -602,6 +680,23 @@ func getPlugins(dir string, skipMetadata bool) ([]PluginInfo, error) {
 // using standard semver sorting rules.  A plugin may be overridden entirely by placing it on your $PATH, though it is
 // possible to opt out of this behavior by setting PULUMI_IGNORE_AMBIENT_PLUGINS to any non-empty value.
 func GetPluginPath(kind PluginKind, name string, version *semver.Version) (string, string, error) {
+	return GetPluginPathWithOverrides(kind, name, version, nil)
+}
+
+// GetPluginPathWithOverrides finds a plugin's path like GetPluginPath, except that a plugin may also be overridden for
+// a single project by listing it in the project's plugins section, whose plugins are passed as projectPlugins.
+func GetPluginPathWithOverrides(kind PluginKind, name string, version *semver.Version,
+	projectPlugins []ProjectPlugin) (string, string, error) {
+
+	// If the project overrides this plugin with a local one, use it.
+	for _, plugin := range projectPlugins {
+		if plugin.Kind == kind && plugin.Name == name &&
+			(plugin.Version == nil || version == nil || plugin.Version.EQ(*version)) {
+			logging.V(6).Infof("GetPluginPath(%s, %s, %v): found in project at %s", kind, name, version, plugin.Path)
+			return filepath.Dir(plugin.Path), plugin.Path, nil
+		}
+	}
+
 	var filename string
 
 	// If we have a version of the plugin on its $PATH, use it, unless we have opted out of this behavior explicitly.
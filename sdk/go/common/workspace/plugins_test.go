@@ -15,10 +15,14 @@
 package workspace
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/blang/semver"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPluginSelection_ExactMatch(t *testing.T) {
@@ -234,3 +238,20 @@ func TestPluginSelection_EmptyVersionWithAlternatives(t *testing.T) {
 	assert.Equal(t, "myplugin", result.Name)
 	assert.Equal(t, "0.2.0", result.Version.String())
 }
+
+func TestPluginPrune(t *testing.T) {
+	v := semver.MustParse("1.0.0")
+	info := PluginInfo{Kind: ResourcePlugin, Name: "aws", Version: &v, PluginDir: t.TempDir()}
+	dir, err := info.DirPath()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, info.File()), []byte{}, 0600))
+
+	require.NoError(t, info.Prune())
+	assert.NoDirExists(t, dir)
+
+	// The plugin's installation lock can still be taken after pruning.
+	unlock, err := info.installLock()
+	require.NoError(t, err)
+	unlock()
+}
@@ -0,0 +1,129 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// PolicyExemptionDateFormat is the format of the expiry date of a policy exemption.
+const PolicyExemptionDateFormat = "2006-01-02"
+
+// PolicyExemption waives the violations of a single policy by the resources whose URNs match a pattern. Violations
+// that are waived by an exemption are still reported, but do not block deployments.
+type PolicyExemption struct {
+	// Policy is the name of the exempted policy. The name may be qualified by the name of the policy pack that
+	// contains the policy, as in `pack/policy`.
+	Policy string `json:"policy" yaml:"policy"`
+	// URN is a pattern that matches the URNs of the exempted resources. A `*` in the pattern matches any sequence of
+	// characters.
+	URN string `json:"urn" yaml:"urn"`
+	// Justification is a required explanation of why the exemption is needed.
+	Justification string `json:"justification" yaml:"justification"`
+	// Expires is the required date, in YYYY-MM-DD form, on which the exemption expires.
+	Expires string `json:"expires" yaml:"expires"`
+}
+
+// Validate checks that the exemption is well-formed.
+func (e PolicyExemption) Validate() error {
+	if e.Policy == "" {
+		return errors.New("policy exemption is missing a 'policy' attribute")
+	}
+	if e.URN == "" {
+		return errors.Errorf("policy exemption for '%s' is missing a 'urn' attribute", e.Policy)
+	}
+	if strings.TrimSpace(e.Justification) == "" {
+		return errors.Errorf("policy exemption for '%s' is missing a 'justification' attribute", e.Policy)
+	}
+	if _, err := e.ExpiresAt(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ExpiresAt returns the time at which the exemption expires. Exemptions expire at the start of their expiry date, in
+// UTC.
+func (e PolicyExemption) ExpiresAt() (time.Time, error) {
+	if e.Expires == "" {
+		return time.Time{}, errors.Errorf("policy exemption for '%s' is missing an 'expires' attribute", e.Policy)
+	}
+	t, err := time.Parse(PolicyExemptionDateFormat, e.Expires)
+	if err != nil {
+		return time.Time{}, errors.Errorf("policy exemption for '%s' has an invalid expiry date '%s'; "+
+			"expected a date of the form YYYY-MM-DD", e.Policy, e.Expires)
+	}
+	return t, nil
+}
+
+// Matches returns true if the exemption applies to the given policy and resource.
+func (e PolicyExemption) Matches(policyPackName, policyName, urn string) bool {
+	policy := e.Policy
+	if i := strings.LastIndex(policy, "/"); i != -1 {
+		if policy[:i] != policyPackName {
+			return false
+		}
+		policy = policy[i+1:]
+	}
+	if policy != policyName {
+		return false
+	}
+
+	pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(e.URN), `\*`, ".*") + "$"
+	matched, err := regexp.MatchString(pattern, urn)
+	return err == nil && matched
+}
+
+func (e PolicyExemption) String() string {
+	return fmt.Sprintf("%s (%s)", e.Policy, e.URN)
+}
+
+// ValidatePolicyExemptions checks that the given exemptions are well-formed and have not expired as of the given
+// time. Expired exemptions are reported as errors so that they are renewed or removed rather than silently ignored.
+func ValidatePolicyExemptions(exemptions []PolicyExemption, now time.Time) error {
+	var expired []string
+	for _, e := range exemptions {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		expiresAt, err := e.ExpiresAt()
+		if err != nil {
+			return err
+		}
+		if !now.Before(expiresAt) {
+			expired = append(expired, fmt.Sprintf("%v expired on %s", e, e.Expires))
+		}
+	}
+	if len(expired) != 0 {
+		return errors.Errorf("expired policy exemptions must be renewed or removed:\n  %s",
+			strings.Join(expired, "\n  "))
+	}
+	return nil
+}
+
+// FindPolicyExemption returns the first of the given exemptions that applies to the given policy and resource, or nil
+// if no exemption applies.
+func FindPolicyExemption(exemptions []PolicyExemption, policyPackName, policyName, urn string) *PolicyExemption {
+	for i := range exemptions {
+		if exemptions[i].Matches(policyPackName, policyName, urn) {
+			return &exemptions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package workspace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestPolicyExemptionMatches(t *testing.T) {
+	e := PolicyExemption{
+		Policy: "s3-encryption",
+		URN:    "urn:pulumi:prod::app::aws:s3/bucket:Bucket::legacy-*",
+	}
+	assert.True(t, e.Matches("security", "s3-encryption", "urn:pulumi:prod::app::aws:s3/bucket:Bucket::legacy-logs"))
+	assert.False(t, e.Matches("security", "s3-encryption", "urn:pulumi:prod::app::aws:s3/bucket:Bucket::logs"))
+	assert.False(t, e.Matches("security", "s3-versioning", "urn:pulumi:prod::app::aws:s3/bucket:Bucket::legacy-logs"))
+
+	// Patterns are anchored and only `*` is special.
+	e.URN = "urn:pulumi:prod::app::aws:s3/bucket:Bucket::legacy.logs"
+	assert.False(t, e.Matches("security", "s3-encryption", "urn:pulumi:prod::app::aws:s3/bucket:Bucket::legacy-logs"))
+
+	// Qualified policy names must also match the policy pack.
+	e = PolicyExemption{Policy: "security/s3-encryption", URN: "*"}
+	assert.True(t, e.Matches("security", "s3-encryption", "urn:pulumi:prod::app::aws:s3/bucket:Bucket::logs"))
+	assert.False(t, e.Matches("cost", "s3-encryption", "urn:pulumi:prod::app::aws:s3/bucket:Bucket::logs"))
+
+	exemptions := []PolicyExemption{{Policy: "a", URN: "*"}, e}
+	assert.Equal(t, &exemptions[1], FindPolicyExemption(exemptions, "security", "s3-encryption", "urn"))
+	assert.Nil(t, FindPolicyExemption(exemptions, "security", "b", "urn"))
+}
+
+func TestValidatePolicyExemptions(t *testing.T) {
+	var exemptions []PolicyExemption
+	err := yaml.Unmarshal([]byte(`
+- policy: s3-encryption
+  urn: "*legacy*"
+  justification: Scheduled for deletion.
+  expires: 2021-07-01
+`), &exemptions)
+	assert.NoError(t, err)
+
+	now := time.Date(2021, 6, 30, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, ValidatePolicyExemptions(exemptions, now))
+
+	now = time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	assert.EqualError(t, ValidatePolicyExemptions(exemptions, now),
+		"expired policy exemptions must be renewed or removed:\n  s3-encryption (*legacy*) expired on 2021-07-01")
+
+	exemptions[0].Justification = ""
+	assert.EqualError(t, ValidatePolicyExemptions(exemptions, now),
+		"policy exemption for 's3-encryption' is missing a 'justification' attribute")
+
+	exemptions[0].Justification = "Scheduled for deletion."
+	exemptions[0].Expires = "July 1st"
+	assert.Error(t, ValidatePolicyExemptions(exemptions, now))
+}
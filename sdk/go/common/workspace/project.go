@@ -20,6 +20,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/blang/semver"
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/encoding"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/config"
@@ -40,6 +41,40 @@ type ProjectTemplate struct {
 	Config map[string]ProjectTemplateConfigValue `json:"config,omitempty" yaml:"config,omitempty"`
 	// Important indicates the template is important and should be listed by default.
 	Important bool `json:"important,omitempty" yaml:"important,omitempty"`
+	// Prompts is an optional list of questions asked when the template is used. The answers are available to the
+	// template's rendered files, file names, file rules, and post-create commands.
+	Prompts []ProjectTemplatePrompt `json:"prompts,omitempty" yaml:"prompts,omitempty"`
+	// Files is an optional list of rules that include or exclude the template's files based on the answers.
+	Files []ProjectTemplateFile `json:"files,omitempty" yaml:"files,omitempty"`
+	// PostCreate is an optional list of commands that are run in the new project's directory after its files are
+	// created, e.g. `go mod tidy`. Values rendered into the commands are quoted for the shell.
+	PostCreate []string `json:"postCreate,omitempty" yaml:"postCreate,omitempty"`
+}
+
+// ProjectTemplatePrompt is a question asked when a project template is used.
+type ProjectTemplatePrompt struct {
+	// Name is the required name of the answer in the template's values, e.g. `{{ .useVpc }}`.
+	Name string `json:"name" yaml:"name"`
+	// Description is an optional description of the question that is displayed when prompting.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Type is the optional type of the answer: `string` (the default), `bool`, or `choice`.
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+	// Choices is the list of valid answers for a `choice` prompt.
+	Choices []string `json:"choices,omitempty" yaml:"choices,omitempty"`
+	// Default is an optional default answer. The default answer to a `bool` prompt is false if none is given.
+	Default string `json:"default,omitempty" yaml:"default,omitempty"`
+	// Pattern is an optional regular expression that a `string` answer must match.
+	Pattern string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+}
+
+// ProjectTemplateFile is a rule that includes or excludes a project template's files based on its prompts' answers.
+type ProjectTemplateFile struct {
+	// Path is a slash-separated glob pattern, relative to the template's directory, for the files the rule applies to.
+	// A pattern that ends in `/**` matches everything in a directory.
+	Path string `json:"path" yaml:"path"`
+	// If is a Go template condition, e.g. `{{ eq .cloud "aws" }}`. Matching files are only created if it renders to a
+	// value other than an empty string, `false`, `0`, or `no`.
+	If string `json:"if" yaml:"if"`
 }
 
 // ProjectTemplateConfigValue is a config value included in the project template manifest.
@@ -58,6 +93,35 @@ type ProjectBackend struct {
 	URL string `json:"url,omitempty" yaml:"url,omitempty"`
 }
 
+// ProjectPlugins is an optional set of local plugins that override the installed plugins of the same kind and name for
+// a project only. This is useful for testing locally built plugins without installing them.
+type ProjectPlugins struct {
+	// Providers is a list of local resource provider plugins.
+	Providers []ProjectPluginOptions `json:"providers,omitempty" yaml:"providers,omitempty"`
+	// Languages is a list of local language plugins.
+	Languages []ProjectPluginOptions `json:"languages,omitempty" yaml:"languages,omitempty"`
+	// Analyzers is a list of local analyzer plugins.
+	Analyzers []ProjectPluginOptions `json:"analyzers,omitempty" yaml:"analyzers,omitempty"`
+}
+
+// ProjectPluginOptions describes a local plugin in a project's plugins section.
+type ProjectPluginOptions struct {
+	// Name is the required name of the plugin, e.g. `aws`.
+	Name string `json:"name" yaml:"name"`
+	// Version is an optional version. If set, the local plugin is only used when this version is requested.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+	// Path is the required path to the plugin's binary, or to a directory that contains it, relative to the project.
+	Path string `json:"path" yaml:"path"`
+}
+
+// ProjectPlugin is a local plugin that overrides the installed plugins of the same kind and name.
+type ProjectPlugin struct {
+	Kind    PluginKind      // the kind of the plugin.
+	Name    string          // the name of the plugin.
+	Version *semver.Version // the version the plugin overrides, if any; nil overrides every version.
+	Path    string          // the absolute path of the plugin's binary.
+}
+
 // Project is a Pulumi project manifest.
 //
 // We explicitly add yaml tags (instead of using the default behavior from https://github.com/ghodss/yaml which works
@@ -90,6 +154,12 @@ type Project struct {
 
 	// Backend is an optional backend configuration
 	Backend *ProjectBackend `json:"backend,omitempty" yaml:"backend,omitempty"`
+
+	// PolicyExemptions is an optional list of policy exemptions that apply to every stack in the project.
+	PolicyExemptions []PolicyExemption `json:"policyExemptions,omitempty" yaml:"policyExemptions,omitempty"`
+
+	// Plugins is an optional set of local plugins that override the installed plugins for this project.
+	Plugins *ProjectPlugins `json:"plugins,omitempty" yaml:"plugins,omitempty"`
 }
 
 func (proj *Project) Validate() error {
@@ -99,10 +169,98 @@ func (proj *Project) Validate() error {
 	if proj.Runtime.Name() == "" {
 		return errors.New("project is missing a 'runtime' attribute")
 	}
+	for _, e := range proj.PolicyExemptions {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+	}
+	if proj.Plugins != nil {
+		for _, p := range proj.Plugins.all() {
+			if p.options.Name == "" {
+				return errors.Errorf("%s plugin is missing a 'name' attribute", p.kind)
+			}
+			if p.options.Path == "" {
+				return errors.Errorf("%s plugin %s is missing a 'path' attribute", p.kind, p.options.Name)
+			}
+			if p.options.Version != "" {
+				if _, err := semver.ParseTolerant(p.options.Version); err != nil {
+					return errors.Wrapf(err, "invalid version for %s plugin %s", p.kind, p.options.Name)
+				}
+			}
+		}
+	}
 
 	return nil
 }
 
+// GetProjectPlugins returns the local plugins declared in the project's plugins section, with their paths resolved
+// relative to the given project root. Paths that name a directory are resolved to the plugin binary inside it.
+func (proj *Project) GetProjectPlugins(root string) ([]ProjectPlugin, error) {
+	if proj.Plugins == nil {
+		return nil, nil
+	}
+
+	var plugins []ProjectPlugin
+	for _, p := range proj.Plugins.all() {
+		plugin := ProjectPlugin{Kind: p.kind, Name: p.options.Name}
+		if p.options.Version != "" {
+			version, err := semver.ParseTolerant(p.options.Version)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid version for %s plugin %s", p.kind, p.options.Name)
+			}
+			plugin.Version = &version
+		}
+
+		path := p.options.Path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(root, path)
+		}
+		stat, err := os.Stat(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "loading local %s plugin %s", p.kind, p.options.Name)
+		}
+		if stat.IsDir() {
+			filename := (&PluginInfo{Kind: p.kind, Name: p.options.Name}).FilePrefix()
+			found := false
+			for _, ext := range getCandidateExtensions() {
+				candidate := filepath.Join(path, filename+ext)
+				if _, err := os.Stat(candidate); err == nil {
+					path, found = candidate, true
+					break
+				}
+			}
+			if !found {
+				return nil, errors.Errorf("loading local %s plugin %s: %s does not contain %s",
+					p.kind, p.options.Name, path, filename)
+			}
+		}
+		plugin.Path = path
+
+		plugins = append(plugins, plugin)
+	}
+	return plugins, nil
+}
+
+type projectPluginOptions struct {
+	kind    PluginKind
+	options ProjectPluginOptions
+}
+
+// all returns every plugin in the plugins section, along with its kind.
+func (plugins *ProjectPlugins) all() []projectPluginOptions {
+	var all []projectPluginOptions
+	for _, p := range plugins.Providers {
+		all = append(all, projectPluginOptions{kind: ResourcePlugin, options: p})
+	}
+	for _, p := range plugins.Languages {
+		all = append(all, projectPluginOptions{kind: LanguagePlugin, options: p})
+	}
+	for _, p := range plugins.Analyzers {
+		all = append(all, projectPluginOptions{kind: AnalyzerPlugin, options: p})
+	}
+	return all
+}
+
 // TrustResourceDependencies returns whether or not this project's runtime can be trusted to accurately report
 // dependencies. All languages supported by Pulumi today do this correctly. This option remains useful when bringing
 // up new Pulumi languages.
@@ -179,6 +337,8 @@ type ProjectStack struct {
 	EncryptionSalt string `json:"encryptionsalt,omitempty" yaml:"encryptionsalt,omitempty"`
 	// Config is an optional config bag.
 	Config config.Map `json:"config,omitempty" yaml:"config,omitempty"`
+	// PolicyExemptions is an optional list of policy exemptions that apply to this stack.
+	PolicyExemptions []PolicyExemption `json:"policyExemptions,omitempty" yaml:"policyExemptions,omitempty"`
 }
 
 // Save writes a project definition to a file.
@@ -2,9 +2,14 @@ package workspace
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/blang/semver"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
 )
 
@@ -36,3 +41,81 @@ func TestProjectRuntimeInfoRoundtripYAML(t *testing.T) {
 	doTest(yaml.Marshal, yaml.Unmarshal)
 	doTest(json.Marshal, json.Unmarshal)
 }
+
+func TestProjectPlugins(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "bin"), 0700))
+	provider := filepath.Join(root, "bin", "pulumi-resource-aws")
+	analyzer := filepath.Join(root, "pulumi-analyzer-policy")
+	for _, path := range []string{provider, analyzer} {
+		require.NoError(t, ioutil.WriteFile(path, []byte("#!/bin/sh\n"), 0700)) //nolint: gosec
+	}
+
+	var proj Project
+	require.NoError(t, yaml.Unmarshal([]byte(`name: test
+runtime: nodejs
+plugins:
+  providers:
+  - name: aws
+    path: bin
+  analyzers:
+  - name: policy
+    version: 1.2.0
+    path: pulumi-analyzer-policy
+`), &proj))
+	require.NoError(t, proj.Validate())
+
+	plugins, err := proj.GetProjectPlugins(root)
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+	assert.Equal(t, ProjectPlugin{Kind: ResourcePlugin, Name: "aws", Path: provider}, plugins[0])
+	assert.Equal(t, AnalyzerPlugin, plugins[1].Kind)
+	assert.Equal(t, "1.2.0", plugins[1].Version.String())
+	assert.Equal(t, analyzer, plugins[1].Path)
+
+	// Local plugins are preferred to installed plugins, as long as their versions match.
+	_, path, err := GetPluginPathWithOverrides(ResourcePlugin, "aws", nil, plugins)
+	require.NoError(t, err)
+	assert.Equal(t, provider, path)
+
+	v3 := semver.MustParse("3.0.0")
+	_, path, err = GetPluginPathWithOverrides(ResourcePlugin, "aws", &v3, plugins)
+	require.NoError(t, err)
+	assert.Equal(t, provider, path)
+
+	v1 := semver.MustParse("1.2.0")
+	_, path, err = GetPluginPathWithOverrides(AnalyzerPlugin, "policy", &v1, plugins)
+	require.NoError(t, err)
+	assert.Equal(t, analyzer, path)
+
+	os.Setenv("PULUMI_IGNORE_AMBIENT_PLUGINS", "true")
+	defer os.Unsetenv("PULUMI_IGNORE_AMBIENT_PLUGINS")
+	os.Setenv(PulumiHomeEnvVar, t.TempDir())
+	defer os.Unsetenv(PulumiHomeEnvVar)
+	v2 := semver.MustParse("2.0.0")
+	_, path, err = GetPluginPathWithOverrides(AnalyzerPlugin, "policy", &v2, plugins)
+	assert.Error(t, err)
+	assert.Empty(t, path)
+}
+
+func TestProjectPluginsInvalid(t *testing.T) {
+	proj := Project{Name: "test", Runtime: NewProjectRuntimeInfo("nodejs", nil), Plugins: &ProjectPlugins{
+		Providers: []ProjectPluginOptions{{Name: "aws"}},
+	}}
+	assert.Error(t, proj.Validate())
+
+	proj.Plugins.Providers[0].Path = "bin"
+	proj.Plugins.Providers[0].Version = "not-a-version"
+	assert.Error(t, proj.Validate())
+
+	proj.Plugins.Providers[0].Version = ""
+	assert.NoError(t, proj.Validate())
+
+	_, err := proj.GetProjectPlugins(t.TempDir())
+	assert.Error(t, err)
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "bin"), 0700))
+	_, err = proj.GetProjectPlugins(root)
+	assert.Error(t, err)
+}
@@ -0,0 +1,515 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+	git "gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
+	githttp "gopkg.in/src-d/go-git.v4/plumbing/transport/http"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport/ssh"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/archive"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/gitutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/httputil"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/util/logging"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/version"
+)
+
+// The kinds of template sources.
+const (
+	// TemplateSourceGit is a Git repository of templates.
+	TemplateSourceGit = "git"
+	// TemplateSourceTarball is a .tar.gz archive of templates served over HTTP.
+	TemplateSourceTarball = "tarball"
+	// TemplateSourceLocal is a local directory of templates.
+	TemplateSourceLocal = "local"
+
+	// templateSourceVersionPlaceholder is replaced with the requested version in the URLs of tarball sources.
+	templateSourceVersionPlaceholder = "{version}"
+)
+
+// templateSourceNameRegexp matches valid template source names.
+var templateSourceNameRegexp = regexp.MustCompile("^[A-Za-z0-9_.-]+$")
+
+// TemplateSettings holds settings for retrieving project templates, stored in ~/.pulumi/templates.json.
+type TemplateSettings struct {
+	// Sources is a list of template sources. If any are configured, `pulumi new` lists and looks up templates in
+	// these sources, in order, instead of in the public Pulumi templates repository.
+	Sources []TemplateSource `json:"sources,omitempty" yaml:"sources,omitempty"`
+}
+
+// TemplateSource is a repository of project templates: a Git repository, a tarball served over HTTP, or a local
+// directory. Each template is a subdirectory, containing a Pulumi.yaml, of the source's root or of its Path.
+type TemplateSource struct {
+	// Name is the required name of the source. Templates may be qualified with it, as in `<source>/<template>`.
+	Name string `json:"name" yaml:"name"`
+	// Description is an optional description of the source.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// Kind is the optional kind of the source: `git`, `tarball`, or `local`. If omitted, it is inferred from the URL:
+	// URLs that end in `.tar.gz` or `.tgz` are tarballs, other URLs are Git repositories, and paths are local.
+	Kind string `json:"kind,omitempty" yaml:"kind,omitempty"`
+	// URL is the required location of the source: a Git URL (HTTPS or SSH), the URL of a tarball, or a local path.
+	// A tarball URL may contain `{version}`, which is replaced with the requested version.
+	URL string `json:"url" yaml:"url"`
+	// Path is an optional slash-separated path to the directory within the source that contains the templates.
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+	// Ref is an optional Git branch or tag to use when no version is requested, or the default version of a tarball
+	// source. Git sources default to the repository's default branch.
+	Ref string `json:"ref,omitempty" yaml:"ref,omitempty"`
+	// TokenEnv is the optional name of an environment variable that holds an access token. Git sources send it as
+	// the password of HTTPS basic authentication, and tarball sources send it as a bearer token.
+	TokenEnv string `json:"tokenEnv,omitempty" yaml:"tokenEnv,omitempty"`
+	// Username is an optional user name for HTTPS basic authentication with TokenEnv, or for SSH. Defaults to `git`.
+	Username string `json:"username,omitempty" yaml:"username,omitempty"`
+	// SSHKey is an optional path to the private key used to clone a Git source over SSH. If omitted, the SSH agent is
+	// used.
+	SSHKey string `json:"sshKey,omitempty" yaml:"sshKey,omitempty"`
+}
+
+// GetTemplateSettings reads the template settings file, returning empty settings if it does not exist.
+func GetTemplateSettings() (*TemplateSettings, error) {
+	path, err := GetPulumiPath(TemplateSettingsFile)
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &TemplateSettings{}, nil
+		}
+		return nil, err
+	}
+
+	var settings TemplateSettings
+	if err = json.Unmarshal(b, &settings); err != nil {
+		return nil, errors.Wrapf(err, "reading %s", path)
+	}
+	if err = settings.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "reading %s", path)
+	}
+	return &settings, nil
+}
+
+// Validate checks that the template sources are well-formed.
+func (settings *TemplateSettings) Validate() error {
+	seen := make(map[string]bool)
+	for i, source := range settings.Sources {
+		if !templateSourceNameRegexp.MatchString(source.Name) {
+			return errors.Errorf("template source %d: name %q must be non-empty and contain only letters, digits, "+
+				"`_`, `.`, and `-`", i, source.Name)
+		}
+		if seen[source.Name] {
+			return errors.Errorf("duplicate template source %q", source.Name)
+		}
+		seen[source.Name] = true
+
+		if source.URL == "" {
+			return errors.Errorf("template source %q has no url", source.Name)
+		}
+		switch source.kind() {
+		case TemplateSourceGit, TemplateSourceTarball, TemplateSourceLocal:
+		default:
+			return errors.Errorf("template source %q has unknown kind %q; expected git, tarball, or local",
+				source.Name, source.Kind)
+		}
+		for _, part := range strings.Split(source.Path, "/") {
+			if part == ".." {
+				return errors.Errorf("template source %q has an invalid path %q", source.Name, source.Path)
+			}
+		}
+	}
+	return nil
+}
+
+// GetTemplateSources returns the configured template sources, in the order they are searched.
+func GetTemplateSources() ([]TemplateSource, error) {
+	settings, err := GetTemplateSettings()
+	if err != nil {
+		return nil, err
+	}
+	return settings.Sources, nil
+}
+
+// ParseTemplateVersion splits a template name of the form `<name>@<version>` into its name and version. The version
+// is empty if the name does not have one.
+func ParseTemplateVersion(templateName string) (string, string) {
+	if i := strings.LastIndex(templateName, "@"); i > 0 {
+		return templateName[:i], templateName[i+1:]
+	}
+	return templateName, ""
+}
+
+// kind returns the kind of the source, inferring it from the URL if it is not set.
+func (source TemplateSource) kind() string {
+	if source.Kind != "" {
+		return source.Kind
+	}
+	if strings.HasSuffix(source.URL, ".tar.gz") || strings.HasSuffix(source.URL, ".tgz") {
+		return TemplateSourceTarball
+	}
+	if strings.Contains(source.URL, "://") || isSCPLikeGitURL(source.URL) {
+		return TemplateSourceGit
+	}
+	return TemplateSourceLocal
+}
+
+// isSCPLikeGitURL returns true for SSH Git URLs of the form `user@host:path`.
+func isSCPLikeGitURL(url string) bool {
+	at, colon := strings.Index(url, "@"), strings.Index(url, ":")
+	return at > 0 && colon > at && !(runtime.GOOS == "windows" && colon == 1)
+}
+
+// token returns the source's access token, if it has one.
+func (source TemplateSource) token() (string, error) {
+	if source.TokenEnv == "" {
+		return "", nil
+	}
+	token := os.Getenv(source.TokenEnv)
+	if token == "" {
+		return "", errors.Errorf("template source %q requires an access token in %s", source.Name, source.TokenEnv)
+	}
+	return token, nil
+}
+
+func (source TemplateSource) username() string {
+	if source.Username != "" {
+		return source.Username
+	}
+	return "git"
+}
+
+// gitAuth returns the authentication method for a Git source. A nil method uses the SSH agent for SSH URLs.
+func (source TemplateSource) gitAuth() (transport.AuthMethod, error) {
+	if source.SSHKey != "" {
+		path := source.SSHKey
+		if strings.HasPrefix(path, "~/") {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return nil, err
+			}
+			path = filepath.Join(home, path[2:])
+		}
+		auth, err := ssh.NewPublicKeysFromFile(source.username(), path, "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading SSH key for template source %q", source.Name)
+		}
+		return auth, nil
+	}
+
+	token, err := source.token()
+	if err != nil || token == "" {
+		return nil, err
+	}
+	return &githttp.BasicAuth{Username: source.username(), Password: token}, nil
+}
+
+// errTemplateVersionNotFound is returned when a template source does not have the requested version.
+var errTemplateVersionNotFound = errors.New("version not found")
+
+// retrieve retrieves the "template repository" for the source, at the given version if it is not empty.
+func (source TemplateSource) retrieve(version string, offline bool) (TemplateRepository, error) {
+	var repo TemplateRepository
+	var err error
+	switch source.kind() {
+	case TemplateSourceGit:
+		repo, err = source.retrieveGit(version, offline)
+	case TemplateSourceTarball:
+		repo, err = source.retrieveTarball(version, offline)
+	default:
+		if version != "" {
+			return TemplateRepository{}, errors.Errorf("template source %q is a local directory and does not "+
+				"support versions", source.Name)
+		}
+		repo = TemplateRepository{Root: source.URL}
+	}
+	if err != nil {
+		return TemplateRepository{}, err
+	}
+
+	// The source's templates are in its path, relative to the root of the retrieved repository.
+	base := repo.SubDirectory
+	if base == "" {
+		base = repo.Root
+	}
+	repo.Source = source.Name
+	repo.SubDirectory = filepath.Join(base, filepath.FromSlash(source.Path))
+	if _, err = os.Stat(repo.SubDirectory); err != nil {
+		contract.IgnoreError(repo.Delete())
+		return TemplateRepository{}, errors.Wrapf(err, "template source %q", source.Name)
+	}
+	return repo, nil
+}
+
+// retrieveGit clones the source's Git repository. Unversioned sources are cached under ~/.pulumi/template-sources and
+// updated each time they are retrieved, unless offline. Versions are cloned from the matching tag, `<version>` or
+// `v<version>`, into a temporary directory.
+func (source TemplateSource) retrieveGit(version string, offline bool) (TemplateRepository, error) {
+	auth, err := source.gitAuth()
+	if err != nil {
+		return TemplateRepository{}, err
+	}
+
+	if version == "" {
+		dir, err := GetPulumiPath(TemplateSourcesDir, source.Name)
+		if err != nil {
+			return TemplateRepository{}, err
+		}
+		if offline {
+			if _, err = os.Stat(dir); err != nil {
+				return TemplateRepository{}, errors.Errorf("template source %q has not been retrieved; "+
+					"run without --offline first", source.Name)
+			}
+			return TemplateRepository{Root: dir}, nil
+		}
+
+		// Start over if the cached clone is of a different repository or branch.
+		ref := plumbing.HEAD
+		if source.Ref != "" {
+			if ref, err = source.resolveGitRef(source.Ref, auth); err != nil {
+				return TemplateRepository{}, err
+			}
+		}
+		if err = source.cleanupGitCache(dir, ref); err != nil {
+			return TemplateRepository{}, err
+		}
+		if err = os.MkdirAll(filepath.Dir(dir), 0700); err != nil {
+			return TemplateRepository{}, err
+		}
+		if err = gitutil.GitCloneOrPullWithAuth(source.URL, ref, dir, false /*shallow*/, auth); err != nil {
+			return TemplateRepository{}, errors.Wrapf(err, "cloning template source %q", source.Name)
+		}
+		return TemplateRepository{Root: dir}, nil
+	}
+
+	if offline {
+		return TemplateRepository{}, errors.Errorf("cannot retrieve version %s of template source %q offline",
+			version, source.Name)
+	}
+	ref, err := source.resolveGitRef(version, auth)
+	if err != nil {
+		return TemplateRepository{}, err
+	}
+	if !ref.IsTag() {
+		return TemplateRepository{}, errTemplateVersionNotFound
+	}
+
+	temp, err := ioutil.TempDir("", "pulumi-template-")
+	if err != nil {
+		return TemplateRepository{}, err
+	}
+	if err = gitutil.GitCloneOrPullWithAuth(source.URL, ref, temp, true /*shallow*/, auth); err != nil {
+		contract.IgnoreError(os.RemoveAll(temp))
+		return TemplateRepository{}, errors.Wrapf(err, "cloning template source %q at %s", source.Name, version)
+	}
+	return TemplateRepository{Root: temp, ShouldDelete: true}, nil
+}
+
+// resolveGitRef returns the tag or branch of the source's repository with the given name. Tags are preferred, and a
+// name without a leading `v` also matches a tag with one.
+func (source TemplateSource) resolveGitRef(name string, auth transport.AuthMethod) (plumbing.ReferenceName, error) {
+	refs, err := gitutil.GitListBranchesAndTagsWithAuth(source.URL, auth)
+	if err != nil {
+		return "", errors.Wrapf(err, "listing tags of template source %q", source.Name)
+	}
+
+	candidates := []plumbing.ReferenceName{plumbing.NewTagReferenceName(name)}
+	if !strings.HasPrefix(name, "v") {
+		candidates = append(candidates, plumbing.NewTagReferenceName("v"+name))
+	}
+	candidates = append(candidates, plumbing.NewBranchReferenceName(name))
+	for _, candidate := range candidates {
+		for _, ref := range refs {
+			if ref == candidate {
+				return ref, nil
+			}
+		}
+	}
+	return "", errTemplateVersionNotFound
+}
+
+// cleanupGitCache deletes the cached clone of the source if it is not a clone of the source's URL and reference.
+func (source TemplateSource) cleanupGitCache(dir string, ref plumbing.ReferenceName) error {
+	repo, err := git.PlainOpen(dir)
+	if err != nil {
+		if err == git.ErrRepositoryNotExists {
+			return os.RemoveAll(dir)
+		}
+		return err
+	}
+
+	remotes, err := repo.Remotes()
+	if err != nil {
+		return errors.Wrapf(err, "getting remotes of %s", dir)
+	}
+	matches := len(remotes) == 1 && len(remotes[0].Config().URLs) == 1 && remotes[0].Config().URLs[0] == source.URL
+	if matches && ref != plumbing.HEAD {
+		head, err := repo.Head()
+		matches = err == nil && head.Name() == ref
+	}
+	if !matches {
+		return os.RemoveAll(dir)
+	}
+	return nil
+}
+
+// retrieveTarball downloads and extracts the source's tarball into a temporary directory. If the tarball contains a
+// single top-level directory, as archives of Git repositories do, the source's path is relative to that directory.
+func (source TemplateSource) retrieveTarball(templateVersion string, offline bool) (TemplateRepository, error) {
+	url := source.URL
+	if strings.Contains(url, templateSourceVersionPlaceholder) {
+		if templateVersion == "" {
+			templateVersion = source.Ref
+		}
+		if templateVersion == "" {
+			return TemplateRepository{}, errors.Errorf("template source %q requires a version, "+
+				"e.g. `<template>@<version>`", source.Name)
+		}
+		url = strings.Replace(url, templateSourceVersionPlaceholder, templateVersion, -1)
+	} else if templateVersion != "" {
+		return TemplateRepository{}, errors.Errorf("template source %q does not support versions; "+
+			"its url has no %s placeholder", source.Name, templateSourceVersionPlaceholder)
+	}
+	if offline {
+		return TemplateRepository{}, errors.Errorf("cannot retrieve template source %q offline", source.Name)
+	}
+
+	token, err := source.token()
+	if err != nil {
+		return TemplateRepository{}, err
+	}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return TemplateRepository{}, err
+	}
+	req.Header.Set("User-Agent", fmt.Sprintf("pulumi-cli/1 (%s; %s)", version.Version, runtime.GOOS))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	logging.V(9).Infof("downloading template source %s from %s", source.Name, url)
+	resp, err := httputil.DoWithRetry(req, http.DefaultClient)
+	if err != nil {
+		return TemplateRepository{}, errors.Wrapf(err, "downloading template source %q", source.Name)
+	}
+	defer contract.IgnoreClose(resp.Body)
+	if resp.StatusCode == http.StatusNotFound && templateVersion != "" {
+		return TemplateRepository{}, errTemplateVersionNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return TemplateRepository{}, errors.Errorf("%d HTTP error downloading template source %q from %s",
+			resp.StatusCode, source.Name, url)
+	}
+
+	temp, err := ioutil.TempDir("", "pulumi-template-")
+	if err != nil {
+		return TemplateRepository{}, err
+	}
+	repo := TemplateRepository{Root: temp, SubDirectory: temp, ShouldDelete: true}
+	if err = archive.ExtractTGZ(resp.Body, temp); err != nil {
+		contract.IgnoreError(repo.Delete())
+		return TemplateRepository{}, errors.Wrapf(err, "extracting template source %q", source.Name)
+	}
+
+	infos, err := ioutil.ReadDir(temp)
+	if err != nil {
+		contract.IgnoreError(repo.Delete())
+		return TemplateRepository{}, err
+	}
+	if len(infos) == 1 && infos[0].IsDir() {
+		if _, err = os.Stat(filepath.Join(temp, ProjectFile+".yaml")); os.IsNotExist(err) {
+			repo.SubDirectory = filepath.Join(temp, infos[0].Name())
+		}
+	}
+	return repo, nil
+}
+
+// retrieveSourceTemplates retrieves the "template repository" for a template from the configured sources. If the
+// template name is empty, the repository contains the templates of all of the sources. If the name is qualified with
+// a source, as in `<source>/<template>`, only that source is searched, and it is an error if there is no such source;
+// otherwise the first source with the template is used.
+func retrieveSourceTemplates(sources []TemplateSource, templateName, version string,
+	offline bool) (TemplateRepository, error) {
+
+	if templateName == "" {
+		if version != "" {
+			return TemplateRepository{}, errors.New("a version requires a template name, e.g. `<template>@<version>`")
+		}
+
+		var repo TemplateRepository
+		for _, source := range sources {
+			sourceRepo, err := source.retrieve("", offline)
+			if err != nil {
+				contract.IgnoreError(repo.Delete())
+				return TemplateRepository{}, err
+			}
+			repo.Repositories = append(repo.Repositories, sourceRepo)
+		}
+		return repo, nil
+	}
+
+	if i := strings.Index(templateName, "/"); i > 0 {
+		sourceName, found := templateName[:i], false
+		for _, source := range sources {
+			if source.Name == sourceName {
+				sources, templateName, found = []TemplateSource{source}, templateName[i+1:], true
+				break
+			}
+		}
+		if !found {
+			return TemplateRepository{}, errors.Errorf("unknown template source '%s' in template '%s'",
+				sourceName, templateName)
+		}
+	}
+
+	var names []string
+	for _, source := range sources {
+		names = append(names, source.Name)
+
+		repo, err := source.retrieve(version, offline)
+		if err == errTemplateVersionNotFound {
+			logging.V(5).Infof("template source %s does not have version %s", source.Name, version)
+			continue
+		} else if err != nil {
+			return TemplateRepository{}, err
+		}
+
+		subDir := filepath.Join(repo.SubDirectory, filepath.FromSlash(templateName))
+		if info, err := os.Stat(subDir); err == nil && info.IsDir() {
+			repo.SubDirectory = subDir
+			return repo, nil
+		}
+		contract.IgnoreError(repo.Delete())
+	}
+
+	message := fmt.Sprintf("template '%s' not found in template sources %s", templateName, strings.Join(names, ", "))
+	if version != "" {
+		message = fmt.Sprintf("template '%s' version %s not found in template sources %s",
+			templateName, version, strings.Join(names, ", "))
+	}
+	return TemplateRepository{}, errors.New(message)
+}
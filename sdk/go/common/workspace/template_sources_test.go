@@ -0,0 +1,240 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	git "gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+// writeTestTemplate writes a minimal project template to dir/name.
+func writeTestTemplate(t *testing.T, dir, name, description string) {
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, name), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name, "Pulumi.yaml"),
+		[]byte("name: "+name+"\nruntime: nodejs\ntemplate:\n  description: "+description+"\n"), 0600))
+}
+
+func TestParseTemplateVersion(t *testing.T) {
+	for input, expected := range map[string][2]string{
+		"":                        {"", ""},
+		"aws-typescript":          {"aws-typescript", ""},
+		"aws-typescript@v1.2.0":   {"aws-typescript", "v1.2.0"},
+		"internal/service@2.0":    {"internal/service", "2.0"},
+		"@scoped":                 {"@scoped", ""},
+		"internal/service@2.0@rc": {"internal/service@2.0", "rc"},
+	} {
+		name, version := ParseTemplateVersion(input)
+		assert.Equal(t, expected, [2]string{name, version}, input)
+	}
+}
+
+func TestTemplateSettingsValidate(t *testing.T) {
+	settings := TemplateSettings{Sources: []TemplateSource{
+		{Name: "internal", URL: "git@github.com:acme/templates.git"},
+		{Name: "archive", URL: "https://templates.acme.com/templates-{version}.tar.gz"},
+		{Name: "local", URL: "/opt/templates", Path: "templates"},
+	}}
+	require.NoError(t, settings.Validate())
+	assert.Equal(t, TemplateSourceGit, settings.Sources[0].kind())
+	assert.Equal(t, TemplateSourceTarball, settings.Sources[1].kind())
+	assert.Equal(t, TemplateSourceLocal, settings.Sources[2].kind())
+
+	invalid := []TemplateSource{
+		{URL: "/opt/templates"},
+		{Name: "has space", URL: "/opt/templates"},
+		{Name: "internal", URL: "/opt/templates"},
+		{Name: "nourl"},
+		{Name: "kind", Kind: "svn", URL: "/opt/templates"},
+		{Name: "path", URL: "/opt/templates", Path: "../templates"},
+	}
+	for _, source := range invalid {
+		settings := TemplateSettings{Sources: []TemplateSource{{Name: "internal", URL: "/opt/templates"}, source}}
+		assert.Error(t, settings.Validate(), "%v", source)
+	}
+}
+
+func TestRetrieveSourceTemplates(t *testing.T) {
+	first, second := t.TempDir(), t.TempDir()
+	writeTestTemplate(t, first, "service", "An internal service")
+	writeTestTemplate(t, filepath.Join(second, "templates"), "service", "Another service")
+	writeTestTemplate(t, filepath.Join(second, "templates"), "website", "A website")
+	sources := []TemplateSource{
+		{Name: "first", URL: first},
+		{Name: "second", URL: second, Path: "templates"},
+	}
+
+	// Listing aggregates the sources, preferring earlier ones.
+	repo, err := retrieveSourceTemplates(sources, "", "", false)
+	require.NoError(t, err)
+	templates, err := repo.Templates()
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, "service", templates[0].Name)
+	assert.Equal(t, "first", templates[0].Source)
+	assert.Equal(t, "An internal service", templates[0].Description)
+	assert.Equal(t, "website", templates[1].Name)
+	assert.Equal(t, "second", templates[1].Source)
+
+	// Names are looked up in order, unless qualified with a source.
+	repo, err = retrieveSourceTemplates(sources, "service", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(first, "service"), repo.SubDirectory)
+
+	repo, err = retrieveSourceTemplates(sources, "second/service", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(second, "templates", "service"), repo.SubDirectory)
+
+	_, err = retrieveSourceTemplates(sources, "missing", "", false)
+	assert.Error(t, err)
+
+	// Qualified names must name a configured source.
+	_, err = retrieveSourceTemplates(sources, "third/service", "", false)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unknown template source 'third'")
+	}
+
+	// Local sources don't support versions.
+	_, err = retrieveSourceTemplates(sources, "service", "1.0.0", false)
+	assert.Error(t, err)
+}
+
+func TestRetrieveTarballSourceTemplates(t *testing.T) {
+	const token = "secret-token"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/templates-1.0.0.tar.gz" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// Archives of Git repositories have a single top-level directory.
+		gz := gzip.NewWriter(w)
+		tw := tar.NewWriter(gz)
+		contents := []byte("name: service\nruntime: go\ntemplate:\n  description: A service\n")
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: "templates-1.0.0/", Typeflag: tar.TypeDir, Mode: 0755,
+		}))
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: "templates-1.0.0/service/", Typeflag: tar.TypeDir, Mode: 0755,
+		}))
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: "templates-1.0.0/service/Pulumi.yaml", Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(contents)),
+		}))
+		_, err := tw.Write(contents)
+		require.NoError(t, err)
+		require.NoError(t, tw.Close())
+		require.NoError(t, gz.Close())
+	}))
+	defer server.Close()
+
+	os.Setenv("TEST_TEMPLATE_TOKEN", token)
+	defer os.Unsetenv("TEST_TEMPLATE_TOKEN")
+	sources := []TemplateSource{
+		{Name: "archive", URL: server.URL + "/templates-{version}.tar.gz", TokenEnv: "TEST_TEMPLATE_TOKEN"},
+	}
+
+	repo, err := retrieveSourceTemplates(sources, "service", "1.0.0", false)
+	require.NoError(t, err)
+	assert.True(t, repo.ShouldDelete)
+	templates, err := repo.Templates()
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, "A service", templates[0].Description)
+	assert.Equal(t, "archive", templates[0].Source)
+	require.NoError(t, repo.Delete())
+	assert.NoDirExists(t, repo.Root)
+
+	_, err = retrieveSourceTemplates(sources, "service", "2.0.0", false)
+	assert.Error(t, err)
+
+	// Without a version or a default ref, a versioned tarball source can't be retrieved.
+	_, err = retrieveSourceTemplates(sources, "service", "", false)
+	assert.Error(t, err)
+
+	os.Unsetenv("TEST_TEMPLATE_TOKEN")
+	_, err = retrieveSourceTemplates(sources, "service", "1.0.0", false)
+	assert.Error(t, err)
+}
+
+func TestRetrieveGitSourceTemplates(t *testing.T) {
+	os.Setenv(PulumiHomeEnvVar, t.TempDir())
+	defer os.Unsetenv(PulumiHomeEnvVar)
+
+	// Create a repository with a tagged version of a template, and a newer untagged one.
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+	commit := func(description string) {
+		writeTestTemplate(t, dir, "service", description)
+		_, err := w.Add("service/Pulumi.yaml")
+		require.NoError(t, err)
+		_, err = w.Commit(description, &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		})
+		require.NoError(t, err)
+	}
+	commit("Version one")
+	head, err := repo.Head()
+	require.NoError(t, err)
+	_, err = repo.CreateTag("v1.0.0", head.Hash(), nil)
+	require.NoError(t, err)
+	commit("Version two")
+
+	sources := []TemplateSource{{Name: "internal", URL: dir, Kind: TemplateSourceGit}}
+
+	latest, err := retrieveSourceTemplates(sources, "service", "", false)
+	require.NoError(t, err)
+	assert.False(t, latest.ShouldDelete)
+	templates, err := latest.Templates()
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, "Version two", templates[0].Description)
+
+	// Versions match tags, with or without a leading v.
+	for _, version := range []string{"v1.0.0", "1.0.0"} {
+		pinned, err := retrieveSourceTemplates(sources, "service", version, false)
+		require.NoError(t, err)
+		templates, err := pinned.Templates()
+		require.NoError(t, err)
+		require.Len(t, templates, 1)
+		assert.Equal(t, "Version one", templates[0].Description)
+		require.NoError(t, pinned.Delete())
+	}
+
+	_, err = retrieveSourceTemplates(sources, "service", "2.0.0", false)
+	assert.Error(t, err)
+
+	// Offline, the cached clone is used.
+	offline, err := retrieveSourceTemplates(sources, "service", "", true)
+	require.NoError(t, err)
+	assert.Equal(t, latest.SubDirectory, offline.SubDirectory)
+}
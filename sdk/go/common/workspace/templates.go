@@ -77,18 +77,57 @@ type TemplateRepository struct {
 	Root         string // The full path to the root directory of the repository.
 	SubDirectory string // The full path to the sub directory within the repository.
 	ShouldDelete bool   // Whether the root directory should be deleted.
+	Source       string // The name of the template source the repository was retrieved from, if any.
+
+	// Repositories are the repositories of all of the configured template sources, when listing their templates.
+	Repositories []TemplateRepository
 }
 
 // Delete deletes the template repository.
 func (repo TemplateRepository) Delete() error {
+	for _, r := range repo.Repositories {
+		if err := r.Delete(); err != nil {
+			return err
+		}
+	}
 	if repo.ShouldDelete {
 		return os.RemoveAll(repo.Root)
 	}
 	return nil
 }
 
-// Templates lists the templates in the repository.
+// Templates lists the templates in the repository. If the repository aggregates template sources, a template that
+// has the same name as one in an earlier source is omitted.
 func (repo TemplateRepository) Templates() ([]Template, error) {
+	if len(repo.Repositories) > 0 {
+		var result []Template
+		seen := make(map[string]bool)
+		for _, r := range repo.Repositories {
+			templates, err := r.Templates()
+			if err != nil {
+				return nil, err
+			}
+			for _, template := range templates {
+				if !seen[template.Name] {
+					seen[template.Name] = true
+					result = append(result, template)
+				}
+			}
+		}
+		return result, nil
+	}
+
+	templates, err := repo.templates()
+	if err != nil {
+		return nil, err
+	}
+	for i := range templates {
+		templates[i].Source = repo.Source
+	}
+	return templates, nil
+}
+
+func (repo TemplateRepository) templates() ([]Template, error) {
 	path := repo.SubDirectory
 
 	info, err := os.Stat(path)
@@ -195,6 +234,10 @@ type Template struct {
 	Quickstart  string                                // Optional text to be displayed after template creation.
 	Config      map[string]ProjectTemplateConfigValue // Optional template config.
 	Important   bool                                  // Indicates whether the template should be listed by default.
+	Prompts     []ProjectTemplatePrompt               // Optional questions asked when the template is used.
+	Files       []ProjectTemplateFile                 // Optional rules that include or exclude files.
+	PostCreate  []string                              // Optional commands run after the project is created.
+	Source      string                                // The name of the template source, if any.
 
 	ProjectName        string // Name of the project.
 	ProjectDescription string // Optional description of the project.
@@ -258,7 +301,9 @@ func isTemplateFileOrDirectory(templateNamePathOrURL string) bool {
 	return err == nil
 }
 
-// RetrieveTemplates retrieves a "template repository" based on the specified name, path, or URL.
+// RetrieveTemplates retrieves a "template repository" based on the specified name, path, or URL. Names may be
+// pinned to a version, as in `<name>@<version>`. Project templates are retrieved from the template sources in
+// ~/.pulumi/templates.json, if any are configured, and from the public Pulumi templates otherwise.
 func RetrieveTemplates(templateNamePathOrURL string, offline bool,
 	templateKind TemplateKind) (TemplateRepository, error) {
 
@@ -268,9 +313,55 @@ func RetrieveTemplates(templateNamePathOrURL string, offline bool,
 	if isTemplateFileOrDirectory(templateNamePathOrURL) {
 		return retrieveFileTemplates(templateNamePathOrURL)
 	}
+
+	templateName, version := ParseTemplateVersion(templateNamePathOrURL)
+	if templateKind == TemplateKindPulumiProject {
+		sources, err := GetTemplateSources()
+		if err != nil {
+			return TemplateRepository{}, err
+		}
+		if len(sources) > 0 {
+			return retrieveSourceTemplates(sources, templateName, version, offline)
+		}
+	}
+	if version != "" {
+		return retrieveVersionedPulumiTemplates(templateName, version, offline, templateKind)
+	}
 	return retrievePulumiTemplates(templateNamePathOrURL, offline, templateKind)
 }
 
+// retrieveVersionedPulumiTemplates retrieves the "template repository" for a Pulumi template at the given version,
+// i.e. a tag of the Pulumi templates repository.
+func retrieveVersionedPulumiTemplates(templateName, version string, offline bool,
+	templateKind TemplateKind) (TemplateRepository, error) {
+
+	source := TemplateSource{Name: "pulumi", Kind: TemplateSourceGit, URL: pulumiTemplateGitRepository}
+	if templateKind == TemplateKindPolicyPack {
+		source.URL = pulumiPolicyTemplateGitRepository
+	}
+	repo, err := source.retrieve(version, offline)
+	if err != nil {
+		if err == errTemplateVersionNotFound {
+			return TemplateRepository{}, errors.Errorf("template version %s not found", version)
+		}
+		return TemplateRepository{}, err
+	}
+	repo.Source = ""
+
+	if templateName != "" {
+		subDir := filepath.Join(repo.SubDirectory, strings.ToLower(templateName))
+		if _, err = os.Stat(subDir); err != nil {
+			if os.IsNotExist(err) {
+				err = newTemplateNotFoundError(repo.SubDirectory, templateName)
+			}
+			contract.IgnoreError(repo.Delete())
+			return TemplateRepository{}, err
+		}
+		repo.SubDirectory = subDir
+	}
+	return repo, nil
+}
+
 // retrieveURLTemplates retrieves the "template repository" at the specified URL.
 func retrieveURLTemplates(rawurl string, offline bool, templateKind TemplateKind) (TemplateRepository, error) {
 	if offline {
@@ -425,6 +516,12 @@ func LoadTemplate(path string) (Template, error) {
 		template.Quickstart = proj.Template.Quickstart
 		template.Config = proj.Template.Config
 		template.Important = proj.Template.Important
+		template.Prompts = proj.Template.Prompts
+		template.Files = proj.Template.Files
+		template.PostCreate = proj.Template.PostCreate
+		if err = validateTemplatePrompts(template.Prompts); err != nil {
+			return Template{}, errors.Wrapf(err, "loading template %s", path)
+		}
 	}
 	if proj.Description != nil {
 		template.ProjectDescription = *proj.Description
@@ -436,8 +533,19 @@ func LoadTemplate(path string) (Template, error) {
 // CopyTemplateFilesDryRun does a dry run of copying a template to a destination directory,
 // to ensure it won't overwrite any files.
 func CopyTemplateFilesDryRun(sourceDir, destDir, projectName string) error {
+	return copyTemplateFilesDryRun(sourceDir, destDir, projectName, nil)
+}
+
+// CopyTemplateFiles does the actual copy operation to a destination directory.
+func CopyTemplateFiles(
+	sourceDir, destDir string, force bool, projectName string, projectDescription string) error {
+
+	return copyTemplateFiles(sourceDir, destDir, force, projectName, projectDescription, nil)
+}
+
+func copyTemplateFilesDryRun(sourceDir, destDir, projectName string, renderer *templateRenderer) error {
 	var existing []string
-	if err := walkFiles(sourceDir, destDir, projectName,
+	if err := walkFiles(sourceDir, destDir, projectName, renderer,
 		func(info os.FileInfo, source string, dest string) error {
 			if destInfo, statErr := os.Stat(dest); statErr == nil && !destInfo.IsDir() {
 				existing = append(existing, filepath.Base(dest))
@@ -453,11 +561,10 @@ func CopyTemplateFilesDryRun(sourceDir, destDir, projectName string) error {
 	return nil
 }
 
-// CopyTemplateFiles does the actual copy operation to a destination directory.
-func CopyTemplateFiles(
-	sourceDir, destDir string, force bool, projectName string, projectDescription string) error {
+func copyTemplateFiles(sourceDir, destDir string, force bool, projectName string, projectDescription string,
+	renderer *templateRenderer) error {
 
-	return walkFiles(sourceDir, destDir, projectName,
+	return walkFiles(sourceDir, destDir, projectName, renderer,
 		func(info os.FileInfo, source string, dest string) error {
 			if info.IsDir() {
 				// Create the destination directory.
@@ -474,6 +581,11 @@ func CopyTemplateFiles(
 			result := b
 			if !isBinary(b) {
 				transformed := transform(string(b), projectName, projectDescription)
+				if renderer != nil && strings.HasSuffix(source, templateFileSuffix) {
+					if transformed, err = renderer.render(source, transformed); err != nil {
+						return err
+					}
+				}
 				result = []byte(transformed)
 			}
 
@@ -638,8 +750,9 @@ func getValidProjectName(name string) string {
 }
 
 // walkFiles is a helper that walks the directories/files in a source directory
-// and performs an action for each item.
-func walkFiles(sourceDir string, destDir string, projectName string,
+// and performs an action for each item. If a renderer is given, it determines
+// the items' destination names and which items are included.
+func walkFiles(sourceDir string, destDir string, projectName string, renderer *templateRenderer,
 	actionFn func(info os.FileInfo, source string, dest string) error) error {
 
 	contract.Require(sourceDir != "", "sourceDir")
@@ -655,25 +768,31 @@ func walkFiles(sourceDir string, destDir string, projectName string,
 		source := filepath.Join(sourceDir, name)
 		dest := filepath.Join(destDir, name)
 
-		if info.IsDir() {
-			// Ignore the .git directory.
-			if name == GitDir {
+		if info.IsDir() && name == GitDir || !info.IsDir() && name == legacyPulumiTemplateManifestFile {
+			// Ignore the .git directory and the legacy template manifest.
+			continue
+		}
+
+		if renderer != nil {
+			destName, include, err := renderer.destName(source, name, info.IsDir())
+			if err != nil {
+				return err
+			}
+			if !include {
 				continue
 			}
+			dest = filepath.Join(destDir, destName)
+		}
 
+		if info.IsDir() {
 			if err := actionFn(info, source, dest); err != nil {
 				return err
 			}
 
-			if err := walkFiles(source, dest, projectName, actionFn); err != nil {
+			if err := walkFiles(source, dest, projectName, renderer, actionFn); err != nil {
 				return err
 			}
 		} else {
-			// Ignore the legacy template manifest.
-			if name == legacyPulumiTemplateManifestFile {
-				continue
-			}
-
 			// The file name may contain a placeholder for project name: replace it with the actual value.
 			newDest := transform(dest, projectName, "")
 
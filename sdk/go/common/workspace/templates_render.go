@@ -0,0 +1,313 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"bytes"
+	"path"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// templateFileSuffix is the suffix of template files whose contents are rendered as Go templates. The suffix is
+	// removed from the names of the files that are created.
+	templateFileSuffix = ".tmpl"
+
+	// The types of template prompts.
+	templatePromptString = "string"
+	templatePromptBool   = "bool"
+	templatePromptChoice = "choice"
+)
+
+// templatePromptNameRegexp matches valid template prompt names, which must be usable as Go template fields.
+var templatePromptNameRegexp = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+// TemplateValues are the values that a template's rendered files, file names, file rules, and post-create commands
+// may refer to: the project's name as `.Project`, its description as `.Description`, and the answers to the
+// template's prompts by name.
+type TemplateValues map[string]interface{}
+
+// NewTemplateValues returns template values for the given project name and description, to which the answers to the
+// template's prompts may be added.
+func NewTemplateValues(projectName, projectDescription string) TemplateValues {
+	return TemplateValues{"Project": projectName, "Description": projectDescription}
+}
+
+// ParseValue parses an answer to the prompt, returning an error if it is not valid. `bool` answers are parsed into
+// booleans, and other answers are returned as strings.
+func (p ProjectTemplatePrompt) ParseValue(s string) (interface{}, error) {
+	switch p.Type {
+	case templatePromptBool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, errors.New("expected true or false")
+		}
+		return b, nil
+	case templatePromptChoice:
+		for _, choice := range p.Choices {
+			if s == choice {
+				return s, nil
+			}
+		}
+		return nil, errors.Errorf("expected one of %s", strings.Join(p.Choices, ", "))
+	default:
+		if p.Pattern != "" {
+			re, err := regexp.Compile(p.Pattern)
+			if err != nil {
+				return nil, err
+			}
+			if !re.MatchString(s) {
+				return nil, errors.Errorf("must match %s", p.Pattern)
+			}
+		}
+		return s, nil
+	}
+}
+
+// validateTemplatePrompts checks that a template's prompts are well-formed.
+func validateTemplatePrompts(prompts []ProjectTemplatePrompt) error {
+	seen := make(map[string]bool)
+	for _, p := range prompts {
+		if !templatePromptNameRegexp.MatchString(p.Name) {
+			return errors.Errorf("template prompt name %q must start with a letter or underscore and contain only "+
+				"letters, digits, and underscores", p.Name)
+		}
+		if p.Name == "Project" || p.Name == "Description" {
+			return errors.Errorf("template prompt name %q is reserved", p.Name)
+		}
+		if seen[p.Name] {
+			return errors.Errorf("duplicate template prompt %q", p.Name)
+		}
+		seen[p.Name] = true
+
+		switch p.Type {
+		case "", templatePromptString:
+			if _, err := regexp.Compile(p.Pattern); err != nil {
+				return errors.Wrapf(err, "invalid pattern for template prompt %q", p.Name)
+			}
+		case templatePromptBool:
+		case templatePromptChoice:
+			if len(p.Choices) == 0 {
+				return errors.Errorf("template prompt %q has no choices", p.Name)
+			}
+		default:
+			return errors.Errorf("template prompt %q has unknown type %q; expected string, bool, or choice",
+				p.Name, p.Type)
+		}
+		if p.Default != "" {
+			if _, err := p.ParseValue(p.Default); err != nil {
+				return errors.Wrapf(err, "invalid default for template prompt %q", p.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// CopyFilesDryRun does a dry run of copying the template's files to a destination directory, to ensure it won't
+// overwrite any files.
+func (template Template) CopyFilesDryRun(destDir string, values TemplateValues) error {
+	return copyTemplateFilesDryRun(template.Dir, destDir, values.projectName(), template.renderer(values))
+}
+
+// CopyStaticFilesDryRun does a dry run of copying the template's files to a destination directory, to ensure it won't
+// overwrite any files, before the template's values are known. Only the files whose names and inclusion don't depend
+// on the values are checked; files with templated names or that match a file rule are checked by CopyFilesDryRun.
+func (template Template) CopyStaticFilesDryRun(destDir, projectName string) error {
+	renderer := &templateRenderer{root: template.Dir, files: template.Files, static: true}
+	return copyTemplateFilesDryRun(template.Dir, destDir, projectName, renderer)
+}
+
+// CopyFiles copies the template's files to a destination directory. Files and directories that the template's file
+// rules exclude, or whose rendered names are empty, are skipped. File and directory names are rendered as Go
+// templates, as are the contents of files whose names end in `.tmpl`, a suffix that is removed.
+func (template Template) CopyFiles(destDir string, force bool, values TemplateValues) error {
+	return copyTemplateFiles(template.Dir, destDir, force, values.projectName(), values.projectDescription(),
+		template.renderer(values))
+}
+
+// PostCreateCommands returns the template's post-create commands, rendered as Go templates. Commands that render to
+// an empty string are omitted, so commands may be made conditional with `{{ if }}`.
+//
+// Because the commands are run by the system shell, string values are quoted for the shell before they are rendered,
+// so that an answer is always passed to a command as a single argument. The answers to `choice` prompts are not
+// quoted, as they are one of the template's own choices.
+func (template Template) PostCreateCommands(values TemplateValues) ([]string, error) {
+	quoted, err := template.shellQuotedValues(values, runtime.GOOS)
+	if err != nil {
+		return nil, err
+	}
+
+	renderer := template.renderer(quoted)
+	var commands []string
+	for i, command := range template.PostCreate {
+		rendered, err := renderer.render("postCreate["+strconv.Itoa(i)+"]", command)
+		if err != nil {
+			return nil, err
+		}
+		if rendered = strings.TrimSpace(rendered); rendered != "" {
+			commands = append(commands, rendered)
+		}
+	}
+	return commands, nil
+}
+
+// shellQuotedValues returns a copy of the values in which every string, other than the answers to `choice` prompts, is
+// quoted for the shell that runs post-create commands on the given OS.
+func (template Template) shellQuotedValues(values TemplateValues, goos string) (TemplateValues, error) {
+	choices := make(map[string]bool)
+	for _, p := range template.Prompts {
+		if p.Type == templatePromptChoice {
+			choices[p.Name] = true
+		}
+	}
+
+	quoted := make(TemplateValues, len(values))
+	for name, value := range values {
+		s, ok := value.(string)
+		if !ok || choices[name] {
+			quoted[name] = value
+			continue
+		}
+		q, err := shellQuote(s, goos)
+		if err != nil {
+			return nil, errors.Wrapf(err, "template value %q cannot be used in a post-create command", name)
+		}
+		quoted[name] = q
+	}
+	return quoted, nil
+}
+
+// shellQuote quotes s as a single argument for `sh`, or for `cmd` on Windows. `cmd` has no way to quote double quotes,
+// percent signs, or line breaks, so strings that contain them are rejected on Windows.
+func shellQuote(s, goos string) (string, error) {
+	if goos == "windows" {
+		if strings.ContainsAny(s, "\"%\r\n") {
+			return "", errors.New("it contains a double quote, percent sign, or line break")
+		}
+		return `"` + s + `"`, nil
+	}
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'", nil
+}
+
+func (template Template) renderer(values TemplateValues) *templateRenderer {
+	return &templateRenderer{root: template.Dir, files: template.Files, values: values}
+}
+
+func (values TemplateValues) projectName() string {
+	name, _ := values["Project"].(string)
+	return name
+}
+
+func (values TemplateValues) projectDescription() string {
+	description, _ := values["Description"].(string)
+	return description
+}
+
+// templateRenderer renders a template's files, file names, and file rules with a set of values.
+type templateRenderer struct {
+	root   string                // the template's directory.
+	files  []ProjectTemplateFile // the template's file rules.
+	values TemplateValues        // the values to render with.
+	static bool                  // true to skip the files and directories whose names or inclusion need the values.
+}
+
+// render renders text as a Go template. The name identifies the text in errors.
+func (r *templateRenderer) render(name, text string) (string, error) {
+	t, err := template.New(name).Option("missingkey=error").Funcs(template.FuncMap{
+		"lower":   strings.ToLower,
+		"upper":   strings.ToUpper,
+		"replace": func(s, old, new string) string { return strings.Replace(s, old, new, -1) },
+	}).Parse(text)
+	if err != nil {
+		return "", errors.Wrap(err, "parsing template")
+	}
+	var buf bytes.Buffer
+	if err = t.Execute(&buf, map[string]interface{}(r.values)); err != nil {
+		return "", errors.Wrap(err, "rendering template")
+	}
+	return buf.String(), nil
+}
+
+// destName returns the name of the file or directory to create for the given template file or directory, and whether
+// it should be created at all.
+func (r *templateRenderer) destName(source, name string, isDir bool) (string, bool, error) {
+	rel, err := filepath.Rel(r.root, source)
+	if err != nil {
+		return "", false, err
+	}
+	rel = filepath.ToSlash(rel)
+
+	for _, rule := range r.files {
+		if !templateFileRuleMatches(rule.Path, rel) {
+			continue
+		}
+		if r.static {
+			return "", false, nil
+		}
+		condition, err := r.render(rule.Path, rule.If)
+		if err != nil {
+			return "", false, err
+		}
+		switch strings.ToLower(strings.TrimSpace(condition)) {
+		case "", "false", "0", "no":
+			return "", false, nil
+		}
+	}
+
+	if strings.Contains(name, "{{") {
+		if r.static {
+			return "", false, nil
+		}
+		rendered, err := r.render(rel, name)
+		if err != nil {
+			return "", false, err
+		}
+		if strings.TrimSpace(rendered) == "" {
+			return "", false, nil
+		}
+		// A rendered name must name a single file or directory, so that values can't place files outside of it.
+		if strings.ContainsAny(rendered, `/\`) || rendered == "." || rendered == ".." {
+			return "", false, errors.Errorf("template file name %q rendered to %q, which is not a single file name",
+				rel, rendered)
+		}
+		name = rendered
+	}
+	if !isDir {
+		name = strings.TrimSuffix(name, templateFileSuffix)
+	}
+	return name, true, nil
+}
+
+// templateFileRuleMatches returns true if a file rule's pattern matches the given slash-separated path. A pattern that
+// ends in `/**` matches a directory and everything in it.
+func templateFileRuleMatches(pattern, rel string) bool {
+	if dir := strings.TrimSuffix(pattern, "/**"); dir != pattern {
+		for parent := rel; parent != "."; parent = path.Dir(parent) {
+			if matched, _ := path.Match(dir, parent); matched {
+				return true
+			}
+		}
+		return false
+	}
+	matched, _ := path.Match(pattern, rel)
+	return matched
+}
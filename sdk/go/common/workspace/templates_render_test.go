@@ -0,0 +1,208 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspace
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplatePromptParseValue(t *testing.T) {
+	b := ProjectTemplatePrompt{Name: "useVpc", Type: "bool"}
+	v, err := b.ParseValue("true")
+	assert.NoError(t, err)
+	assert.Equal(t, true, v)
+	_, err = b.ParseValue("maybe")
+	assert.Error(t, err)
+
+	c := ProjectTemplatePrompt{Name: "cloud", Type: "choice", Choices: []string{"aws", "azure"}}
+	v, err = c.ParseValue("azure")
+	assert.NoError(t, err)
+	assert.Equal(t, "azure", v)
+	_, err = c.ParseValue("gcp")
+	assert.Error(t, err)
+
+	s := ProjectTemplatePrompt{Name: "region", Pattern: "^[a-z]+-[a-z]+-[0-9]$"}
+	v, err = s.ParseValue("us-west-2")
+	assert.NoError(t, err)
+	assert.Equal(t, "us-west-2", v)
+	_, err = s.ParseValue("nowhere")
+	assert.Error(t, err)
+}
+
+func TestValidateTemplatePrompts(t *testing.T) {
+	assert.NoError(t, validateTemplatePrompts([]ProjectTemplatePrompt{
+		{Name: "region", Default: "us-west-2"},
+		{Name: "useVpc", Type: "bool", Default: "false"},
+		{Name: "cloud", Type: "choice", Choices: []string{"aws", "azure"}, Default: "aws"},
+	}))
+
+	invalid := [][]ProjectTemplatePrompt{
+		{{Name: "not-a-field"}},
+		{{Name: "Project"}},
+		{{Name: "a"}, {Name: "a"}},
+		{{Name: "a", Type: "number"}},
+		{{Name: "a", Type: "choice"}},
+		{{Name: "a", Pattern: "("}},
+		{{Name: "a", Type: "bool", Default: "maybe"}},
+	}
+	for _, prompts := range invalid {
+		assert.Error(t, validateTemplatePrompts(prompts), "%v", prompts)
+	}
+}
+
+func TestTemplateCopyFiles(t *testing.T) {
+	source := t.TempDir()
+	write := func(name, contents string) {
+		path := filepath.Join(source, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	}
+	write("README.md.tmpl", "# {{ .Project }} in {{ .region }}{{ if .useVpc }} with a VPC{{ end }}\n")
+	write("plain.txt", "{{ .Project }}\n")
+	write("{{ .cloud }}.ts", "export {};\n")
+	write("{{ if .useVpc }}vpc.ts{{ end }}", "export {};\n")
+	write("aws/index.ts", "export {};\n")
+	write("azure/index.ts", "export {};\n")
+
+	template := Template{
+		Dir: source,
+		Files: []ProjectTemplateFile{
+			{Path: "aws/**", If: `{{ eq .cloud "aws" }}`},
+			{Path: "azure/**", If: `{{ eq .cloud "azure" }}`},
+		},
+		PostCreate: []string{"npm install", "{{ if .useVpc }}echo {{ .region }}{{ end }}"},
+	}
+	values := NewTemplateValues("proj", "A project")
+	values["region"] = "us-west-2"
+	values["useVpc"] = false
+	values["cloud"] = "azure"
+
+	dest := t.TempDir()
+	require.NoError(t, template.CopyFilesDryRun(dest, values))
+	require.NoError(t, template.CopyFiles(dest, false, values))
+
+	readme, err := ioutil.ReadFile(filepath.Join(dest, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# proj in us-west-2\n", string(readme))
+	plain, err := ioutil.ReadFile(filepath.Join(dest, "plain.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "{{ .Project }}\n", string(plain))
+
+	assert.FileExists(t, filepath.Join(dest, "azure.ts"))
+	assert.FileExists(t, filepath.Join(dest, "azure", "index.ts"))
+	assert.NoDirExists(t, filepath.Join(dest, "aws"))
+	assert.NoFileExists(t, filepath.Join(dest, "vpc.ts"))
+
+	commands, err := template.PostCreateCommands(values)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"npm install"}, commands)
+
+	values["useVpc"] = true
+	commands, err = template.PostCreateCommands(values)
+	require.NoError(t, err)
+	region, err := shellQuote("us-west-2", runtime.GOOS)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"npm install", "echo " + region}, commands)
+
+	// Existing files are only overwritten with force.
+	assert.Error(t, template.CopyFilesDryRun(dest, values))
+
+	// Referring to a value that doesn't exist is an error.
+	delete(values, "region")
+	assert.Error(t, template.CopyFiles(t.TempDir(), false, values))
+}
+
+func TestTemplatePostCreateCommandsQuoteValues(t *testing.T) {
+	template := Template{
+		Prompts:    []ProjectTemplatePrompt{{Name: "cloud", Type: "choice", Choices: []string{"aws", "azure"}}},
+		PostCreate: []string{`echo {{ .Description }} {{ .cloud }}{{ if eq .cloud "aws" }} aws{{ end }}`},
+	}
+	values := NewTemplateValues("proj", "it's $(whoami) %USERNAME%")
+	values["cloud"] = "aws"
+
+	quoted, err := template.shellQuotedValues(values, "linux")
+	require.NoError(t, err)
+	assert.Equal(t, `'proj'`, quoted["Project"])
+	assert.Equal(t, `'it'\''s $(whoami) %USERNAME%'`, quoted["Description"])
+	assert.Equal(t, "aws", quoted["cloud"])
+
+	_, err = template.shellQuotedValues(values, "windows")
+	assert.Error(t, err)
+	values["Description"] = "a & b"
+	quoted, err = template.shellQuotedValues(values, "windows")
+	require.NoError(t, err)
+	assert.Equal(t, `"a & b"`, quoted["Description"])
+
+	// Choice answers are left as is, so they can be compared in conditions.
+	commands, err := template.PostCreateCommands(values)
+	require.NoError(t, err)
+	description, err := shellQuote("a & b", runtime.GOOS)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"echo " + description + " aws aws"}, commands)
+}
+
+func TestTemplateCopyFilesRejectsRenderedPaths(t *testing.T) {
+	source := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(source, "{{ .name }}"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(source, "{{ .name }}", "index.ts"), []byte("export {};\n"), 0600))
+	template := Template{Dir: source}
+
+	for _, name := range []string{"../escape", "a/b", `a\b`, "..", "."} {
+		values := NewTemplateValues("proj", "A project")
+		values["name"] = name
+		dest := t.TempDir()
+		assert.Error(t, template.CopyFilesDryRun(dest, values), name)
+		assert.Error(t, template.CopyFiles(dest, false, values), name)
+	}
+
+	// Names that merely contain dots are allowed.
+	values := NewTemplateValues("proj", "A project")
+	values["name"] = "a..b"
+	dest := t.TempDir()
+	require.NoError(t, template.CopyFilesDryRun(dest, values))
+	require.NoError(t, template.CopyFiles(dest, false, values))
+	assert.FileExists(t, filepath.Join(dest, "a..b", "index.ts"))
+}
+
+func TestTemplateCopyStaticFilesDryRun(t *testing.T) {
+	source := t.TempDir()
+	for _, name := range []string{"index.ts", "{{ .cloud }}.ts", "vpc.ts"} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(source, name), []byte("export {};\n"), 0600))
+	}
+	template := Template{
+		Dir:   source,
+		Files: []ProjectTemplateFile{{Path: "vpc.ts", If: "{{ .useVpc }}"}},
+	}
+
+	// Files whose names or inclusion depend on values are only checked once the values are known.
+	dest := t.TempDir()
+	for _, name := range []string{"{{ .cloud }}.ts", "vpc.ts"} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dest, name), []byte("\n"), 0600))
+	}
+	assert.NoError(t, template.CopyStaticFilesDryRun(dest, "proj"))
+
+	// Static files are checked before the values are known.
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dest, "index.ts"), []byte("\n"), 0600))
+	err := template.CopyStaticFilesDryRun(dest, "proj")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "index.ts")
+}
@@ -308,6 +308,156 @@ func (ctx *Context) Invoke(tok string, args interface{}, result interface{}, opt
 	return nil
 }
 
+// Call will invoke a provider's method, identified by its token tok, on the resource self. The result of the call is
+// returned asynchronously as an output of the same type as output, which is only used to determine the type of the
+// result.
+//
+// args must be a struct or map that implements Input. If self is non-nil, it is passed to the method as the
+// `__self__` argument.
+func (ctx *Context) Call(tok string, args Input, output Output, self Resource, opts ...InvokeOption) (Output, error) {
+	if tok == "" {
+		return nil, errors.New("call token must not be empty")
+	}
+	if output == nil {
+		return nil, errors.New("output must not be nil")
+	}
+
+	options := &invokeOptions{}
+	for _, o := range opts {
+		if o != nil {
+			o.applyInvokeOption(options)
+		}
+	}
+
+	// Note that we're about to make an outstanding RPC request, so that we can rendezvous during shutdown.
+	if err := ctx.beginRPC(); err != nil {
+		return nil, err
+	}
+
+	var deps []Resource
+	if self != nil {
+		deps = append(deps, self)
+	}
+	result := ctx.newOutput(reflect.TypeOf(output), deps...)
+
+	prepareCallRequest := func() (*pulumirpc.CallRequest, error) {
+		var providerRef string
+		parent := options.Parent
+		if parent == nil {
+			parent = self
+		}
+		if provider := mergeProviders(tok, parent, options.Provider, nil)[getPackage(tok)]; provider != nil {
+			pr, err := ctx.resolveProviderReference(provider)
+			if err != nil {
+				return nil, err
+			}
+			providerRef = pr
+		}
+
+		// Serialize all args, first by awaiting them, and then marshaling them to the requisite gRPC values.
+		resolvedArgs, argDeps, _, err := marshalInputs(args)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling args: %w", err)
+		}
+		if self != nil {
+			selfRef, _, err := marshalInput(self, anyType, true)
+			if err != nil {
+				return nil, fmt.Errorf("marshaling self: %w", err)
+			}
+			resolvedArgs["__self__"] = selfRef
+		}
+
+		rpcArgs, err := plugin.MarshalProperties(
+			resolvedArgs,
+			plugin.MarshalOptions{KeepUnknowns: ctx.DryRun(), KeepSecrets: true, KeepResources: true},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling args: %w", err)
+		}
+
+		// Convert the arg dependencies map for RPC and remove duplicates.
+		rpcArgDeps := make(map[string]*pulumirpc.CallRequest_ArgumentDependencies)
+		for k, deps := range argDeps {
+			rpcArgDeps[k] = &pulumirpc.CallRequest_ArgumentDependencies{
+				Urns: sortedURNs(deps),
+			}
+		}
+
+		return &pulumirpc.CallRequest{
+			Tok:             tok,
+			Args:            rpcArgs,
+			ArgDependencies: rpcArgDeps,
+			Provider:        providerRef,
+			Version:         options.Version,
+		}, nil
+	}
+
+	// Kick off the call asynchronously so that the arguments may be awaited.
+	go func() {
+		var err error
+		defer func() {
+			if err != nil {
+				result.getState().reject(err)
+			}
+			ctx.endRPC(err)
+		}()
+
+		req, err := prepareCallRequest()
+		if err != nil {
+			return
+		}
+
+		logging.V(9).Infof("Call(%s, #args=%d): RPC call being made asynchronously", tok, len(req.Args.GetFields()))
+		resp, err := ctx.monitor.Call(ctx.ctx, req)
+		if err != nil {
+			logging.V(9).Infof("Call(%s, ...): error: %v", tok, err)
+			return
+		}
+
+		// If there were any failures from the provider, return them.
+		if len(resp.Failures) > 0 {
+			logging.V(9).Infof("Call(%s, ...): success: w/ %d failures", tok, len(resp.Failures))
+			for _, failure := range resp.Failures {
+				err = multierror.Append(err,
+					fmt.Errorf("%s call failed: %s (%s)", tok, failure.Reason, failure.Property))
+			}
+			return
+		}
+
+		// Otherwise, unmarshal the returned properties into the result.
+		keepUnknowns := ctx.DryRun()
+		outProps, err := plugin.UnmarshalProperties(
+			resp.Return,
+			plugin.MarshalOptions{KeepSecrets: true, KeepResources: true, KeepUnknowns: keepUnknowns},
+		)
+		if err != nil {
+			return
+		}
+
+		// The result is unknown if any of the returned properties are unknown.
+		known := !resource.NewObjectProperty(outProps).ContainsUnknowns()
+
+		dest := reflect.New(result.ElementType()).Elem()
+		secret, err := unmarshalOutput(ctx, resource.NewObjectProperty(outProps), dest)
+		if err != nil {
+			return
+		}
+
+		// Record the dependencies of the returned values.
+		var returnDeps []Resource
+		for _, urns := range resp.GetReturnDependencies() {
+			for _, urn := range urns.GetUrns() {
+				returnDeps = append(returnDeps, ctx.newDependencyResource(URN(urn)))
+			}
+		}
+
+		result.getState().resolve(dest.Interface(), known, secret, returnDeps)
+		logging.V(9).Infof("Call(%s, ...): success: w/ %d outs", tok, len(outProps))
+	}()
+
+	return result, nil
+}
+
 // ReadResource reads an existing custom resource's state from the resource monitor. t is the fully qualified type
 // token and name is the "name" part to use in creating a stable and globally unique URN for the object. id is the ID
 // of the resource to read, and props contains any state necessary to perform the read (typically props will be nil).
@@ -548,6 +698,9 @@ func (ctx *Context) registerResource(
 		return err
 	}
 
+	// Record the position of the user code that is registering the resource before we leave its goroutine.
+	sourcePosition := callerSourcePosition()
+
 	// Note that we're about to make an outstanding RPC request, so that we can rendezvous during shutdown.
 	if err := ctx.beginRPC(); err != nil {
 		return err
@@ -610,6 +763,7 @@ func (ctx *Context) registerResource(
 				AdditionalSecretOutputs: inputs.additionalSecretOutputs,
 				Version:                 inputs.version,
 				Remote:                  remote,
+				SourcePosition:          sourcePosition,
 			})
 			if err != nil {
 				logging.V(9).Infof("RegisterResource(%s, %s): error: %v", t, name, err)
@@ -963,18 +1117,8 @@ func (ctx *Context) prepareResourceInputs(props Input, t string, opts *resourceO
 	// Convert the property dependencies map for RPC and remove duplicates.
 	rpcPropertyDeps := make(map[string]*pulumirpc.RegisterResourceRequest_PropertyDependencies)
 	for k, deps := range propertyDeps {
-		sort.Slice(deps, func(i, j int) bool { return deps[i] < deps[j] })
-
-		urns := make([]string, 0, len(deps))
-		for i, d := range deps {
-			if i > 0 && urns[i-1] == string(d) {
-				continue
-			}
-			urns = append(urns, string(d))
-		}
-
 		rpcPropertyDeps[k] = &pulumirpc.RegisterResourceRequest_PropertyDependencies{
-			Urns: urns,
+			Urns: sortedURNs(deps),
 		}
 	}
 
@@ -1111,6 +1255,20 @@ func (ctx *Context) resolveProviderReference(provider ProviderResource) (string,
 	return string(urn) + "::" + string(id), nil
 }
 
+// sortedURNs returns the given dependency URNs sorted and with duplicates removed, for use in RPC requests.
+func sortedURNs(deps []URN) []string {
+	sort.Slice(deps, func(i, j int) bool { return deps[i] < deps[j] })
+
+	urns := make([]string, 0, len(deps))
+	for _, d := range deps {
+		if len(urns) > 0 && urns[len(urns)-1] == string(d) {
+			continue
+		}
+		urns = append(urns, string(d))
+	}
+	return urns
+}
+
 // noMoreRPCs is a sentinel value used to stop subsequent RPCs from occurring.
 const noMoreRPCs = -1
 
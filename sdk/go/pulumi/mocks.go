@@ -53,6 +53,52 @@ type MockResourceArgs struct {
 	Custom bool
 }
 
+// MockResourceState returns the ID and output properties of a mocked resource, for use in the NewResource method of a
+// MockResourceMonitor. The output properties are the resource's inputs overlaid with the non-nil fields of state, which
+// must be a struct whose fields are tagged with `pulumi:"name"` (e.g. the typed resource mocks generated for a
+// package). If id is empty, the ID of the resource being read, if any, or the resource's name suffixed with "_id" is
+// used.
+func MockResourceState(args MockResourceArgs, id string, state interface{}) (string, resource.PropertyMap, error) {
+	props, err := marshalMockProperties(state)
+	if err != nil {
+		return "", nil, err
+	}
+
+	outputs := args.Inputs.Copy()
+	for k, v := range props {
+		outputs[k] = v
+	}
+
+	if id == "" {
+		id = args.ID
+	}
+	if id == "" {
+		id = args.Name + "_id"
+	}
+	return id, outputs, nil
+}
+
+// MockCallResult returns the outputs of a mocked function call, for use in the Call method of a MockResourceMonitor.
+// The result must be a struct whose fields are tagged with `pulumi:"name"` (e.g. a function's generated result type).
+func MockCallResult(result interface{}) (resource.PropertyMap, error) {
+	return marshalMockProperties(result)
+}
+
+func marshalMockProperties(v interface{}) (resource.PropertyMap, error) {
+	value, _, err := marshalInput(v, anyType, false)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case value.IsNull():
+		return resource.PropertyMap{}, nil
+	case value.IsObject():
+		return value.ObjectValue(), nil
+	default:
+		return nil, errors.Errorf("expected a struct, not %T", v)
+	}
+}
+
 type mockMonitor struct {
 	project   string
 	stack     string
@@ -135,6 +181,39 @@ func (m *mockMonitor) StreamInvoke(ctx context.Context, in *pulumirpc.InvokeRequ
 	panic("not implemented")
 }
 
+func (m *mockMonitor) Call(ctx context.Context, in *pulumirpc.CallRequest,
+	opts ...grpc.CallOption) (*pulumirpc.CallResponse, error) {
+
+	args, err := plugin.UnmarshalProperties(in.GetArgs(), plugin.MarshalOptions{
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resultV, err := m.mocks.Call(MockCallArgs{
+		Token:    in.GetTok(),
+		Args:     args,
+		Provider: in.GetProvider(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := plugin.MarshalProperties(resultV, plugin.MarshalOptions{
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pulumirpc.CallResponse{
+		Return: result,
+	}, nil
+}
+
 func (m *mockMonitor) ReadResource(ctx context.Context, in *pulumirpc.ReadResourceRequest,
 	opts ...grpc.CallOption) (*pulumirpc.ReadResourceResponse, error) {
 
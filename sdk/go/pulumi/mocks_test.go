@@ -0,0 +1,67 @@
+package pulumi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+type mockBucketState struct {
+	ID     string
+	Arn    *string           `pulumi:"arn"`
+	Region *string           `pulumi:"region"`
+	Tags   map[string]string `pulumi:"tags"`
+}
+
+func TestMockResourceState(t *testing.T) {
+	arn := "arn:aws:s3:::bucket"
+	args := MockResourceArgs{
+		TypeToken: "aws:s3/bucket:Bucket",
+		Name:      "bucket",
+		Inputs: resource.PropertyMap{
+			"arn":  resource.NewStringProperty("ignored"),
+			"acl":  resource.NewStringProperty("private"),
+			"tags": resource.NewObjectProperty(resource.PropertyMap{}),
+		},
+	}
+
+	id, state, err := MockResourceState(args, "", mockBucketState{
+		Arn:  &arn,
+		Tags: map[string]string{"env": "test"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "bucket_id", id)
+	assert.Equal(t, resource.PropertyMap{
+		"arn": resource.NewStringProperty(arn),
+		"acl": resource.NewStringProperty("private"),
+		"tags": resource.NewObjectProperty(resource.PropertyMap{
+			"env": resource.NewStringProperty("test"),
+		}),
+	}, state)
+
+	// The inputs must not be modified.
+	assert.Equal(t, resource.NewStringProperty("ignored"), args.Inputs["arn"])
+
+	id, _, err = MockResourceState(args, "my-bucket", mockBucketState{})
+	assert.NoError(t, err)
+	assert.Equal(t, "my-bucket", id)
+
+	args.ID = "existing-bucket"
+	id, _, err = MockResourceState(args, "", mockBucketState{})
+	assert.NoError(t, err)
+	assert.Equal(t, "existing-bucket", id)
+}
+
+func TestMockCallResult(t *testing.T) {
+	result, err := MockCallResult(invokeResult{Foo: "oof", Baz: "zab"})
+	assert.NoError(t, err)
+	assert.Equal(t, resource.PropertyMap{
+		"foo": resource.NewStringProperty("oof"),
+		"baz": resource.NewStringProperty("zab"),
+	}, result)
+
+	_, err = MockCallResult("not a struct")
+	assert.Error(t, err)
+}
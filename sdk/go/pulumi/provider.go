@@ -17,7 +17,6 @@ package pulumi
 import (
 	"context"
 	"reflect"
-	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -141,18 +140,8 @@ func construct(ctx context.Context, req *pulumirpc.ConstructRequest, engineConn
 	// Convert the property dependencies map for RPC and remove duplicates.
 	rpcPropertyDeps := make(map[string]*pulumirpc.ConstructResponse_PropertyDependencies)
 	for k, deps := range propertyDeps {
-		sort.Slice(deps, func(i, j int) bool { return deps[i] < deps[j] })
-
-		urns := make([]string, 0, len(deps))
-		for i, d := range deps {
-			if i > 0 && urns[i-1] == string(d) {
-				continue
-			}
-			urns = append(urns, string(d))
-		}
-
 		rpcPropertyDeps[k] = &pulumirpc.ConstructResponse_PropertyDependencies{
-			Urns: urns,
+			Urns: sortedURNs(deps),
 		}
 	}
 
@@ -163,6 +152,105 @@ func construct(ctx context.Context, req *pulumirpc.ConstructRequest, engineConn
 	}, nil
 }
 
+type callFunc func(ctx *Context, tok string, args map[string]interface{}) (Input, error)
+
+// call adapts the gRPC CallRequest/CallResponse to/from the Pulumi Go SDK programming model.
+func call(ctx context.Context, req *pulumirpc.CallRequest, engineConn *grpc.ClientConn,
+	callF callFunc) (*pulumirpc.CallResponse, error) {
+
+	// Configure the RunInfo.
+	runInfo := RunInfo{
+		Project:     req.GetProject(),
+		Stack:       req.GetStack(),
+		Config:      req.GetConfig(),
+		Parallel:    int(req.GetParallel()),
+		DryRun:      req.GetDryRun(),
+		MonitorAddr: req.GetMonitorEndpoint(),
+		engineConn:  engineConn,
+	}
+	pulumiCtx, err := NewContext(ctx, runInfo)
+	if err != nil {
+		return nil, errors.Wrap(err, "constructing run context")
+	}
+
+	// Deserialize the args and apply appropriate dependencies.
+	argDependencies := req.GetArgDependencies()
+	deserializedArgs, err := plugin.UnmarshalProperties(
+		req.GetArgs(),
+		plugin.MarshalOptions{KeepSecrets: true, KeepResources: true, KeepUnknowns: req.GetDryRun()},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "unmarshaling args")
+	}
+	args := make(map[string]interface{}, len(deserializedArgs))
+	for key, value := range deserializedArgs {
+		k := string(key)
+		var deps []Resource
+		if argDeps, ok := argDependencies[k]; ok {
+			deps = make([]Resource, len(argDeps.GetUrns()))
+			for i, depURN := range argDeps.GetUrns() {
+				deps[i] = pulumiCtx.newDependencyResource(URN(depURN))
+			}
+		}
+
+		args[k] = &constructInput{
+			value: value,
+			deps:  deps,
+		}
+	}
+
+	result, err := callF(pulumiCtx, req.GetTok(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for async work to finish.
+	if err = pulumiCtx.wait(); err != nil {
+		return nil, err
+	}
+
+	// Serialize all result properties, first by awaiting them, and then marshaling them to the requisite gRPC values.
+	resolvedProps, propertyDeps, _, err := marshalInputs(result)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshaling properties")
+	}
+
+	// Marshal all properties for the RPC call.
+	keepUnknowns := req.GetDryRun()
+	rpcProps, err := plugin.MarshalProperties(
+		resolvedProps,
+		plugin.MarshalOptions{KeepSecrets: true, KeepUnknowns: keepUnknowns, KeepResources: pulumiCtx.keepResources})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshaling properties")
+	}
+
+	// Convert the property dependencies map for RPC and remove duplicates.
+	rpcPropertyDeps := make(map[string]*pulumirpc.CallResponse_ReturnDependencies)
+	for k, deps := range propertyDeps {
+		rpcPropertyDeps[k] = &pulumirpc.CallResponse_ReturnDependencies{
+			Urns: sortedURNs(deps),
+		}
+	}
+
+	return &pulumirpc.CallResponse{
+		Return:             rpcProps,
+		ReturnDependencies: rpcPropertyDeps,
+	}, nil
+}
+
+// callArgsSelf returns the resource that a method is being called on, which is passed as the `__self__` argument.
+func callArgsSelf(ctx *Context, args map[string]interface{}) (Resource, error) {
+	v, ok := args["__self__"]
+	if !ok {
+		return nil, errors.New("missing __self__ argument")
+	}
+	ci := v.(*constructInput)
+	if !ci.value.IsResourceReference() {
+		return nil, errors.New("__self__ argument must be a resource reference")
+	}
+	return unmarshalResourceReference(ctx, ci.value.ResourceReferenceValue())
+}
+
 type constructInput struct {
 	value resource.PropertyValue
 	deps  []Resource
@@ -80,6 +80,8 @@ func NewConstructResult(resource pulumi.ComponentResource) (*ConstructResult, er
 type constructFunc func(ctx *pulumi.Context, typ, name string, inputs map[string]interface{},
 	options pulumi.ResourceOption) (pulumi.URNInput, pulumi.Input, error)
 
+type callFunc func(ctx *pulumi.Context, tok string, args map[string]interface{}) (pulumi.Input, error)
+
 // linkedConstruct is made available here from ../provider_linked.go via go:linkname.
 func linkedConstruct(ctx context.Context, req *pulumirpc.ConstructRequest, engineConn *grpc.ClientConn,
 	constructF constructFunc) (*pulumirpc.ConstructResponse, error)
@@ -92,3 +94,53 @@ func linkedConstructInputsCopyTo(ctx *pulumi.Context, inputs map[string]interfac
 
 // linkedNewConstructResult is made available here from ../provider_linked.go via go:linkname.
 func linkedNewConstructResult(resource pulumi.ComponentResource) (pulumi.URNInput, pulumi.Input, error)
+
+type CallFunc func(ctx *pulumi.Context, tok string, args CallArgs) (*CallResult, error)
+
+// Call adapts the gRPC CallRequest/CallResponse to/from the Pulumi Go SDK programming model.
+func Call(ctx context.Context, req *pulumirpc.CallRequest, engineConn *grpc.ClientConn,
+	call CallFunc) (*pulumirpc.CallResponse, error) {
+	return linkedCall(ctx, req, engineConn, func(pulumiCtx *pulumi.Context, tok string,
+		args map[string]interface{}) (pulumi.Input, error) {
+		ca := CallArgs{ctx: pulumiCtx, args: args}
+		result, err := call(pulumiCtx, tok, ca)
+		if err != nil {
+			return nil, err
+		}
+		return result.Return, nil
+	})
+}
+
+// CallArgs represents the arguments associated with a call to Call.
+type CallArgs struct {
+	ctx  *pulumi.Context
+	args map[string]interface{}
+}
+
+// Self returns the resource that the method is being called on.
+func (a CallArgs) Self() (pulumi.Resource, error) {
+	return linkedCallArgsSelf(a.ctx, a.args)
+}
+
+// Map returns the args as a Map. The map includes the `__self__` argument.
+func (a CallArgs) Map() (pulumi.Map, error) {
+	return linkedConstructInputsMap(a.ctx, a.args)
+}
+
+// CopyTo sets the args on the given args struct.
+func (a CallArgs) CopyTo(args interface{}) error {
+	return linkedConstructInputsCopyTo(a.ctx, a.args, args)
+}
+
+// CallResult is the result of a call to Call.
+type CallResult struct {
+	// Return is the value returned by the method, which must be a struct or map that implements Input.
+	Return pulumi.Input
+}
+
+// linkedCall is made available here from ../provider_linked.go via go:linkname.
+func linkedCall(ctx context.Context, req *pulumirpc.CallRequest, engineConn *grpc.ClientConn,
+	callF callFunc) (*pulumirpc.CallResponse, error)
+
+// linkedCallArgsSelf is made available here from ../provider_linked.go via go:linkname.
+func linkedCallArgsSelf(ctx *pulumi.Context, args map[string]interface{}) (pulumi.Resource, error)
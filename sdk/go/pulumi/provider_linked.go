@@ -48,3 +48,14 @@ func linkedConstructInputsCopyTo(ctx *Context, inputs map[string]interface{}, ar
 func linkedNewConstructResult(resource ComponentResource) (URNInput, Input, error) {
 	return newConstructResult(resource)
 }
+
+//go:linkname linkedCall github.com/pulumi/pulumi/sdk/v3/go/pulumi/provider.linkedCall
+func linkedCall(ctx context.Context, req *pulumirpc.CallRequest, engineConn *grpc.ClientConn,
+	callF callFunc) (*pulumirpc.CallResponse, error) {
+	return call(ctx, req, engineConn, callF)
+}
+
+//go:linkname linkedCallArgsSelf github.com/pulumi/pulumi/sdk/v3/go/pulumi/provider.linkedCallArgsSelf
+func linkedCallArgsSelf(ctx *Context, args map[string]interface{}) (Resource, error) {
+	return callArgsSelf(ctx, args)
+}
@@ -265,6 +265,48 @@ func TestInvoke(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCall(t *testing.T) {
+	mocks := &testMonitor{
+		CallF: func(args MockCallArgs) (resource.PropertyMap, error) {
+			assert.Equal(t, "test:resource:type/method", args.Token)
+			assert.Equal(t, resource.NewStringProperty("rab"), args.Args["bar"])
+			assert.True(t, args.Args["__self__"].IsResourceReference())
+			return resource.NewPropertyMapFromMap(map[string]interface{}{
+				"foo": "oof",
+			}), nil
+		},
+	}
+
+	err := RunErr(func(ctx *Context) error {
+		var res testResource2
+		err := ctx.RegisterResource("test:resource:type", "resA", &testResource2Inputs{}, &res)
+		assert.NoError(t, err)
+
+		out, err := ctx.Call("test:resource:type/method", Map{"bar": String("rab")}, MapOutput{}, &res)
+		assert.NoError(t, err)
+
+		result, known, secret, deps, err := await(out)
+		assert.NoError(t, err)
+		assert.True(t, known)
+		assert.False(t, secret)
+		assert.Equal(t, []Resource{&res}, deps)
+		assert.Equal(t, map[string]interface{}{"foo": "oof"}, result)
+
+		_, err = ctx.Call("", nil, MapOutput{}, nil)
+		assert.Error(t, err)
+
+		return nil
+	}, WithMocks("project", "stack", mocks))
+	assert.NoError(t, err)
+}
+
+func TestSortedURNsRemovesDuplicates(t *testing.T) {
+	assert.Equal(t, []string{}, sortedURNs(nil))
+	assert.Equal(t, []string{"a"}, sortedURNs([]URN{"a", "a"}))
+	assert.Equal(t, []string{"a", "b"}, sortedURNs([]URN{"a", "a", "b"}))
+	assert.Equal(t, []string{"a", "b", "c"}, sortedURNs([]URN{"c", "a", "b", "a", "c", "c"}))
+}
+
 type testInstanceResource struct {
 	CustomResourceState
 }
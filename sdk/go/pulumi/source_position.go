@@ -0,0 +1,83 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"net/url"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// sdkPackagePrefix is the prefix of the names of the functions in this package and its subpackages.
+const sdkPackagePrefix = "github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+
+// callerSourcePosition returns the position of the user code that is registering a resource, if it can be
+// determined. Frames in this SDK and in the Go runtime are skipped, as are frames in dependencies such as generated
+// provider SDKs, unless no other frames remain.
+func callerSourcePosition() *pulumirpc.SourcePosition {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var dependency *runtime.Frame
+	for {
+		frame, more := frames.Next()
+		if frame.File != "" && !isSDKFrame(frame) {
+			if !isDependencyFrame(frame) {
+				return newSourcePosition(frame)
+			}
+			if dependency == nil {
+				f := frame
+				dependency = &f
+			}
+		}
+		if !more {
+			break
+		}
+	}
+	if dependency != nil {
+		return newSourcePosition(*dependency)
+	}
+	return nil
+}
+
+// isSDKFrame returns true if the given frame is in this SDK or in the Go runtime.
+func isSDKFrame(frame runtime.Frame) bool {
+	fn := frame.Function
+	return strings.HasPrefix(fn, sdkPackagePrefix+".") || strings.HasPrefix(fn, sdkPackagePrefix+"/") ||
+		strings.HasPrefix(fn, "runtime.")
+}
+
+// isDependencyFrame returns true if the given frame is in a dependency of the program rather than in the program
+// itself, i.e. in the module cache, a vendor directory, or the Go root.
+func isDependencyFrame(frame runtime.Frame) bool {
+	file := filepath.ToSlash(frame.File)
+	return strings.Contains(file, "/pkg/mod/") || strings.Contains(file, "/vendor/") ||
+		strings.HasPrefix(file, filepath.ToSlash(runtime.GOROOT())+"/")
+}
+
+func newSourcePosition(frame runtime.Frame) *pulumirpc.SourcePosition {
+	path := filepath.ToSlash(frame.File)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return &pulumirpc.SourcePosition{
+		Uri:  (&url.URL{Scheme: "file", Path: path}).String(),
+		Line: int32(frame.Line),
+	}
+}
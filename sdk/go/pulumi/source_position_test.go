@@ -0,0 +1,45 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pulumi
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourcePositionFrames(t *testing.T) {
+	user := runtime.Frame{Function: "main.main.func1", File: "/home/user/app/main.go", Line: 12}
+	assert.False(t, isSDKFrame(user))
+	assert.False(t, isDependencyFrame(user))
+
+	sdk := runtime.Frame{Function: "github.com/pulumi/pulumi/sdk/v3/go/pulumi.(*Context).RegisterResource"}
+	assert.True(t, isSDKFrame(sdk))
+
+	generated := runtime.Frame{
+		Function: "github.com/pulumi/pulumi-aws/sdk/v4/go/aws/s3.NewBucket",
+		File:     "/home/user/go/pkg/mod/github.com/pulumi/pulumi-aws/sdk/v4@v4.0.0/go/aws/s3/bucket.go",
+	}
+	assert.False(t, isSDKFrame(generated))
+	assert.True(t, isDependencyFrame(generated))
+
+	pos := newSourcePosition(user)
+	assert.Equal(t, "file:///home/user/app/main.go", pos.Uri)
+	assert.Equal(t, int32(12), pos.Line)
+
+	pos = newSourcePosition(runtime.Frame{File: "C:/Users/user/app/main.go", Line: 3})
+	assert.Equal(t, "file:///C:/Users/user/app/main.go", pos.Uri)
+}
@@ -19,6 +19,7 @@
 var grpc = require('@grpc/grpc-js');
 var analyzer_pb = require('./analyzer_pb.js');
 var plugin_pb = require('./plugin_pb.js');
+var provider_pb = require('./provider_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
 
@@ -33,6 +34,17 @@ function deserialize_google_protobuf_Empty(buffer_arg) {
   return google_protobuf_empty_pb.Empty.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_AnalyzePlanRequest(arg) {
+  if (!(arg instanceof analyzer_pb.AnalyzePlanRequest)) {
+    throw new Error('Expected argument of type pulumirpc.AnalyzePlanRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_AnalyzePlanRequest(buffer_arg) {
+  return analyzer_pb.AnalyzePlanRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_AnalyzeRequest(arg) {
   if (!(arg instanceof analyzer_pb.AnalyzeRequest)) {
     throw new Error('Expected argument of type pulumirpc.AnalyzeRequest');
@@ -99,6 +111,17 @@ function deserialize_pulumirpc_PluginInfo(buffer_arg) {
   return plugin_pb.PluginInfo.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_RemediateResponse(arg) {
+  if (!(arg instanceof analyzer_pb.RemediateResponse)) {
+    throw new Error('Expected argument of type pulumirpc.RemediateResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_RemediateResponse(buffer_arg) {
+  return analyzer_pb.RemediateResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 // Analyzer provides a pluggable interface for checking resource definitions against some number of
 // resource policies. It is intentionally open-ended, allowing for implementations that check
@@ -132,6 +155,35 @@ analyzeStack: {
     responseSerialize: serialize_pulumirpc_AnalyzeResponse,
     responseDeserialize: deserialize_pulumirpc_AnalyzeResponse,
   },
+  // AnalyzePlan analyzes the steps planned by a preview of a deployment, including the resources that are
+// created, updated, replaced and deleted, so that policies can govern changes as well as state. Before an
+// update, the engine previews it and calls AnalyzePlan, so that mandatory violations stop the update before
+// any of its steps are applied.
+analyzePlan: {
+    path: '/pulumirpc.Analyzer/AnalyzePlan',
+    requestStream: false,
+    responseStream: false,
+    requestType: analyzer_pb.AnalyzePlanRequest,
+    responseType: analyzer_pb.AnalyzeResponse,
+    requestSerialize: serialize_pulumirpc_AnalyzePlanRequest,
+    requestDeserialize: deserialize_pulumirpc_AnalyzePlanRequest,
+    responseSerialize: serialize_pulumirpc_AnalyzeResponse,
+    responseDeserialize: deserialize_pulumirpc_AnalyzeResponse,
+  },
+  // Remediate optionally transforms a single resource object. This effectively rewrites
+// a single resource object's properties instead of using what was generated by the program.
+// Called with the "inputs" to the resource, after they have been checked and before they are analyzed.
+remediate: {
+    path: '/pulumirpc.Analyzer/Remediate',
+    requestStream: false,
+    responseStream: false,
+    requestType: analyzer_pb.AnalyzeRequest,
+    responseType: analyzer_pb.RemediateResponse,
+    requestSerialize: serialize_pulumirpc_AnalyzeRequest,
+    requestDeserialize: deserialize_pulumirpc_AnalyzeRequest,
+    responseSerialize: serialize_pulumirpc_RemediateResponse,
+    responseDeserialize: deserialize_pulumirpc_RemediateResponse,
+  },
   // GetAnalyzerInfo returns metadata about the analyzer (e.g., list of policies contained).
 getAnalyzerInfo: {
     path: '/pulumirpc.Analyzer/GetAnalyzerInfo',
@@ -14,15 +14,19 @@ var proto = { pulumirpc: {} }, global = proto;
 
 var plugin_pb = require('./plugin_pb.js');
 goog.object.extend(proto, plugin_pb);
+var provider_pb = require('./provider_pb.js');
+goog.object.extend(proto, provider_pb);
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 goog.object.extend(proto, google_protobuf_empty_pb);
 var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
 goog.object.extend(proto, google_protobuf_struct_pb);
 goog.exportSymbol('proto.pulumirpc.AnalyzeDiagnostic', null, global);
+goog.exportSymbol('proto.pulumirpc.AnalyzePlanRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.AnalyzeRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.AnalyzeResponse', null, global);
 goog.exportSymbol('proto.pulumirpc.AnalyzeStackRequest', null, global);
 goog.exportSymbol('proto.pulumirpc.AnalyzerInfo', null, global);
+goog.exportSymbol('proto.pulumirpc.AnalyzerPlannedStep', null, global);
 goog.exportSymbol('proto.pulumirpc.AnalyzerPropertyDependencies', null, global);
 goog.exportSymbol('proto.pulumirpc.AnalyzerProviderResource', null, global);
 goog.exportSymbol('proto.pulumirpc.AnalyzerResource', null, global);
@@ -33,6 +37,8 @@ goog.exportSymbol('proto.pulumirpc.EnforcementLevel', null, global);
 goog.exportSymbol('proto.pulumirpc.PolicyConfig', null, global);
 goog.exportSymbol('proto.pulumirpc.PolicyConfigSchema', null, global);
 goog.exportSymbol('proto.pulumirpc.PolicyInfo', null, global);
+goog.exportSymbol('proto.pulumirpc.RemediateResponse', null, global);
+goog.exportSymbol('proto.pulumirpc.Remediation', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -180,6 +186,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pulumirpc.AnalyzeStackRequest.displayName = 'proto.pulumirpc.AnalyzeStackRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.AnalyzePlanRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.AnalyzePlanRequest.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.AnalyzePlanRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.AnalyzePlanRequest.displayName = 'proto.pulumirpc.AnalyzePlanRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.AnalyzerPlannedStep = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.AnalyzerPlannedStep.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.AnalyzerPlannedStep, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.AnalyzerPlannedStep.displayName = 'proto.pulumirpc.AnalyzerPlannedStep';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -222,6 +270,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.pulumirpc.AnalyzeDiagnostic.displayName = 'proto.pulumirpc.AnalyzeDiagnostic';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.Remediation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.pulumirpc.Remediation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.Remediation.displayName = 'proto.pulumirpc.Remediation';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.pulumirpc.RemediateResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.pulumirpc.RemediateResponse.repeatedFields_, null);
+};
+goog.inherits(proto.pulumirpc.RemediateResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.pulumirpc.RemediateResponse.displayName = 'proto.pulumirpc.RemediateResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -2280,7 +2370,7 @@ proto.pulumirpc.AnalyzeStackRequest.prototype.clearResourcesList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.AnalyzeResponse.repeatedFields_ = [2];
+proto.pulumirpc.AnalyzePlanRequest.repeatedFields_ = [1];
 
 
 
@@ -2297,8 +2387,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.AnalyzeResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.AnalyzeResponse.toObject(opt_includeInstance, this);
+proto.pulumirpc.AnalyzePlanRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.AnalyzePlanRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2307,14 +2397,14 @@ proto.pulumirpc.AnalyzeResponse.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.AnalyzeResponse} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.AnalyzePlanRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.AnalyzeResponse.toObject = function(includeInstance, msg) {
+proto.pulumirpc.AnalyzePlanRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    diagnosticsList: jspb.Message.toObjectList(msg.getDiagnosticsList(),
-    proto.pulumirpc.AnalyzeDiagnostic.toObject, includeInstance)
+    stepsList: jspb.Message.toObjectList(msg.getStepsList(),
+    proto.pulumirpc.AnalyzerPlannedStep.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2328,33 +2418,33 @@ proto.pulumirpc.AnalyzeResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.AnalyzeResponse}
+ * @return {!proto.pulumirpc.AnalyzePlanRequest}
  */
-proto.pulumirpc.AnalyzeResponse.deserializeBinary = function(bytes) {
+proto.pulumirpc.AnalyzePlanRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.AnalyzeResponse;
-  return proto.pulumirpc.AnalyzeResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.AnalyzePlanRequest;
+  return proto.pulumirpc.AnalyzePlanRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.AnalyzeResponse} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.AnalyzePlanRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.AnalyzeResponse}
+ * @return {!proto.pulumirpc.AnalyzePlanRequest}
  */
-proto.pulumirpc.AnalyzeResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.AnalyzePlanRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 2:
-      var value = new proto.pulumirpc.AnalyzeDiagnostic;
-      reader.readMessage(value,proto.pulumirpc.AnalyzeDiagnostic.deserializeBinaryFromReader);
-      msg.addDiagnostics(value);
+    case 1:
+      var value = new proto.pulumirpc.AnalyzerPlannedStep;
+      reader.readMessage(value,proto.pulumirpc.AnalyzerPlannedStep.deserializeBinaryFromReader);
+      msg.addSteps(value);
       break;
     default:
       reader.skipField();
@@ -2369,9 +2459,9 @@ proto.pulumirpc.AnalyzeResponse.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.AnalyzeResponse.prototype.serializeBinary = function() {
+proto.pulumirpc.AnalyzePlanRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.AnalyzeResponse.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.AnalyzePlanRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2379,58 +2469,58 @@ proto.pulumirpc.AnalyzeResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.AnalyzeResponse} message
+ * @param {!proto.pulumirpc.AnalyzePlanRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.AnalyzeResponse.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.AnalyzePlanRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDiagnosticsList();
+  f = message.getStepsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
-      2,
+      1,
       f,
-      proto.pulumirpc.AnalyzeDiagnostic.serializeBinaryToWriter
+      proto.pulumirpc.AnalyzerPlannedStep.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated AnalyzeDiagnostic diagnostics = 2;
- * @return {!Array<!proto.pulumirpc.AnalyzeDiagnostic>}
+ * repeated AnalyzerPlannedStep steps = 1;
+ * @return {!Array<!proto.pulumirpc.AnalyzerPlannedStep>}
  */
-proto.pulumirpc.AnalyzeResponse.prototype.getDiagnosticsList = function() {
-  return /** @type{!Array<!proto.pulumirpc.AnalyzeDiagnostic>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.AnalyzeDiagnostic, 2));
+proto.pulumirpc.AnalyzePlanRequest.prototype.getStepsList = function() {
+  return /** @type{!Array<!proto.pulumirpc.AnalyzerPlannedStep>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.AnalyzerPlannedStep, 1));
 };
 
 
 /**
- * @param {!Array<!proto.pulumirpc.AnalyzeDiagnostic>} value
- * @return {!proto.pulumirpc.AnalyzeResponse} returns this
+ * @param {!Array<!proto.pulumirpc.AnalyzerPlannedStep>} value
+ * @return {!proto.pulumirpc.AnalyzePlanRequest} returns this
 */
-proto.pulumirpc.AnalyzeResponse.prototype.setDiagnosticsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+proto.pulumirpc.AnalyzePlanRequest.prototype.setStepsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.pulumirpc.AnalyzeDiagnostic=} opt_value
+ * @param {!proto.pulumirpc.AnalyzerPlannedStep=} opt_value
  * @param {number=} opt_index
- * @return {!proto.pulumirpc.AnalyzeDiagnostic}
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep}
  */
-proto.pulumirpc.AnalyzeResponse.prototype.addDiagnostics = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.pulumirpc.AnalyzeDiagnostic, opt_index);
+proto.pulumirpc.AnalyzePlanRequest.prototype.addSteps = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pulumirpc.AnalyzerPlannedStep, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.AnalyzeResponse} returns this
+ * @return {!proto.pulumirpc.AnalyzePlanRequest} returns this
  */
-proto.pulumirpc.AnalyzeResponse.prototype.clearDiagnosticsList = function() {
-  return this.setDiagnosticsList([]);
+proto.pulumirpc.AnalyzePlanRequest.prototype.clearStepsList = function() {
+  return this.setStepsList([]);
 };
 
 
@@ -2440,7 +2530,7 @@ proto.pulumirpc.AnalyzeResponse.prototype.clearDiagnosticsList = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.pulumirpc.AnalyzeDiagnostic.repeatedFields_ = [6];
+proto.pulumirpc.AnalyzerPlannedStep.repeatedFields_ = [6,7];
 
 
 
@@ -2457,8 +2547,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.toObject = function(opt_includeInstance) {
-  return proto.pulumirpc.AnalyzeDiagnostic.toObject(opt_includeInstance, this);
+proto.pulumirpc.AnalyzerPlannedStep.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.AnalyzerPlannedStep.toObject(opt_includeInstance, this);
 };
 
 
@@ -2467,20 +2557,20 @@ proto.pulumirpc.AnalyzeDiagnostic.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.pulumirpc.AnalyzeDiagnostic} msg The msg instance to transform.
+ * @param {!proto.pulumirpc.AnalyzerPlannedStep} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.AnalyzeDiagnostic.toObject = function(includeInstance, msg) {
+proto.pulumirpc.AnalyzerPlannedStep.toObject = function(includeInstance, msg) {
   var f, obj = {
-    policyname: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    policypackname: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    policypackversion: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    message: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    tagsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
-    enforcementlevel: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    urn: jspb.Message.getFieldWithDefault(msg, 8, "")
+    op: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    urn: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    old: (f = msg.getOld()) && proto.pulumirpc.AnalyzerResource.toObject(includeInstance, f),
+    pb_new: (f = msg.getNew()) && proto.pulumirpc.AnalyzerResource.toObject(includeInstance, f),
+    replacekeysList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    diffsList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
+    detaileddiffMap: (f = msg.getDetaileddiffMap()) ? f.toObject(includeInstance, provider_pb.PropertyDiff.toObject) : []
   };
 
   if (includeInstance) {
@@ -2494,23 +2584,23 @@ proto.pulumirpc.AnalyzeDiagnostic.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.pulumirpc.AnalyzeDiagnostic}
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep}
  */
-proto.pulumirpc.AnalyzeDiagnostic.deserializeBinary = function(bytes) {
+proto.pulumirpc.AnalyzerPlannedStep.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.pulumirpc.AnalyzeDiagnostic;
-  return proto.pulumirpc.AnalyzeDiagnostic.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.pulumirpc.AnalyzerPlannedStep;
+  return proto.pulumirpc.AnalyzerPlannedStep.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.pulumirpc.AnalyzeDiagnostic} msg The message object to deserialize into.
+ * @param {!proto.pulumirpc.AnalyzerPlannedStep} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.pulumirpc.AnalyzeDiagnostic}
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep}
  */
-proto.pulumirpc.AnalyzeDiagnostic.deserializeBinaryFromReader = function(msg, reader) {
+proto.pulumirpc.AnalyzerPlannedStep.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2519,35 +2609,39 @@ proto.pulumirpc.AnalyzeDiagnostic.deserializeBinaryFromReader = function(msg, re
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPolicyname(value);
+      msg.setOp(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPolicypackname(value);
+      msg.setUrn(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPolicypackversion(value);
+      msg.setType(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
+      var value = new proto.pulumirpc.AnalyzerResource;
+      reader.readMessage(value,proto.pulumirpc.AnalyzerResource.deserializeBinaryFromReader);
+      msg.setOld(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMessage(value);
+      var value = new proto.pulumirpc.AnalyzerResource;
+      reader.readMessage(value,proto.pulumirpc.AnalyzerResource.deserializeBinaryFromReader);
+      msg.setNew(value);
       break;
     case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.addTags(value);
+      msg.addReplacekeys(value);
       break;
     case 7:
-      var value = /** @type {!proto.pulumirpc.EnforcementLevel} */ (reader.readEnum());
-      msg.setEnforcementlevel(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addDiffs(value);
       break;
     case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrn(value);
+      var value = msg.getDetaileddiffMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, provider_pb.PropertyDiff.deserializeBinaryFromReader, "", new provider_pb.PropertyDiff());
+         });
       break;
     default:
       reader.skipField();
@@ -2562,9 +2656,9 @@ proto.pulumirpc.AnalyzeDiagnostic.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.serializeBinary = function() {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.pulumirpc.AnalyzeDiagnostic.serializeBinaryToWriter(this, writer);
+  proto.pulumirpc.AnalyzerPlannedStep.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2572,175 +2666,212 @@ proto.pulumirpc.AnalyzeDiagnostic.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.pulumirpc.AnalyzeDiagnostic} message
+ * @param {!proto.pulumirpc.AnalyzerPlannedStep} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.pulumirpc.AnalyzeDiagnostic.serializeBinaryToWriter = function(message, writer) {
+proto.pulumirpc.AnalyzerPlannedStep.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPolicyname();
+  f = message.getOp();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPolicypackname();
+  f = message.getUrn();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getPolicypackversion();
+  f = message.getType();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getOld();
+  if (f != null) {
+    writer.writeMessage(
       4,
-      f
+      f,
+      proto.pulumirpc.AnalyzerResource.serializeBinaryToWriter
     );
   }
-  f = message.getMessage();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getNew();
+  if (f != null) {
+    writer.writeMessage(
       5,
-      f
+      f,
+      proto.pulumirpc.AnalyzerResource.serializeBinaryToWriter
     );
   }
-  f = message.getTagsList();
+  f = message.getReplacekeysList();
   if (f.length > 0) {
     writer.writeRepeatedString(
       6,
       f
     );
   }
-  f = message.getEnforcementlevel();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getDiffsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       7,
       f
     );
   }
-  f = message.getUrn();
-  if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
+  f = message.getDetaileddiffMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(8, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, provider_pb.PropertyDiff.serializeBinaryToWriter);
   }
 };
 
 
 /**
- * optional string policyName = 1;
+ * optional string op = 1;
  * @return {string}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.getPolicyname = function() {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.getOp = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.setPolicyname = function(value) {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.setOp = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string policyPackName = 2;
+ * optional string urn = 2;
  * @return {string}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.getPolicypackname = function() {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.getUrn = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.setPolicypackname = function(value) {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.setUrn = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string policyPackVersion = 3;
+ * optional string type = 3;
  * @return {string}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.getPolicypackversion = function() {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.getType = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.setPolicypackversion = function(value) {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.setType = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string description = 4;
- * @return {string}
+ * optional AnalyzerResource old = 4;
+ * @return {?proto.pulumirpc.AnalyzerResource}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.pulumirpc.AnalyzerPlannedStep.prototype.getOld = function() {
+  return /** @type{?proto.pulumirpc.AnalyzerResource} */ (
+    jspb.Message.getWrapperField(this, proto.pulumirpc.AnalyzerResource, 4));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ * @param {?proto.pulumirpc.AnalyzerResource|undefined} value
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
+*/
+proto.pulumirpc.AnalyzerPlannedStep.prototype.setOld = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.pulumirpc.AnalyzerPlannedStep.prototype.clearOld = function() {
+  return this.setOld(undefined);
 };
 
 
 /**
- * optional string message = 5;
- * @return {string}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.pulumirpc.AnalyzerPlannedStep.prototype.hasOld = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ * optional AnalyzerResource new = 5;
+ * @return {?proto.pulumirpc.AnalyzerResource}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.pulumirpc.AnalyzerPlannedStep.prototype.getNew = function() {
+  return /** @type{?proto.pulumirpc.AnalyzerResource} */ (
+    jspb.Message.getWrapperField(this, proto.pulumirpc.AnalyzerResource, 5));
 };
 
 
 /**
- * repeated string tags = 6;
+ * @param {?proto.pulumirpc.AnalyzerResource|undefined} value
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
+*/
+proto.pulumirpc.AnalyzerPlannedStep.prototype.setNew = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
+ */
+proto.pulumirpc.AnalyzerPlannedStep.prototype.clearNew = function() {
+  return this.setNew(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.AnalyzerPlannedStep.prototype.hasNew = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * repeated string replaceKeys = 6;
  * @return {!Array<string>}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.getTagsList = function() {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.getReplacekeysList = function() {
   return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.setTagsList = function(value) {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.setReplacekeysList = function(value) {
   return jspb.Message.setField(this, 6, value || []);
 };
 
@@ -2748,55 +2879,1065 @@ proto.pulumirpc.AnalyzeDiagnostic.prototype.setTagsList = function(value) {
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.addTags = function(value, opt_index) {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.addReplacekeys = function(value, opt_index) {
   return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.clearTagsList = function() {
+proto.pulumirpc.AnalyzerPlannedStep.prototype.clearReplacekeysList = function() {
+  return this.setReplacekeysList([]);
+};
+
+
+/**
+ * repeated string diffs = 7;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.AnalyzerPlannedStep.prototype.getDiffsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 7));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
+ */
+proto.pulumirpc.AnalyzerPlannedStep.prototype.setDiffsList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
+ */
+proto.pulumirpc.AnalyzerPlannedStep.prototype.addDiffs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
+ */
+proto.pulumirpc.AnalyzerPlannedStep.prototype.clearDiffsList = function() {
+  return this.setDiffsList([]);
+};
+
+
+/**
+ * map<string, PropertyDiff> detailedDiff = 8;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.pulumirpc.PropertyDiff>}
+ */
+proto.pulumirpc.AnalyzerPlannedStep.prototype.getDetaileddiffMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.pulumirpc.PropertyDiff>} */ (
+      jspb.Message.getMapField(this, 8, opt_noLazyCreate,
+      provider_pb.PropertyDiff));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.pulumirpc.AnalyzerPlannedStep} returns this
+ */
+proto.pulumirpc.AnalyzerPlannedStep.prototype.clearDetaileddiffMap = function() {
+  this.getDetaileddiffMap().clear();
+  return this;};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.AnalyzeResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.AnalyzeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.AnalyzeResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.AnalyzeResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.AnalyzeResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    diagnosticsList: jspb.Message.toObjectList(msg.getDiagnosticsList(),
+    proto.pulumirpc.AnalyzeDiagnostic.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.AnalyzeResponse}
+ */
+proto.pulumirpc.AnalyzeResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.AnalyzeResponse;
+  return proto.pulumirpc.AnalyzeResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.AnalyzeResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.AnalyzeResponse}
+ */
+proto.pulumirpc.AnalyzeResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 2:
+      var value = new proto.pulumirpc.AnalyzeDiagnostic;
+      reader.readMessage(value,proto.pulumirpc.AnalyzeDiagnostic.deserializeBinaryFromReader);
+      msg.addDiagnostics(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.AnalyzeResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.AnalyzeResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.AnalyzeResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.AnalyzeResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDiagnosticsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.pulumirpc.AnalyzeDiagnostic.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated AnalyzeDiagnostic diagnostics = 2;
+ * @return {!Array<!proto.pulumirpc.AnalyzeDiagnostic>}
+ */
+proto.pulumirpc.AnalyzeResponse.prototype.getDiagnosticsList = function() {
+  return /** @type{!Array<!proto.pulumirpc.AnalyzeDiagnostic>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.AnalyzeDiagnostic, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.AnalyzeDiagnostic>} value
+ * @return {!proto.pulumirpc.AnalyzeResponse} returns this
+*/
+proto.pulumirpc.AnalyzeResponse.prototype.setDiagnosticsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.AnalyzeDiagnostic=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic}
+ */
+proto.pulumirpc.AnalyzeResponse.prototype.addDiagnostics = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.pulumirpc.AnalyzeDiagnostic, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.AnalyzeResponse} returns this
+ */
+proto.pulumirpc.AnalyzeResponse.prototype.clearDiagnosticsList = function() {
+  return this.setDiagnosticsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.AnalyzeDiagnostic.repeatedFields_ = [6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.AnalyzeDiagnostic.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.AnalyzeDiagnostic} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.AnalyzeDiagnostic.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    policyname: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    policypackname: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    policypackversion: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    tagsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    enforcementlevel: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    urn: jspb.Message.getFieldWithDefault(msg, 8, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.AnalyzeDiagnostic;
+  return proto.pulumirpc.AnalyzeDiagnostic.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.AnalyzeDiagnostic} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPolicyname(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPolicypackname(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPolicypackversion(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addTags(value);
+      break;
+    case 7:
+      var value = /** @type {!proto.pulumirpc.EnforcementLevel} */ (reader.readEnum());
+      msg.setEnforcementlevel(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrn(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.AnalyzeDiagnostic.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.AnalyzeDiagnostic} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.AnalyzeDiagnostic.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPolicyname();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPolicypackname();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPolicypackversion();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getTagsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
+  f = message.getEnforcementlevel();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      7,
+      f
+    );
+  }
+  f = message.getUrn();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string policyName = 1;
+ * @return {string}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.getPolicyname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.setPolicyname = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string policyPackName = 2;
+ * @return {string}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.getPolicypackname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.setPolicypackname = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string policyPackVersion = 3;
+ * @return {string}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.getPolicypackversion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.setPolicypackversion = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string description = 4;
+ * @return {string}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string message = 5;
+ * @return {string}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * repeated string tags = 6;
+ * @return {!Array<string>}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.getTagsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.setTagsList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.addTags = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.clearTagsList = function() {
   return this.setTagsList([]);
 };
 
 
 /**
- * optional EnforcementLevel enforcementLevel = 7;
- * @return {!proto.pulumirpc.EnforcementLevel}
+ * optional EnforcementLevel enforcementLevel = 7;
+ * @return {!proto.pulumirpc.EnforcementLevel}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.getEnforcementlevel = function() {
+  return /** @type {!proto.pulumirpc.EnforcementLevel} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {!proto.pulumirpc.EnforcementLevel} value
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.setEnforcementlevel = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
+};
+
+
+/**
+ * optional string urn = 8;
+ * @return {string}
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.getUrn = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ */
+proto.pulumirpc.AnalyzeDiagnostic.prototype.setUrn = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.Remediation.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.Remediation.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.Remediation} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.Remediation.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    policyname: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    policypackname: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    policypackversion: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    properties: (f = msg.getProperties()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f),
+    diagnostic: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.Remediation}
+ */
+proto.pulumirpc.Remediation.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.Remediation;
+  return proto.pulumirpc.Remediation.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.Remediation} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.Remediation}
+ */
+proto.pulumirpc.Remediation.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPolicyname(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPolicypackname(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPolicypackversion(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 5:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setProperties(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDiagnostic(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.Remediation.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.Remediation.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.Remediation} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.Remediation.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPolicyname();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPolicypackname();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPolicypackversion();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getProperties();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+  f = message.getDiagnostic();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string policyName = 1;
+ * @return {string}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.getEnforcementlevel = function() {
-  return /** @type {!proto.pulumirpc.EnforcementLevel} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.pulumirpc.Remediation.prototype.getPolicyname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.pulumirpc.EnforcementLevel} value
- * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ * @param {string} value
+ * @return {!proto.pulumirpc.Remediation} returns this
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.setEnforcementlevel = function(value) {
-  return jspb.Message.setProto3EnumField(this, 7, value);
+proto.pulumirpc.Remediation.prototype.setPolicyname = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string urn = 8;
+ * optional string policyPackName = 2;
  * @return {string}
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.getUrn = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.pulumirpc.Remediation.prototype.getPolicypackname = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.pulumirpc.AnalyzeDiagnostic} returns this
+ * @return {!proto.pulumirpc.Remediation} returns this
  */
-proto.pulumirpc.AnalyzeDiagnostic.prototype.setUrn = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.pulumirpc.Remediation.prototype.setPolicypackname = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string policyPackVersion = 3;
+ * @return {string}
+ */
+proto.pulumirpc.Remediation.prototype.getPolicypackversion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.Remediation} returns this
+ */
+proto.pulumirpc.Remediation.prototype.setPolicypackversion = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string description = 4;
+ * @return {string}
+ */
+proto.pulumirpc.Remediation.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.Remediation} returns this
+ */
+proto.pulumirpc.Remediation.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct properties = 5;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.pulumirpc.Remediation.prototype.getProperties = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.pulumirpc.Remediation} returns this
+*/
+proto.pulumirpc.Remediation.prototype.setProperties = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.pulumirpc.Remediation} returns this
+ */
+proto.pulumirpc.Remediation.prototype.clearProperties = function() {
+  return this.setProperties(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.pulumirpc.Remediation.prototype.hasProperties = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional string diagnostic = 6;
+ * @return {string}
+ */
+proto.pulumirpc.Remediation.prototype.getDiagnostic = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.pulumirpc.Remediation} returns this
+ */
+proto.pulumirpc.Remediation.prototype.setDiagnostic = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.pulumirpc.RemediateResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.pulumirpc.RemediateResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.pulumirpc.RemediateResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.pulumirpc.RemediateResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.RemediateResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    remediationsList: jspb.Message.toObjectList(msg.getRemediationsList(),
+    proto.pulumirpc.Remediation.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.pulumirpc.RemediateResponse}
+ */
+proto.pulumirpc.RemediateResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.pulumirpc.RemediateResponse;
+  return proto.pulumirpc.RemediateResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.pulumirpc.RemediateResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.pulumirpc.RemediateResponse}
+ */
+proto.pulumirpc.RemediateResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.pulumirpc.Remediation;
+      reader.readMessage(value,proto.pulumirpc.Remediation.deserializeBinaryFromReader);
+      msg.addRemediations(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.pulumirpc.RemediateResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.pulumirpc.RemediateResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.pulumirpc.RemediateResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.pulumirpc.RemediateResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRemediationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.pulumirpc.Remediation.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Remediation remediations = 1;
+ * @return {!Array<!proto.pulumirpc.Remediation>}
+ */
+proto.pulumirpc.RemediateResponse.prototype.getRemediationsList = function() {
+  return /** @type{!Array<!proto.pulumirpc.Remediation>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.pulumirpc.Remediation, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.pulumirpc.Remediation>} value
+ * @return {!proto.pulumirpc.RemediateResponse} returns this
+*/
+proto.pulumirpc.RemediateResponse.prototype.setRemediationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.pulumirpc.Remediation=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.pulumirpc.Remediation}
+ */
+proto.pulumirpc.RemediateResponse.prototype.addRemediations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.pulumirpc.Remediation, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.pulumirpc.RemediateResponse} returns this
+ */
+proto.pulumirpc.RemediateResponse.prototype.clearRemediationsList = function() {
+  return this.setRemediationsList([]);
 };
 
 
@@ -3941,7 +5082,8 @@ proto.pulumirpc.ConfigureAnalyzerRequest.prototype.clearPolicyconfigMap = functi
 proto.pulumirpc.EnforcementLevel = {
   ADVISORY: 0,
   MANDATORY: 1,
-  DISABLED: 2
+  DISABLED: 2,
+  REMEDIATE: 3
 };
 
 goog.object.extend(exports, proto.pulumirpc);
@@ -33,6 +33,28 @@ function deserialize_google_protobuf_Empty(buffer_arg) {
   return google_protobuf_empty_pb.Empty.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_CallRequest(arg) {
+  if (!(arg instanceof provider_pb.CallRequest)) {
+    throw new Error('Expected argument of type pulumirpc.CallRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_CallRequest(buffer_arg) {
+  return provider_pb.CallRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_CallResponse(arg) {
+  if (!(arg instanceof provider_pb.CallResponse)) {
+    throw new Error('Expected argument of type pulumirpc.CallResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_CallResponse(buffer_arg) {
+  return provider_pb.CallResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_CheckRequest(arg) {
   if (!(arg instanceof provider_pb.CheckRequest)) {
     throw new Error('Expected argument of type pulumirpc.CheckRequest');
@@ -198,6 +220,28 @@ function deserialize_pulumirpc_InvokeResponse(buffer_arg) {
   return provider_pb.InvokeResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_pulumirpc_ListResourcesRequest(arg) {
+  if (!(arg instanceof provider_pb.ListResourcesRequest)) {
+    throw new Error('Expected argument of type pulumirpc.ListResourcesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ListResourcesRequest(buffer_arg) {
+  return provider_pb.ListResourcesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_pulumirpc_ListResourcesResponse(arg) {
+  if (!(arg instanceof provider_pb.ListResourcesResponse)) {
+    throw new Error('Expected argument of type pulumirpc.ListResourcesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_pulumirpc_ListResourcesResponse(buffer_arg) {
+  return provider_pb.ListResourcesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_pulumirpc_PluginInfo(arg) {
   if (!(arg instanceof plugin_pb.PluginInfo)) {
     throw new Error('Expected argument of type pulumirpc.PluginInfo');
@@ -420,6 +464,31 @@ construct: {
     responseSerialize: serialize_pulumirpc_ConstructResponse,
     responseDeserialize: deserialize_pulumirpc_ConstructResponse,
   },
+  // Call dynamically executes a method in the provider associated with a component resource.
+call: {
+    path: '/pulumirpc.ResourceProvider/Call',
+    requestStream: false,
+    responseStream: false,
+    requestType: provider_pb.CallRequest,
+    responseType: provider_pb.CallResponse,
+    requestSerialize: serialize_pulumirpc_CallRequest,
+    requestDeserialize: deserialize_pulumirpc_CallRequest,
+    responseSerialize: serialize_pulumirpc_CallResponse,
+    responseDeserialize: deserialize_pulumirpc_CallResponse,
+  },
+  // ListResources enumerates the existing resources that the provider is able to manage, e.g. so that they may be
+// discovered and imported in bulk. Providers that cannot enumerate their resources need not implement this.
+listResources: {
+    path: '/pulumirpc.ResourceProvider/ListResources',
+    requestStream: false,
+    responseStream: false,
+    requestType: provider_pb.ListResourcesRequest,
+    responseType: provider_pb.ListResourcesResponse,
+    requestSerialize: serialize_pulumirpc_ListResourcesRequest,
+    requestDeserialize: deserialize_pulumirpc_ListResourcesRequest,
+    responseSerialize: serialize_pulumirpc_ListResourcesResponse,
+    responseDeserialize: deserialize_pulumirpc_ListResourcesResponse,
+  },
   // Cancel signals the provider to abort all outstanding resource operations.
 cancel: {
     path: '/pulumirpc.ResourceProvider/Cancel',
@@ -23,7 +23,13 @@ import { InvokeOptions } from "../invoke";
 import * as log from "../log";
 import { Inputs, Output } from "../output";
 import { debuggablePromise } from "./debuggable";
-import { deserializeProperties, serializeProperties } from "./rpc";
+import {
+    deserializeProperties,
+    isRpcSecret,
+    serializeFilteredProperties,
+    serializeProperties,
+    unwrapRpcSecret,
+} from "./rpc";
 import {
     excessiveDebugOutput,
     getMonitor,
@@ -31,7 +37,7 @@ import {
     terminateRpcs,
 } from "./settings";
 
-import { ProviderResource, Resource } from "../resource";
+import { DependencyResource, ProviderResource, Resource } from "../resource";
 import * as utils from "../utils";
 import { PushableAsyncIterable } from "./asyncIterableUtil";
 
@@ -173,6 +179,182 @@ async function invokeAsync(tok: string, props: Inputs, opts: InvokeOptions): Pro
     }
 }
 
+/**
+ * `call` dynamically calls the method, `tok`, of the resource, `res`, which is offered by a provider plugin. Unlike
+ * `invoke`, the `props` inputs can be a bag of computed values (including `T`s, `Promise<T>`s, `Output<T>`s etc.),
+ * and the result is an `Output` that resolves to the value returned by the provider plugin.
+ */
+export function call<T>(tok: string, props: Inputs, res?: Resource): Output<T> {
+    const label = `Calling function: tok=${tok}`;
+    log.debug(label + (excessiveDebugOutput ? `, props=${JSON.stringify(props)}` : ``));
+
+    const [out, resolver] = createOutput<T>(`call(${tok})`, res);
+
+    debuggablePromise(Promise.resolve().then(async () => {
+        // Wait for all values to be available, and then perform the RPC.
+        const done = rpcKeepAlive();
+        try {
+            const [serialized, propertyToDependentResources] =
+                await serializeFilteredProperties(`call:${tok}`, props, _ => true);
+            log.debug(`Call RPC prepared: tok=${tok}` + (excessiveDebugOutput ? `, obj=${JSON.stringify(serialized)}` : ``));
+
+            // Fetch the monitor and make an RPC request.
+            const monitor: any = getMonitor();
+
+            const provider = await ProviderResource.register(getProvider(tok, { parent: res }));
+            const req = await createCallRequest(tok, serialized, propertyToDependentResources, provider);
+
+            const resp: any = await debuggablePromise(new Promise((innerResolve, innerReject) =>
+                monitor.call(req, (err: grpc.ServiceError, innerResponse: any) => {
+                    log.debug(`Call RPC finished: tok=${tok}; err: ${err}, resp: ${innerResponse}`);
+                    if (err) {
+                        // If the monitor is unavailable, it is in the process of shutting down or has already
+                        // shut down. Don't emit an error and don't do any more RPCs, just exit.
+                        if (err.code === grpc.status.UNAVAILABLE || err.code === grpc.status.CANCELLED) {
+                            terminateRpcs();
+                            err.message = "Resource monitor is terminating";
+                            innerReject(err);
+                            return;
+                        }
+
+                        // If the RPC failed, rethrow the error with a native exception and the message that
+                        // the engine provided - it's suitable for user presentation.
+                        innerReject(new Error(err.details));
+                    }
+                    else {
+                        innerResolve(innerResponse);
+                    }
+                })), label);
+
+            // Deserialize the response and resolve the output.
+            const deserialized = deserializeResponse(tok, resp, "Call");
+            let isSecret = false;
+            const deps: Resource[] = [];
+
+            // Keep track of whether we need to mark the resulting output a secret, and unwrap each individual value.
+            if (deserialized !== undefined) {
+                for (const k of Object.keys(deserialized)) {
+                    const v = deserialized[k];
+                    if (isRpcSecret(v)) {
+                        isSecret = true;
+                        deserialized[k] = unwrapRpcSecret(v);
+                    }
+                }
+            }
+
+            // Combine the individual dependencies into a single set of dependency resources.
+            const rpcDeps = resp.getReturndependenciesMap();
+            if (rpcDeps) {
+                const urns = new Set<string>();
+                rpcDeps.forEach((returnDeps: any) => {
+                    for (const urn of returnDeps.getUrnsList()) {
+                        urns.add(urn);
+                    }
+                });
+                for (const urn of urns) {
+                    deps.push(new DependencyResource(urn));
+                }
+            }
+
+            // If the value the engine handed back is or contains an unknown value, the output will mark its value
+            // as unknown automatically, so we just pass true for isKnown here.
+            resolver(<T>deserialized, true, isSecret, deps);
+        }
+        catch (e) {
+            resolver(<any>undefined, true, false, undefined, e);
+        }
+        finally {
+            done();
+        }
+    }), label);
+
+    return out;
+}
+
+function createOutput<T>(label: string, res?: Resource):
+    [Output<T>, (v: T, isKnown: boolean, isSecret: boolean, deps?: Resource[], err?: Error | undefined) => void] {
+    let resolveValue: (v: T) => void;
+    let rejectValue: (err: Error) => void;
+    let resolveIsKnown: (v: boolean) => void;
+    let rejectIsKnown: (err: Error) => void;
+    let resolveIsSecret: (v: boolean) => void;
+    let rejectIsSecret: (err: Error) => void;
+    let resolveDeps: (v: Resource[]) => void;
+    let rejectDeps: (err: Error) => void;
+
+    const resolver = (v: T, isKnown: boolean, isSecret: boolean, deps: Resource[] = [], err?: Error) => {
+        if (!!err) {
+            rejectValue(err);
+            rejectIsKnown(err);
+            rejectIsSecret(err);
+            rejectDeps(err);
+        } else {
+            resolveValue(v);
+            resolveIsKnown(isKnown);
+            resolveIsSecret(isSecret);
+            resolveDeps(deps);
+        }
+    };
+
+    const out = new Output(
+        res ? [res] : [],
+        debuggablePromise(
+            new Promise<T>((resolve, reject) => {
+                resolveValue = resolve;
+                rejectValue = reject;
+            }),
+            `${label}Value`),
+        debuggablePromise(
+            new Promise<boolean>((resolve, reject) => {
+                resolveIsKnown = resolve;
+                rejectIsKnown = reject;
+            }),
+            `${label}IsKnown`),
+        debuggablePromise(
+            new Promise<boolean>((resolve, reject) => {
+                resolveIsSecret = resolve;
+                rejectIsSecret = reject;
+            }),
+            `${label}IsSecret`),
+        debuggablePromise(
+            new Promise<Resource[]>((resolve, reject) => {
+                resolveDeps = resolve;
+                rejectDeps = reject;
+            }),
+            `${label}Deps`));
+
+    return [out, resolver];
+}
+
+async function createCallRequest(tok: string, serialized: Record<string, any>,
+                                 propertyToDependentResources: Map<string, Set<Resource>>,
+                                 provider: string | undefined) {
+    if (provider !== undefined && typeof provider !== "string") {
+        throw new Error("Incorrect provider type.");
+    }
+
+    const obj = gstruct.Struct.fromJavaScript(serialized);
+
+    const req = new providerproto.CallRequest();
+    req.setTok(tok);
+    req.setArgs(obj);
+    req.setProvider(provider);
+
+    const argDependencies = req.getArgdependenciesMap();
+    for (const [key, propertyDeps] of propertyToDependentResources) {
+        const urns = new Set<string>();
+        for (const dep of propertyDeps) {
+            const urn = await dep.urn.promise();
+            urns.add(urn);
+        }
+        const deps = new providerproto.CallRequest.ArgumentDependencies();
+        deps.setUrnsList(Array.from(urns));
+        argDependencies.set(key, deps);
+    }
+
+    return req;
+}
+
 // StreamInvokeResponse represents a (potentially infinite) streaming response to `streamInvoke`,
 // with facilities to gracefully cancel and clean up the stream.
 export class StreamInvokeResponse<T> implements AsyncIterable<T> {
@@ -212,7 +394,7 @@ function getProvider(tok: string, opts: InvokeOptions) {
            opts.parent ? opts.parent.getProvider(tok) : undefined;
 }
 
-function deserializeResponse(tok: string, resp: any): any {
+function deserializeResponse(tok: string, resp: any, operation: string = "Invoke"): any {
     const failures: any = resp.getFailuresList();
     if (failures && failures.length) {
         let reasons = "";
@@ -224,7 +406,7 @@ function deserializeResponse(tok: string, resp: any): any {
             reasons += `${failures[i].getReason()} (${failures[i].getProperty()})`;
         }
 
-        throw new Error(`Invoke of '${tok}' failed: ${reasons}`);
+        throw new Error(`${operation} of '${tok}' failed: ${reasons}`);
     }
 
     const ret = resp.getReturn();
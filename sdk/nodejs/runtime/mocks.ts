@@ -140,6 +140,21 @@ export class MockMonitor {
         }
     }
 
+    public async call(req: any, callback: (err: any, innerResponse: any) => void) {
+        try {
+            const result = this.mocks.call({
+                token: req.getTok(),
+                inputs: deserializeProperties(req.getArgs()),
+                provider: req.getProvider(),
+            });
+            const response = new provproto.CallResponse();
+            response.setReturn(structproto.Struct.fromJavaScript(await serializeProperties("", result)));
+            callback(null, response);
+        } catch (err) {
+            callback(err, undefined);
+        }
+    }
+
     public async readResource(req: any, callback: (err: any, innterResponse: any) => void) {
         try {
             const result = this.mocks.newResource({
@@ -111,7 +111,7 @@ export function transferProperties(onto: Resource, label: string, props: Inputs)
  * properties with keys that match the provided filter, creating a reasonable POJO object that can
  * be remoted over to registerResource.
  */
-async function serializeFilteredProperties(
+export async function serializeFilteredProperties(
         label: string,
         props: Inputs,
         acceptKey: (k: string) => boolean,
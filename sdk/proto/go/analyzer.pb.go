@@ -33,18 +33,21 @@ const (
 	EnforcementLevel_ADVISORY  EnforcementLevel = 0
 	EnforcementLevel_MANDATORY EnforcementLevel = 1
 	EnforcementLevel_DISABLED  EnforcementLevel = 2
+	EnforcementLevel_REMEDIATE EnforcementLevel = 3
 )
 
 var EnforcementLevel_name = map[int32]string{
 	0: "ADVISORY",
 	1: "MANDATORY",
 	2: "DISABLED",
+	3: "REMEDIATE",
 }
 
 var EnforcementLevel_value = map[string]int32{
 	"ADVISORY":  0,
 	"MANDATORY": 1,
 	"DISABLED":  2,
+	"REMEDIATE": 3,
 }
 
 func (x EnforcementLevel) String() string {
@@ -529,6 +532,141 @@ func (m *AnalyzeStackRequest) GetResources() []*AnalyzerResource {
 	return nil
 }
 
+type AnalyzePlanRequest struct {
+	Steps                []*AnalyzerPlannedStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *AnalyzePlanRequest) Reset()         { *m = AnalyzePlanRequest{} }
+func (m *AnalyzePlanRequest) String() string { return proto.CompactTextString(m) }
+func (*AnalyzePlanRequest) ProtoMessage()    {}
+func (*AnalyzePlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fadbb7eccb91f143, []int{6}
+}
+
+func (m *AnalyzePlanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnalyzePlanRequest.Unmarshal(m, b)
+}
+func (m *AnalyzePlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnalyzePlanRequest.Marshal(b, m, deterministic)
+}
+func (m *AnalyzePlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzePlanRequest.Merge(m, src)
+}
+func (m *AnalyzePlanRequest) XXX_Size() int {
+	return xxx_messageInfo_AnalyzePlanRequest.Size(m)
+}
+func (m *AnalyzePlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzePlanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyzePlanRequest proto.InternalMessageInfo
+
+func (m *AnalyzePlanRequest) GetSteps() []*AnalyzerPlannedStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+// AnalyzerPlannedStep defines the view of a step of a deployment as sent to Analyzers.
+type AnalyzerPlannedStep struct {
+	Op                   string                   `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Urn                  string                   `protobuf:"bytes,2,opt,name=urn,proto3" json:"urn,omitempty"`
+	Type                 string                   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Old                  *AnalyzerResource        `protobuf:"bytes,4,opt,name=old,proto3" json:"old,omitempty"`
+	New                  *AnalyzerResource        `protobuf:"bytes,5,opt,name=new,proto3" json:"new,omitempty"`
+	ReplaceKeys          []string                 `protobuf:"bytes,6,rep,name=replaceKeys,proto3" json:"replaceKeys,omitempty"`
+	Diffs                []string                 `protobuf:"bytes,7,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	DetailedDiff         map[string]*PropertyDiff `protobuf:"bytes,8,rep,name=detailedDiff,proto3" json:"detailedDiff,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AnalyzerPlannedStep) Reset()         { *m = AnalyzerPlannedStep{} }
+func (m *AnalyzerPlannedStep) String() string { return proto.CompactTextString(m) }
+func (*AnalyzerPlannedStep) ProtoMessage()    {}
+func (*AnalyzerPlannedStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fadbb7eccb91f143, []int{7}
+}
+
+func (m *AnalyzerPlannedStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnalyzerPlannedStep.Unmarshal(m, b)
+}
+func (m *AnalyzerPlannedStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnalyzerPlannedStep.Marshal(b, m, deterministic)
+}
+func (m *AnalyzerPlannedStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzerPlannedStep.Merge(m, src)
+}
+func (m *AnalyzerPlannedStep) XXX_Size() int {
+	return xxx_messageInfo_AnalyzerPlannedStep.Size(m)
+}
+func (m *AnalyzerPlannedStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzerPlannedStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyzerPlannedStep proto.InternalMessageInfo
+
+func (m *AnalyzerPlannedStep) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *AnalyzerPlannedStep) GetUrn() string {
+	if m != nil {
+		return m.Urn
+	}
+	return ""
+}
+
+func (m *AnalyzerPlannedStep) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AnalyzerPlannedStep) GetOld() *AnalyzerResource {
+	if m != nil {
+		return m.Old
+	}
+	return nil
+}
+
+func (m *AnalyzerPlannedStep) GetNew() *AnalyzerResource {
+	if m != nil {
+		return m.New
+	}
+	return nil
+}
+
+func (m *AnalyzerPlannedStep) GetReplaceKeys() []string {
+	if m != nil {
+		return m.ReplaceKeys
+	}
+	return nil
+}
+
+func (m *AnalyzerPlannedStep) GetDiffs() []string {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+func (m *AnalyzerPlannedStep) GetDetailedDiff() map[string]*PropertyDiff {
+	if m != nil {
+		return m.DetailedDiff
+	}
+	return nil
+}
+
 type AnalyzeResponse struct {
 	Diagnostics          []*AnalyzeDiagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -540,7 +678,7 @@ func (m *AnalyzeResponse) Reset()         { *m = AnalyzeResponse{} }
 func (m *AnalyzeResponse) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeResponse) ProtoMessage()    {}
 func (*AnalyzeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fadbb7eccb91f143, []int{6}
+	return fileDescriptor_fadbb7eccb91f143, []int{8}
 }
 
 func (m *AnalyzeResponse) XXX_Unmarshal(b []byte) error {
@@ -586,7 +724,7 @@ func (m *AnalyzeDiagnostic) Reset()         { *m = AnalyzeDiagnostic{} }
 func (m *AnalyzeDiagnostic) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeDiagnostic) ProtoMessage()    {}
 func (*AnalyzeDiagnostic) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fadbb7eccb91f143, []int{7}
+	return fileDescriptor_fadbb7eccb91f143, []int{9}
 }
 
 func (m *AnalyzeDiagnostic) XXX_Unmarshal(b []byte) error {
@@ -663,6 +801,126 @@ func (m *AnalyzeDiagnostic) GetUrn() string {
 	return ""
 }
 
+// Remediation is a single resource remediation result.
+type Remediation struct {
+	PolicyName           string          `protobuf:"bytes,1,opt,name=policyName,proto3" json:"policyName,omitempty"`
+	PolicyPackName       string          `protobuf:"bytes,2,opt,name=policyPackName,proto3" json:"policyPackName,omitempty"`
+	PolicyPackVersion    string          `protobuf:"bytes,3,opt,name=policyPackVersion,proto3" json:"policyPackVersion,omitempty"`
+	Description          string          `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Properties           *_struct.Struct `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`
+	Diagnostic           string          `protobuf:"bytes,6,opt,name=diagnostic,proto3" json:"diagnostic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Remediation) Reset()         { *m = Remediation{} }
+func (m *Remediation) String() string { return proto.CompactTextString(m) }
+func (*Remediation) ProtoMessage()    {}
+func (*Remediation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fadbb7eccb91f143, []int{10}
+}
+
+func (m *Remediation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Remediation.Unmarshal(m, b)
+}
+func (m *Remediation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Remediation.Marshal(b, m, deterministic)
+}
+func (m *Remediation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Remediation.Merge(m, src)
+}
+func (m *Remediation) XXX_Size() int {
+	return xxx_messageInfo_Remediation.Size(m)
+}
+func (m *Remediation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Remediation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Remediation proto.InternalMessageInfo
+
+func (m *Remediation) GetPolicyName() string {
+	if m != nil {
+		return m.PolicyName
+	}
+	return ""
+}
+
+func (m *Remediation) GetPolicyPackName() string {
+	if m != nil {
+		return m.PolicyPackName
+	}
+	return ""
+}
+
+func (m *Remediation) GetPolicyPackVersion() string {
+	if m != nil {
+		return m.PolicyPackVersion
+	}
+	return ""
+}
+
+func (m *Remediation) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Remediation) GetProperties() *_struct.Struct {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *Remediation) GetDiagnostic() string {
+	if m != nil {
+		return m.Diagnostic
+	}
+	return ""
+}
+
+// RemediateResponse contains a sequence of remediations applied, in order.
+type RemediateResponse struct {
+	Remediations         []*Remediation `protobuf:"bytes,1,rep,name=remediations,proto3" json:"remediations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RemediateResponse) Reset()         { *m = RemediateResponse{} }
+func (m *RemediateResponse) String() string { return proto.CompactTextString(m) }
+func (*RemediateResponse) ProtoMessage()    {}
+func (*RemediateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fadbb7eccb91f143, []int{11}
+}
+
+func (m *RemediateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemediateResponse.Unmarshal(m, b)
+}
+func (m *RemediateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemediateResponse.Marshal(b, m, deterministic)
+}
+func (m *RemediateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemediateResponse.Merge(m, src)
+}
+func (m *RemediateResponse) XXX_Size() int {
+	return xxx_messageInfo_RemediateResponse.Size(m)
+}
+func (m *RemediateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemediateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemediateResponse proto.InternalMessageInfo
+
+func (m *RemediateResponse) GetRemediations() []*Remediation {
+	if m != nil {
+		return m.Remediations
+	}
+	return nil
+}
+
 // AnalyzerInfo provides metadata about a PolicyPack inside an analyzer.
 type AnalyzerInfo struct {
 	Name                 string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -680,7 +938,7 @@ func (m *AnalyzerInfo) Reset()         { *m = AnalyzerInfo{} }
 func (m *AnalyzerInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzerInfo) ProtoMessage()    {}
 func (*AnalyzerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fadbb7eccb91f143, []int{8}
+	return fileDescriptor_fadbb7eccb91f143, []int{12}
 }
 
 func (m *AnalyzerInfo) XXX_Unmarshal(b []byte) error {
@@ -760,7 +1018,7 @@ func (m *PolicyInfo) Reset()         { *m = PolicyInfo{} }
 func (m *PolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*PolicyInfo) ProtoMessage()    {}
 func (*PolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fadbb7eccb91f143, []int{9}
+	return fileDescriptor_fadbb7eccb91f143, []int{13}
 }
 
 func (m *PolicyInfo) XXX_Unmarshal(b []byte) error {
@@ -836,7 +1094,7 @@ func (m *PolicyConfigSchema) Reset()         { *m = PolicyConfigSchema{} }
 func (m *PolicyConfigSchema) String() string { return proto.CompactTextString(m) }
 func (*PolicyConfigSchema) ProtoMessage()    {}
 func (*PolicyConfigSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fadbb7eccb91f143, []int{10}
+	return fileDescriptor_fadbb7eccb91f143, []int{14}
 }
 
 func (m *PolicyConfigSchema) XXX_Unmarshal(b []byte) error {
@@ -884,7 +1142,7 @@ func (m *PolicyConfig) Reset()         { *m = PolicyConfig{} }
 func (m *PolicyConfig) String() string { return proto.CompactTextString(m) }
 func (*PolicyConfig) ProtoMessage()    {}
 func (*PolicyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fadbb7eccb91f143, []int{11}
+	return fileDescriptor_fadbb7eccb91f143, []int{15}
 }
 
 func (m *PolicyConfig) XXX_Unmarshal(b []byte) error {
@@ -931,7 +1189,7 @@ func (m *ConfigureAnalyzerRequest) Reset()         { *m = ConfigureAnalyzerReque
 func (m *ConfigureAnalyzerRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigureAnalyzerRequest) ProtoMessage()    {}
 func (*ConfigureAnalyzerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fadbb7eccb91f143, []int{12}
+	return fileDescriptor_fadbb7eccb91f143, []int{16}
 }
 
 func (m *ConfigureAnalyzerRequest) XXX_Unmarshal(b []byte) error {
@@ -969,8 +1227,13 @@ func init() {
 	proto.RegisterType((*AnalyzerProviderResource)(nil), "pulumirpc.AnalyzerProviderResource")
 	proto.RegisterType((*AnalyzerPropertyDependencies)(nil), "pulumirpc.AnalyzerPropertyDependencies")
 	proto.RegisterType((*AnalyzeStackRequest)(nil), "pulumirpc.AnalyzeStackRequest")
+	proto.RegisterType((*AnalyzePlanRequest)(nil), "pulumirpc.AnalyzePlanRequest")
+	proto.RegisterType((*AnalyzerPlannedStep)(nil), "pulumirpc.AnalyzerPlannedStep")
+	proto.RegisterMapType((map[string]*PropertyDiff)(nil), "pulumirpc.AnalyzerPlannedStep.DetailedDiffEntry")
 	proto.RegisterType((*AnalyzeResponse)(nil), "pulumirpc.AnalyzeResponse")
 	proto.RegisterType((*AnalyzeDiagnostic)(nil), "pulumirpc.AnalyzeDiagnostic")
+	proto.RegisterType((*Remediation)(nil), "pulumirpc.Remediation")
+	proto.RegisterType((*RemediateResponse)(nil), "pulumirpc.RemediateResponse")
 	proto.RegisterType((*AnalyzerInfo)(nil), "pulumirpc.AnalyzerInfo")
 	proto.RegisterMapType((map[string]*PolicyConfig)(nil), "pulumirpc.AnalyzerInfo.InitialConfigEntry")
 	proto.RegisterType((*PolicyInfo)(nil), "pulumirpc.PolicyInfo")
@@ -983,78 +1246,93 @@ func init() {
 func init() { proto.RegisterFile("analyzer.proto", fileDescriptor_fadbb7eccb91f143) }
 
 var fileDescriptor_fadbb7eccb91f143 = []byte{
-	// 1130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x51, 0x6f, 0x1b, 0x45,
-	0x10, 0xce, 0xd9, 0x69, 0x62, 0x8f, 0x1d, 0xd7, 0xd9, 0x42, 0x73, 0xbd, 0x86, 0x2a, 0xba, 0x22,
-	0x88, 0x2a, 0x70, 0xa9, 0x11, 0xa2, 0x20, 0x0a, 0x38, 0x71, 0x14, 0x05, 0x85, 0xc6, 0xac, 0xab,
-	0xaa, 0x79, 0xbc, 0x9e, 0xc7, 0xee, 0x2a, 0xe7, 0xbb, 0xeb, 0xde, 0x5e, 0x24, 0xf3, 0xc8, 0x23,
-	0x12, 0x12, 0x7f, 0x80, 0x7f, 0xc1, 0xff, 0xe0, 0x85, 0xdf, 0xc0, 0x33, 0x3f, 0x01, 0xed, 0xee,
-	0x9d, 0x73, 0xe7, 0x3b, 0xbb, 0x55, 0x84, 0xc4, 0x03, 0x6f, 0x3b, 0xb3, 0xdf, 0xcc, 0xee, 0x7c,
-	0xf3, 0xdd, 0xac, 0x0d, 0x2d, 0xc7, 0x77, 0xbc, 0xd9, 0x8f, 0xc8, 0x3b, 0x21, 0x0f, 0x44, 0x40,
-	0xea, 0x61, 0xec, 0xc5, 0x53, 0xc6, 0x43, 0xd7, 0x6a, 0x86, 0x5e, 0x3c, 0x61, 0xbe, 0xde, 0xb0,
-	0xee, 0x4e, 0x82, 0x60, 0xe2, 0xe1, 0x43, 0x65, 0xbd, 0x8c, 0xc7, 0x0f, 0x71, 0x1a, 0x8a, 0x59,
-	0xb2, 0xb9, 0xbb, 0xb8, 0x19, 0x09, 0x1e, 0xbb, 0x42, 0xef, 0xda, 0x3f, 0x55, 0xa0, 0xd5, 0xd3,
-	0xc7, 0x50, 0x7c, 0x1d, 0x63, 0x24, 0x08, 0x81, 0x75, 0x31, 0x0b, 0xd1, 0x34, 0xf6, 0x8c, 0xfd,
-	0x3a, 0x55, 0x6b, 0xf2, 0x39, 0x40, 0xc8, 0x83, 0x10, 0xb9, 0x60, 0x18, 0x99, 0x95, 0x3d, 0x63,
-	0xbf, 0xd1, 0xdd, 0xe9, 0xe8, 0xcc, 0x9d, 0x34, 0x73, 0x67, 0xa8, 0x32, 0xd3, 0x0c, 0x94, 0xb4,
-	0xa1, 0x1a, 0x73, 0xdf, 0xac, 0xaa, 0x5c, 0x72, 0x29, 0xd3, 0xfb, 0xce, 0x14, 0xcd, 0x75, 0x9d,
-	0x5e, 0xae, 0xc9, 0x57, 0xb0, 0x19, 0x84, 0x82, 0x05, 0x7e, 0x64, 0xde, 0x50, 0xb9, 0xed, 0xce,
-	0xbc, 0xd6, 0x4e, 0x72, 0x3d, 0x4e, 0x31, 0x0a, 0x62, 0xee, 0xe2, 0x99, 0x46, 0xd2, 0x34, 0x84,
-	0x7c, 0x03, 0xb5, 0x90, 0x07, 0x97, 0x6c, 0x84, 0xdc, 0xdc, 0x50, 0xe1, 0xf7, 0x4b, 0xc2, 0x07,
-	0x09, 0x24, 0x4d, 0x43, 0xe7, 0x41, 0xf6, 0x6f, 0xeb, 0xd0, 0x5e, 0x3c, 0xe5, 0xff, 0x47, 0x03,
-	0xb9, 0x0d, 0x1b, 0xa1, 0xc3, 0xd1, 0x17, 0xe6, 0xa6, 0xba, 0x54, 0x62, 0x11, 0x1b, 0x9a, 0x23,
-	0x0c, 0xd1, 0x1f, 0xa1, 0xef, 0xca, 0xba, 0x6b, 0x7b, 0xd5, 0xfd, 0x3a, 0xcd, 0xf9, 0x08, 0x83,
-	0x77, 0x92, 0x72, 0x67, 0xfd, 0x2c, 0xb6, 0xbe, 0x57, 0xdd, 0x6f, 0x74, 0x3f, 0x5b, 0x51, 0x47,
-	0x67, 0x50, 0x12, 0x77, 0xe4, 0x0b, 0x3e, 0xa3, 0xa5, 0x29, 0xad, 0x10, 0xee, 0x2c, 0x0d, 0x91,
-	0x44, 0x5f, 0xe0, 0x2c, 0x69, 0x9a, 0x5c, 0x92, 0x27, 0x70, 0xe3, 0xd2, 0xf1, 0x62, 0x4c, 0xda,
-	0xf5, 0x61, 0x39, 0x27, 0x85, 0x74, 0x54, 0x47, 0x7d, 0x59, 0x79, 0x6c, 0xd8, 0x7f, 0x56, 0x61,
-	0x67, 0x09, 0xfd, 0xc4, 0x84, 0x4d, 0xd9, 0x78, 0x74, 0x85, 0x3a, 0xb4, 0x46, 0x53, 0x93, 0xbc,
-	0x0f, 0x5b, 0x6c, 0xe2, 0x07, 0x1c, 0x0f, 0x5f, 0x39, 0xfe, 0x44, 0xe9, 0x45, 0xf2, 0x96, 0x77,
-	0x92, 0x4f, 0xe0, 0xd6, 0x08, 0x3d, 0x14, 0x78, 0x80, 0xe3, 0x80, 0x23, 0xc5, 0xd0, 0x73, 0x5c,
-	0x54, 0x4a, 0xa9, 0xd1, 0xb2, 0x2d, 0xf2, 0x35, 0x58, 0x25, 0xee, 0x3e, 0x8e, 0x99, 0x8f, 0x23,
-	0xa5, 0xa7, 0x1a, 0x5d, 0x81, 0x20, 0x8f, 0x61, 0xc7, 0x19, 0x8d, 0x98, 0xbc, 0xbe, 0xe3, 0x0d,
-	0xd1, 0xe5, 0x28, 0xce, 0x62, 0x11, 0xc6, 0x42, 0xaa, 0x4e, 0xde, 0x70, 0xd9, 0xb6, 0xac, 0xd5,
-	0xf1, 0x98, 0x13, 0x61, 0x64, 0x6e, 0x28, 0x64, 0x6a, 0x92, 0x73, 0x68, 0xb9, 0x71, 0x24, 0x82,
-	0xe9, 0x33, 0x36, 0xc5, 0x40, 0xa6, 0xda, 0x54, 0x6c, 0x3f, 0x7a, 0xb3, 0x80, 0x3b, 0x87, 0xb9,
-	0x40, 0xba, 0x90, 0xc8, 0x7a, 0x01, 0xad, 0x3c, 0x42, 0xea, 0xd4, 0xe5, 0xe8, 0x08, 0xfd, 0x6d,
-	0x1a, 0x34, 0xb1, 0xa4, 0x3f, 0x0e, 0x47, 0xd2, 0x5f, 0xd1, 0x7e, 0x6d, 0x49, 0xbf, 0xa6, 0x43,
-	0xb1, 0x6a, 0xd0, 0xc4, 0xb2, 0x7f, 0x31, 0xc0, 0x5c, 0xf6, 0x59, 0xfc, 0x07, 0x9f, 0xbf, 0xdd,
-	0x85, 0xdd, 0x55, 0x8a, 0x94, 0x31, 0x31, 0xf7, 0x23, 0xd3, 0x50, 0xdc, 0xab, 0xb5, 0x3d, 0x80,
-	0x5b, 0x49, 0xcc, 0x50, 0x38, 0xee, 0x45, 0x3a, 0xc3, 0xbf, 0x80, 0x3a, 0x4f, 0x2a, 0xd1, 0xf8,
-	0x46, 0xf7, 0xee, 0x8a, 0x56, 0xd0, 0x2b, 0xb4, 0xfd, 0x03, 0xdc, 0x9c, 0x3f, 0x08, 0x51, 0x18,
-	0xf8, 0x91, 0x54, 0x5c, 0x63, 0xc4, 0x9c, 0x89, 0x1f, 0x44, 0x82, 0xb9, 0x5a, 0xc7, 0x8d, 0xee,
-	0x6e, 0x31, 0x5f, 0x7f, 0x0e, 0xa2, 0xd9, 0x00, 0xfb, 0xf7, 0x0a, 0x6c, 0x17, 0x20, 0xe4, 0x1e,
-	0x40, 0x18, 0x78, 0xcc, 0x9d, 0x3d, 0x95, 0x44, 0x68, 0x9e, 0x33, 0x1e, 0xf2, 0x01, 0xb4, 0xb4,
-	0x35, 0x70, 0xdc, 0x0b, 0x85, 0xa9, 0x28, 0xcc, 0x82, 0x97, 0x7c, 0x04, 0xdb, 0x57, 0x9e, 0xe7,
-	0xc8, 0x23, 0x16, 0xa4, 0x54, 0x17, 0x37, 0xc8, 0x1e, 0x34, 0x46, 0x18, 0xb9, 0x9c, 0x29, 0xf5,
-	0x25, 0xfc, 0x67, 0x5d, 0x52, 0xe5, 0x53, 0x8c, 0x22, 0x67, 0x82, 0x6a, 0x0a, 0xd7, 0x69, 0x6a,
-	0x2a, 0x4d, 0x38, 0x93, 0x54, 0xfc, 0x6a, 0x4d, 0x8e, 0xa1, 0x8d, 0xfe, 0x38, 0xe0, 0x2e, 0x4e,
-	0xd1, 0x17, 0xa7, 0x78, 0x89, 0x9e, 0xd2, 0x7e, 0x2b, 0x47, 0xf8, 0xd1, 0x02, 0x84, 0x16, 0x82,
-	0x52, 0x8d, 0xd4, 0xe6, 0x1a, 0xb1, 0xff, 0xaa, 0x40, 0x33, 0xed, 0xd4, 0x89, 0x3f, 0x0e, 0xe6,
-	0xa2, 0x31, 0x32, 0x6f, 0x86, 0xac, 0x87, 0x45, 0xa1, 0xe7, 0xcc, 0x32, 0x14, 0x65, 0x5d, 0xe4,
-	0x11, 0xd4, 0x14, 0x0d, 0x52, 0xb3, 0x55, 0xd5, 0xba, 0x77, 0x33, 0x37, 0x1b, 0x28, 0x86, 0x64,
-	0x7a, 0x3a, 0x87, 0x49, 0x0a, 0x2e, 0x13, 0x22, 0x35, 0x41, 0xa9, 0x29, 0x9b, 0x12, 0xc5, 0x61,
-	0x18, 0x70, 0x11, 0x1d, 0x06, 0xfe, 0x98, 0x4d, 0x14, 0x47, 0x35, 0xba, 0xe0, 0x25, 0x03, 0xd8,
-	0x62, 0x3e, 0x13, 0xcc, 0xf1, 0x12, 0xd8, 0x86, 0x3a, 0xf9, 0x41, 0x89, 0x08, 0xe5, 0xd9, 0x9d,
-	0x93, 0x2c, 0x58, 0x4f, 0xff, 0x7c, 0x02, 0xeb, 0x1c, 0x48, 0x11, 0x54, 0x32, 0xef, 0x3f, 0xce,
-	0xcf, 0xfb, 0x9d, 0x42, 0xad, 0x3a, 0x3c, 0x3b, 0xdf, 0x7f, 0xae, 0x00, 0x5c, 0xf1, 0x70, 0x4d,
-	0x9a, 0x17, 0x84, 0x55, 0x5d, 0x29, 0xac, 0xf5, 0xbc, 0xb0, 0xca, 0x44, 0x74, 0xe3, 0x3a, 0x22,
-	0xea, 0x41, 0xd3, 0x55, 0xe5, 0x0d, 0xdd, 0x57, 0x38, 0x75, 0x92, 0xdf, 0x01, 0xef, 0x2d, 0xe1,
-	0x40, 0x83, 0x68, 0x2e, 0xc4, 0x66, 0x40, 0x8a, 0x98, 0x85, 0xd1, 0x67, 0xbc, 0xfd, 0xe8, 0xb3,
-	0xa0, 0xc6, 0xf1, 0x75, 0xcc, 0x38, 0x8e, 0x92, 0x07, 0x70, 0x6e, 0xdb, 0xbf, 0x1a, 0xd0, 0xcc,
-	0x9e, 0x55, 0xca, 0x83, 0x71, 0x1d, 0x1e, 0xae, 0x3b, 0xa9, 0xed, 0x3f, 0x0c, 0x30, 0xf5, 0x65,
-	0x62, 0x8e, 0x57, 0x63, 0x52, 0x4f, 0xd5, 0x73, 0x68, 0x86, 0x99, 0xeb, 0x26, 0x83, 0x35, 0xfb,
-	0xe3, 0x66, 0x59, 0x68, 0x8e, 0x76, 0x2d, 0xef, 0x5c, 0x2a, 0xeb, 0x05, 0x6c, 0x17, 0x20, 0xff,
-	0x8a, 0xb8, 0x1f, 0x3c, 0x81, 0xf6, 0x22, 0x61, 0xa4, 0x09, 0xb5, 0x5e, 0xff, 0xf9, 0xc9, 0xf0,
-	0x8c, 0x9e, 0xb7, 0xd7, 0xc8, 0x16, 0xd4, 0xbf, 0xef, 0x3d, 0xed, 0xf7, 0x9e, 0x49, 0xd3, 0x90,
-	0x9b, 0xfd, 0x93, 0x61, 0xef, 0xe0, 0xf4, 0xa8, 0xdf, 0xae, 0x74, 0xff, 0xae, 0x40, 0x2d, 0x2d,
-	0x86, 0x1c, 0xc0, 0x66, 0xb2, 0x26, 0x77, 0x8a, 0x5f, 0x72, 0x52, 0xab, 0x65, 0x95, 0x6d, 0xe9,
-	0xa7, 0xc4, 0x5e, 0x23, 0xa7, 0xf3, 0xa1, 0xa6, 0x5e, 0x2c, 0x72, 0xaf, 0x88, 0xce, 0x3e, 0x65,
-	0x6f, 0xc8, 0xd6, 0x87, 0x9b, 0xc7, 0x28, 0x72, 0x53, 0xf2, 0x76, 0xa1, 0xcf, 0x47, 0xf2, 0xef,
-	0x90, 0xb5, 0xb3, 0x64, 0xf6, 0xd8, 0x6b, 0xe4, 0x5b, 0xd8, 0x3a, 0x46, 0x31, 0x50, 0xff, 0xa9,
-	0x56, 0xe6, 0xc8, 0x4d, 0xce, 0x39, 0xdc, 0x5e, 0x23, 0xdf, 0x41, 0x7d, 0xde, 0x7b, 0x72, 0xff,
-	0x2d, 0x14, 0x61, 0x2d, 0x39, 0xc2, 0x5e, 0x7b, 0xb9, 0xa1, 0x3c, 0x9f, 0xfe, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x13, 0xd0, 0x20, 0xeb, 0x00, 0x0e, 0x00, 0x00,
+	// 1373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xdd, 0x6e, 0xdb, 0xc6,
+	0x12, 0x36, 0x25, 0xcb, 0x96, 0x46, 0xb2, 0x22, 0x6f, 0x72, 0x62, 0x46, 0x71, 0x02, 0x83, 0x39,
+	0x38, 0xc7, 0x08, 0x4e, 0x94, 0x44, 0xa7, 0x45, 0xd3, 0xa0, 0x7f, 0x4a, 0xa4, 0x06, 0x4e, 0x9d,
+	0x58, 0x5d, 0x19, 0x41, 0x7c, 0xc9, 0x90, 0x23, 0x65, 0x11, 0x8a, 0x64, 0xc8, 0xa5, 0x03, 0xf5,
+	0xb2, 0xe8, 0x55, 0x81, 0x02, 0xed, 0x03, 0xf4, 0x2d, 0xfa, 0x1e, 0xbd, 0xe9, 0x33, 0xf4, 0x31,
+	0x8a, 0x62, 0x97, 0xa4, 0xb4, 0x94, 0x28, 0xd9, 0x35, 0x0a, 0xb4, 0x40, 0xef, 0x76, 0x67, 0xbf,
+	0x99, 0xdd, 0xf9, 0x76, 0xe6, 0xe3, 0x4a, 0x50, 0x37, 0x5d, 0xd3, 0x99, 0x7c, 0x85, 0x41, 0xcb,
+	0x0f, 0x3c, 0xee, 0x91, 0x8a, 0x1f, 0x39, 0xd1, 0x98, 0x05, 0xbe, 0xd5, 0xac, 0xf9, 0x4e, 0x34,
+	0x62, 0x6e, 0xbc, 0xd0, 0xac, 0xfb, 0x81, 0x77, 0xca, 0xec, 0x14, 0xd8, 0xbc, 0x3e, 0xf2, 0xbc,
+	0x91, 0x83, 0x77, 0xe5, 0xec, 0x55, 0x34, 0xbc, 0x8b, 0x63, 0x9f, 0x4f, 0x92, 0xc5, 0xdd, 0xf9,
+	0xc5, 0x90, 0x07, 0x91, 0xc5, 0xe3, 0x55, 0xe3, 0xeb, 0x02, 0xd4, 0x3b, 0xf1, 0xb6, 0x14, 0xdf,
+	0x46, 0x18, 0x72, 0x42, 0x60, 0x9d, 0x4f, 0x7c, 0xd4, 0xb5, 0x3d, 0x6d, 0xbf, 0x42, 0xe5, 0x98,
+	0x7c, 0x00, 0xe0, 0x07, 0x9e, 0x8f, 0x01, 0x67, 0x18, 0xea, 0x85, 0x3d, 0x6d, 0xbf, 0xda, 0xde,
+	0x69, 0xc5, 0x91, 0x5b, 0x69, 0xe4, 0xd6, 0x40, 0x46, 0xa6, 0x0a, 0x94, 0x34, 0xa0, 0x18, 0x05,
+	0xae, 0x5e, 0x94, 0xb1, 0xc4, 0x50, 0x84, 0x77, 0xcd, 0x31, 0xea, 0xeb, 0x71, 0x78, 0x31, 0x26,
+	0x1f, 0xc1, 0xa6, 0xe7, 0x73, 0xe6, 0xb9, 0xa1, 0x5e, 0x92, 0xb1, 0x8d, 0xd6, 0x34, 0xf7, 0x56,
+	0x72, 0xbc, 0x80, 0x62, 0xe8, 0x45, 0x81, 0x85, 0x47, 0x31, 0x92, 0xa6, 0x2e, 0xe4, 0x53, 0x28,
+	0xa7, 0x84, 0xe8, 0x1b, 0xd2, 0xfd, 0x56, 0x8e, 0x7b, 0x3f, 0x81, 0xa4, 0x61, 0xe8, 0xd4, 0xc9,
+	0xf8, 0x71, 0x1d, 0x1a, 0xf3, 0xbb, 0xfc, 0xf3, 0x68, 0x20, 0x57, 0x61, 0xc3, 0x37, 0x03, 0x74,
+	0xb9, 0xbe, 0x29, 0x0f, 0x95, 0xcc, 0x88, 0x01, 0x35, 0x1b, 0x7d, 0x74, 0x6d, 0x74, 0x2d, 0x91,
+	0x77, 0x79, 0xaf, 0xb8, 0x5f, 0xa1, 0x19, 0x1b, 0x61, 0x70, 0x25, 0x49, 0x77, 0xd2, 0x55, 0xb1,
+	0x95, 0xbd, 0xe2, 0x7e, 0xb5, 0xfd, 0xfe, 0x8a, 0x3c, 0x5a, 0xfd, 0x1c, 0xbf, 0x9e, 0xcb, 0x83,
+	0x09, 0xcd, 0x0d, 0xd9, 0xf4, 0xe1, 0xda, 0x52, 0x17, 0x41, 0xf4, 0x1b, 0x9c, 0x24, 0x97, 0x26,
+	0x86, 0xe4, 0x63, 0x28, 0x9d, 0x9a, 0x4e, 0x84, 0xc9, 0x75, 0xfd, 0x37, 0x9f, 0x93, 0x85, 0x70,
+	0x34, 0xf6, 0x7a, 0x58, 0x78, 0xa0, 0x19, 0xbf, 0x14, 0x61, 0x67, 0x09, 0xfd, 0x44, 0x87, 0x4d,
+	0x71, 0xf1, 0x68, 0x71, 0xb9, 0x69, 0x99, 0xa6, 0x53, 0xf2, 0x6f, 0xd8, 0x62, 0x23, 0xd7, 0x0b,
+	0xf0, 0xf1, 0x6b, 0xd3, 0x1d, 0xc9, 0x7a, 0x11, 0xbc, 0x65, 0x8d, 0xe4, 0x1e, 0x5c, 0xb6, 0xd1,
+	0x41, 0x8e, 0x8f, 0x70, 0xe8, 0x05, 0x48, 0xd1, 0x77, 0x4c, 0x0b, 0x65, 0xa5, 0x94, 0x69, 0xde,
+	0x12, 0xf9, 0x04, 0x9a, 0x39, 0xe6, 0x2e, 0x0e, 0x99, 0x8b, 0xb6, 0xac, 0xa7, 0x32, 0x5d, 0x81,
+	0x20, 0x0f, 0x60, 0xc7, 0xb4, 0x6d, 0x26, 0x8e, 0x6f, 0x3a, 0x03, 0xb4, 0x02, 0xe4, 0x47, 0x11,
+	0xf7, 0x23, 0x2e, 0xaa, 0x4e, 0x9c, 0x70, 0xd9, 0xb2, 0xc8, 0xd5, 0x74, 0x98, 0x19, 0x62, 0xa8,
+	0x6f, 0x48, 0x64, 0x3a, 0x25, 0x27, 0x50, 0xb7, 0xa2, 0x90, 0x7b, 0xe3, 0x63, 0x36, 0x46, 0x4f,
+	0x84, 0xda, 0x94, 0x6c, 0xdf, 0x3f, 0xbb, 0x80, 0x5b, 0x8f, 0x33, 0x8e, 0x74, 0x2e, 0x50, 0xf3,
+	0x25, 0xd4, 0xb3, 0x08, 0x51, 0xa7, 0x56, 0x80, 0x26, 0x8f, 0x7b, 0x53, 0xa3, 0xc9, 0x4c, 0xd8,
+	0x23, 0xdf, 0x36, 0x79, 0x7c, 0xd5, 0x1a, 0x4d, 0x66, 0xc2, 0x1e, 0xd3, 0x21, 0x59, 0xd5, 0x68,
+	0x32, 0x33, 0xbe, 0xd3, 0x40, 0x5f, 0xd6, 0x16, 0x7f, 0x41, 0xfb, 0x1b, 0x6d, 0xd8, 0x5d, 0x55,
+	0x91, 0xc2, 0x27, 0x0a, 0xdc, 0x50, 0xd7, 0x24, 0xf7, 0x72, 0x6c, 0xf4, 0xe1, 0x72, 0xe2, 0x33,
+	0xe0, 0xa6, 0xf5, 0x26, 0xd5, 0xf0, 0x0f, 0xa1, 0x12, 0x24, 0x99, 0xc4, 0xf8, 0x6a, 0xfb, 0xfa,
+	0x8a, 0xab, 0xa0, 0x33, 0xb4, 0xf1, 0x14, 0x48, 0xb2, 0xdc, 0x77, 0x4c, 0x37, 0x0d, 0xf8, 0x1e,
+	0x94, 0x42, 0x8e, 0x7e, 0x1a, 0xec, 0x66, 0x5e, 0x17, 0x39, 0xa6, 0xeb, 0xa2, 0x3d, 0xe0, 0xe8,
+	0xd3, 0x18, 0x6c, 0xfc, 0x50, 0x84, 0xcb, 0x39, 0xcb, 0xa4, 0x0e, 0x05, 0xcf, 0x4f, 0xa8, 0x2d,
+	0x78, 0x7e, 0xca, 0x4f, 0x21, 0xc3, 0x8f, 0xa4, 0xbf, 0xa8, 0xd0, 0x7f, 0x07, 0x8a, 0x9e, 0x13,
+	0x57, 0xf8, 0x19, 0xe9, 0x08, 0x9c, 0x80, 0xbb, 0xf8, 0x4e, 0x2f, 0x9d, 0x03, 0xee, 0xe2, 0x3b,
+	0xb2, 0x07, 0xd5, 0x20, 0x6e, 0x94, 0x2f, 0x70, 0x92, 0x16, 0xb8, 0x6a, 0x22, 0x57, 0xa0, 0x64,
+	0xb3, 0xe1, 0x50, 0xd4, 0xb6, 0x58, 0x8b, 0x27, 0xe4, 0x58, 0xa8, 0x23, 0x37, 0x99, 0x83, 0x76,
+	0x97, 0x0d, 0x87, 0x52, 0x1d, 0xab, 0xed, 0x7b, 0xab, 0x09, 0x6a, 0x75, 0x15, 0x97, 0x58, 0xec,
+	0x32, 0x51, 0x9a, 0x2f, 0x61, 0x7b, 0x01, 0x92, 0x23, 0x6e, 0x77, 0xb2, 0xe2, 0xb6, 0xa3, 0xec,
+	0x3a, 0x2d, 0x21, 0x36, 0x1c, 0xaa, 0x62, 0xf6, 0x25, 0x5c, 0x9a, 0x7e, 0xf0, 0x43, 0xdf, 0x73,
+	0x43, 0xa1, 0x28, 0x55, 0x9b, 0x99, 0x23, 0xd7, 0x0b, 0x39, 0xb3, 0x62, 0x9d, 0xaa, 0xb6, 0x77,
+	0x17, 0x33, 0xe8, 0x4e, 0x41, 0x54, 0x75, 0x30, 0x7e, 0x2a, 0xc0, 0xf6, 0x02, 0x84, 0xdc, 0x04,
+	0xf0, 0x3d, 0x87, 0x59, 0x93, 0xe7, 0xa2, 0xd0, 0xe3, 0x43, 0x2b, 0x16, 0xf2, 0x1f, 0xa8, 0xc7,
+	0xb3, 0xbe, 0x69, 0xbd, 0x91, 0x98, 0xf8, 0xfe, 0xe7, 0xac, 0xe4, 0x7f, 0xb0, 0x3d, 0xb3, 0xbc,
+	0xc0, 0x20, 0x64, 0x5e, 0xda, 0x4a, 0x8b, 0x0b, 0xe2, 0x1a, 0x6d, 0x0c, 0xad, 0x80, 0x49, 0x75,
+	0x49, 0xfa, 0x4b, 0x35, 0x09, 0x15, 0x1b, 0x63, 0x18, 0x9a, 0x23, 0x94, 0xb5, 0x51, 0xa1, 0xe9,
+	0x54, 0x16, 0x9d, 0x39, 0x4a, 0xef, 0x5e, 0x8e, 0xc9, 0x13, 0x68, 0xa0, 0x3b, 0xf4, 0x02, 0x0b,
+	0xc7, 0xe8, 0xf2, 0x43, 0x3c, 0x45, 0x47, 0x6a, 0x5b, 0x3d, 0x53, 0x52, 0xbd, 0x39, 0x08, 0x5d,
+	0x70, 0x4a, 0x6b, 0xbc, 0x3c, 0xad, 0x71, 0xe3, 0x37, 0x0d, 0xaa, 0x14, 0xc7, 0x68, 0x33, 0x53,
+	0x1e, 0xec, 0xef, 0x4a, 0x58, 0x56, 0xf6, 0x4a, 0xe7, 0x97, 0xbd, 0x9b, 0x00, 0xb3, 0x32, 0x91,
+	0x6f, 0x92, 0x0a, 0x55, 0x2c, 0xc6, 0x11, 0x6c, 0xa7, 0xf9, 0xcf, 0x8a, 0xf1, 0x21, 0xd4, 0x82,
+	0x19, 0x29, 0xa9, 0xe0, 0x5c, 0x55, 0xc8, 0x56, 0x38, 0xa3, 0x19, 0xac, 0xf1, 0x6b, 0x01, 0x6a,
+	0x69, 0xb7, 0x1d, 0xb8, 0x43, 0x6f, 0x2a, 0xb3, 0x9a, 0xf2, 0xca, 0x12, 0x09, 0xb3, 0xd0, 0x77,
+	0xcc, 0x89, 0xc2, 0xa1, 0x6a, 0x22, 0xf7, 0xa1, 0x2c, 0x79, 0x12, 0xe9, 0x16, 0xe5, 0xf6, 0xff,
+	0x52, 0x1b, 0x4b, 0x52, 0x28, 0xc2, 0xd3, 0x29, 0x4c, 0x14, 0xd5, 0x69, 0xc2, 0x74, 0xcc, 0x60,
+	0x3a, 0x15, 0xb7, 0x16, 0x46, 0xbe, 0xef, 0x05, 0x3c, 0x7c, 0xec, 0xb9, 0x43, 0x36, 0x92, 0x0c,
+	0x96, 0xe9, 0x9c, 0x95, 0xf4, 0x61, 0x8b, 0xb9, 0x8c, 0x33, 0xd3, 0x49, 0x60, 0x1b, 0x72, 0xe7,
+	0xdb, 0x39, 0x42, 0x22, 0xf6, 0x6e, 0x1d, 0xa8, 0xe0, 0x58, 0x42, 0xb2, 0x01, 0x9a, 0x27, 0x40,
+	0x16, 0x41, 0x7f, 0x50, 0x44, 0x64, 0xae, 0xb1, 0xbb, 0x2a, 0x22, 0xdf, 0x16, 0x00, 0x66, 0x3c,
+	0x5c, 0x90, 0xe6, 0xb9, 0xca, 0x2b, 0xae, 0x6c, 0xd5, 0xf5, 0x6c, 0xab, 0xe6, 0xb5, 0x65, 0xe9,
+	0x22, 0x6d, 0xd9, 0x81, 0x9a, 0x25, 0xd3, 0x1b, 0x58, 0xaf, 0x71, 0x6c, 0x26, 0x2f, 0xe7, 0x1b,
+	0x4b, 0x38, 0x88, 0x41, 0x34, 0xe3, 0x62, 0x30, 0x20, 0x8b, 0x98, 0xb9, 0xae, 0xd1, 0xce, 0xdf,
+	0x35, 0x4d, 0x28, 0x07, 0xf8, 0x36, 0x62, 0x01, 0xda, 0xc9, 0x93, 0x71, 0x3a, 0x37, 0xbe, 0xd7,
+	0xa0, 0xa6, 0xee, 0x95, 0xcb, 0x83, 0x76, 0x11, 0x1e, 0x2e, 0xfa, 0xb6, 0x31, 0x7e, 0xd6, 0x40,
+	0x8f, 0x0f, 0x13, 0x05, 0x38, 0xfb, 0xb4, 0xc6, 0xcf, 0x86, 0x13, 0xa8, 0xf9, 0xca, 0x71, 0x75,
+	0x6d, 0xe1, 0xe7, 0xc0, 0x32, 0xd7, 0x0c, 0xed, 0xc9, 0x17, 0x52, 0x0d, 0x25, 0xbe, 0x90, 0x0b,
+	0x90, 0x3f, 0xa5, 0xb8, 0x6f, 0x1f, 0x42, 0x63, 0x9e, 0x30, 0x52, 0x83, 0x72, 0xa7, 0xfb, 0xe2,
+	0x60, 0x70, 0x44, 0x4f, 0x1a, 0x6b, 0x64, 0x0b, 0x2a, 0xcf, 0x3a, 0xcf, 0xbb, 0x9d, 0x63, 0x31,
+	0xd5, 0xc4, 0x62, 0xf7, 0x60, 0xd0, 0x79, 0x74, 0xd8, 0xeb, 0x36, 0x0a, 0x62, 0x91, 0xf6, 0x9e,
+	0xf5, 0xba, 0x07, 0x9d, 0xe3, 0x5e, 0xa3, 0xd8, 0xfe, 0x66, 0x1d, 0xca, 0x69, 0x6e, 0xe4, 0x11,
+	0x6c, 0x26, 0x63, 0x72, 0x6d, 0xb1, 0xb1, 0x93, 0xd4, 0x9b, 0xcd, 0xbc, 0xa5, 0x58, 0x1e, 0x8d,
+	0x35, 0x72, 0x08, 0x35, 0xf5, 0xc9, 0x47, 0x72, 0xde, 0x62, 0xea, 0x5b, 0xf0, 0x8c, 0x68, 0x4f,
+	0xa1, 0xaa, 0x3c, 0xf7, 0xc8, 0x8d, 0x45, 0xb0, 0xf2, 0x0c, 0x3c, 0x23, 0xd6, 0xe7, 0x50, 0x99,
+	0xea, 0xf9, 0xaa, 0xfc, 0x76, 0x73, 0xc4, 0x5c, 0x8d, 0xd3, 0x85, 0x4b, 0x4f, 0x90, 0x67, 0x84,
+	0xfc, 0xea, 0x42, 0x29, 0xf6, 0xc4, 0x7f, 0x1c, 0xcd, 0x9d, 0x25, 0xf2, 0x68, 0xac, 0x91, 0xcf,
+	0x60, 0xeb, 0x09, 0xf2, 0xbe, 0xfc, 0xe3, 0x64, 0x65, 0x8c, 0x8c, 0xb8, 0x4f, 0xe1, 0x92, 0x9b,
+	0xca, 0xb4, 0x3c, 0xc9, 0xad, 0x73, 0x14, 0x6d, 0x73, 0xc9, 0x16, 0xc6, 0xda, 0xab, 0x0d, 0x69,
+	0xf9, 0xff, 0xef, 0x03, 0x00, 0xe4, 0x08, 0x9c, 0x91, 0xe5, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1076,6 +1354,15 @@ type AnalyzerClient interface {
 	// preview or update. The provided resources are the "outputs", after any mutations
 	// have taken place.
 	AnalyzeStack(ctx context.Context, in *AnalyzeStackRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	// AnalyzePlan analyzes the steps planned by a preview of a deployment, including the resources that are
+	// created, updated, replaced and deleted, so that policies can govern changes as well as state. Before an
+	// update, the engine previews it and calls AnalyzePlan, so that mandatory violations stop the update before
+	// any of its steps are applied.
+	AnalyzePlan(ctx context.Context, in *AnalyzePlanRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error)
+	// Remediate optionally transforms a single resource object. This effectively rewrites
+	// a single resource object's properties instead of using what was generated by the program.
+	// Called with the "inputs" to the resource, after they have been checked and before they are analyzed.
+	Remediate(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*RemediateResponse, error)
 	// GetAnalyzerInfo returns metadata about the analyzer (e.g., list of policies contained).
 	GetAnalyzerInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AnalyzerInfo, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
@@ -1110,6 +1397,24 @@ func (c *analyzerClient) AnalyzeStack(ctx context.Context, in *AnalyzeStackReque
 	return out, nil
 }
 
+func (c *analyzerClient) AnalyzePlan(ctx context.Context, in *AnalyzePlanRequest, opts ...grpc.CallOption) (*AnalyzeResponse, error) {
+	out := new(AnalyzeResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.Analyzer/AnalyzePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerClient) Remediate(ctx context.Context, in *AnalyzeRequest, opts ...grpc.CallOption) (*RemediateResponse, error) {
+	out := new(RemediateResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.Analyzer/Remediate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerClient) GetAnalyzerInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AnalyzerInfo, error) {
 	out := new(AnalyzerInfo)
 	err := c.cc.Invoke(ctx, "/pulumirpc.Analyzer/GetAnalyzerInfo", in, out, opts...)
@@ -1146,6 +1451,15 @@ type AnalyzerServer interface {
 	// preview or update. The provided resources are the "outputs", after any mutations
 	// have taken place.
 	AnalyzeStack(context.Context, *AnalyzeStackRequest) (*AnalyzeResponse, error)
+	// AnalyzePlan analyzes the steps planned by a preview of a deployment, including the resources that are
+	// created, updated, replaced and deleted, so that policies can govern changes as well as state. Before an
+	// update, the engine previews it and calls AnalyzePlan, so that mandatory violations stop the update before
+	// any of its steps are applied.
+	AnalyzePlan(context.Context, *AnalyzePlanRequest) (*AnalyzeResponse, error)
+	// Remediate optionally transforms a single resource object. This effectively rewrites
+	// a single resource object's properties instead of using what was generated by the program.
+	// Called with the "inputs" to the resource, after they have been checked and before they are analyzed.
+	Remediate(context.Context, *AnalyzeRequest) (*RemediateResponse, error)
 	// GetAnalyzerInfo returns metadata about the analyzer (e.g., list of policies contained).
 	GetAnalyzerInfo(context.Context, *empty.Empty) (*AnalyzerInfo, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
@@ -1164,6 +1478,12 @@ func (*UnimplementedAnalyzerServer) Analyze(ctx context.Context, req *AnalyzeReq
 func (*UnimplementedAnalyzerServer) AnalyzeStack(ctx context.Context, req *AnalyzeStackRequest) (*AnalyzeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeStack not implemented")
 }
+func (*UnimplementedAnalyzerServer) AnalyzePlan(ctx context.Context, req *AnalyzePlanRequest) (*AnalyzeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzePlan not implemented")
+}
+func (*UnimplementedAnalyzerServer) Remediate(ctx context.Context, req *AnalyzeRequest) (*RemediateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remediate not implemented")
+}
 func (*UnimplementedAnalyzerServer) GetAnalyzerInfo(ctx context.Context, req *empty.Empty) (*AnalyzerInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAnalyzerInfo not implemented")
 }
@@ -1214,6 +1534,42 @@ func _Analyzer_AnalyzeStack_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Analyzer_AnalyzePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServer).AnalyzePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Analyzer/AnalyzePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServer).AnalyzePlan(ctx, req.(*AnalyzePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analyzer_Remediate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServer).Remediate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.Analyzer/Remediate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServer).Remediate(ctx, req.(*AnalyzeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Analyzer_GetAnalyzerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -1280,6 +1636,14 @@ var _Analyzer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnalyzeStack",
 			Handler:    _Analyzer_AnalyzeStack_Handler,
 		},
+		{
+			MethodName: "AnalyzePlan",
+			Handler:    _Analyzer_AnalyzePlan_Handler,
+		},
+		{
+			MethodName: "Remediate",
+			Handler:    _Analyzer_Remediate_Handler,
+		},
 		{
 			MethodName: "GetAnalyzerInfo",
 			Handler:    _Analyzer_GetAnalyzerInfo_Handler,
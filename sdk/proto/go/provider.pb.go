@@ -60,7 +60,7 @@ func (x PropertyDiff_Kind) String() string {
 }
 
 func (PropertyDiff_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{11, 0}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{15, 0}
 }
 
 type DiffResponse_DiffChanges int32
@@ -88,7 +88,7 @@ func (x DiffResponse_DiffChanges) String() string {
 }
 
 func (DiffResponse_DiffChanges) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{12, 0}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{16, 0}
 }
 
 type GetSchemaRequest struct {
@@ -492,6 +492,426 @@ func (m *InvokeResponse) GetFailures() []*CheckFailure {
 	return nil
 }
 
+type CallRequest struct {
+	Tok                  string                                       `protobuf:"bytes,1,opt,name=tok,proto3" json:"tok,omitempty"`
+	Args                 *_struct.Struct                              `protobuf:"bytes,2,opt,name=args,proto3" json:"args,omitempty"`
+	ArgDependencies      map[string]*CallRequest_ArgumentDependencies `protobuf:"bytes,3,rep,name=argDependencies,proto3" json:"argDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Provider             string                                       `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	Version              string                                       `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Project              string                                       `protobuf:"bytes,6,opt,name=project,proto3" json:"project,omitempty"`
+	Stack                string                                       `protobuf:"bytes,7,opt,name=stack,proto3" json:"stack,omitempty"`
+	Config               map[string]string                            `protobuf:"bytes,8,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DryRun               bool                                         `protobuf:"varint,9,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	Parallel             int32                                        `protobuf:"varint,10,opt,name=parallel,proto3" json:"parallel,omitempty"`
+	MonitorEndpoint      string                                       `protobuf:"bytes,11,opt,name=monitorEndpoint,proto3" json:"monitorEndpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *CallRequest) Reset()         { *m = CallRequest{} }
+func (m *CallRequest) String() string { return proto.CompactTextString(m) }
+func (*CallRequest) ProtoMessage()    {}
+func (*CallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{7}
+}
+
+func (m *CallRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallRequest.Unmarshal(m, b)
+}
+func (m *CallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallRequest.Marshal(b, m, deterministic)
+}
+func (m *CallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallRequest.Merge(m, src)
+}
+func (m *CallRequest) XXX_Size() int {
+	return xxx_messageInfo_CallRequest.Size(m)
+}
+func (m *CallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallRequest proto.InternalMessageInfo
+
+func (m *CallRequest) GetTok() string {
+	if m != nil {
+		return m.Tok
+	}
+	return ""
+}
+
+func (m *CallRequest) GetArgs() *_struct.Struct {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *CallRequest) GetArgDependencies() map[string]*CallRequest_ArgumentDependencies {
+	if m != nil {
+		return m.ArgDependencies
+	}
+	return nil
+}
+
+func (m *CallRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *CallRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *CallRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *CallRequest) GetStack() string {
+	if m != nil {
+		return m.Stack
+	}
+	return ""
+}
+
+func (m *CallRequest) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *CallRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *CallRequest) GetParallel() int32 {
+	if m != nil {
+		return m.Parallel
+	}
+	return 0
+}
+
+func (m *CallRequest) GetMonitorEndpoint() string {
+	if m != nil {
+		return m.MonitorEndpoint
+	}
+	return ""
+}
+
+// ArgumentDependencies describes the resources that a particular argument depends on.
+type CallRequest_ArgumentDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallRequest_ArgumentDependencies) Reset()         { *m = CallRequest_ArgumentDependencies{} }
+func (m *CallRequest_ArgumentDependencies) String() string { return proto.CompactTextString(m) }
+func (*CallRequest_ArgumentDependencies) ProtoMessage()    {}
+func (*CallRequest_ArgumentDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{7, 0}
+}
+
+func (m *CallRequest_ArgumentDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallRequest_ArgumentDependencies.Unmarshal(m, b)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallRequest_ArgumentDependencies.Marshal(b, m, deterministic)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallRequest_ArgumentDependencies.Merge(m, src)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_Size() int {
+	return xxx_messageInfo_CallRequest_ArgumentDependencies.Size(m)
+}
+func (m *CallRequest_ArgumentDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallRequest_ArgumentDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallRequest_ArgumentDependencies proto.InternalMessageInfo
+
+func (m *CallRequest_ArgumentDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
+type CallResponse struct {
+	Return               *_struct.Struct                             `protobuf:"bytes,1,opt,name=return,proto3" json:"return,omitempty"`
+	ReturnDependencies   map[string]*CallResponse_ReturnDependencies `protobuf:"bytes,2,rep,name=returnDependencies,proto3" json:"returnDependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Failures             []*CheckFailure                             `protobuf:"bytes,3,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *CallResponse) Reset()         { *m = CallResponse{} }
+func (m *CallResponse) String() string { return proto.CompactTextString(m) }
+func (*CallResponse) ProtoMessage()    {}
+func (*CallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{8}
+}
+
+func (m *CallResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallResponse.Unmarshal(m, b)
+}
+func (m *CallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallResponse.Marshal(b, m, deterministic)
+}
+func (m *CallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallResponse.Merge(m, src)
+}
+func (m *CallResponse) XXX_Size() int {
+	return xxx_messageInfo_CallResponse.Size(m)
+}
+func (m *CallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallResponse proto.InternalMessageInfo
+
+func (m *CallResponse) GetReturn() *_struct.Struct {
+	if m != nil {
+		return m.Return
+	}
+	return nil
+}
+
+func (m *CallResponse) GetReturnDependencies() map[string]*CallResponse_ReturnDependencies {
+	if m != nil {
+		return m.ReturnDependencies
+	}
+	return nil
+}
+
+func (m *CallResponse) GetFailures() []*CheckFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+// ReturnDependencies describes the resources that a particular return value depends on.
+type CallResponse_ReturnDependencies struct {
+	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallResponse_ReturnDependencies) Reset()         { *m = CallResponse_ReturnDependencies{} }
+func (m *CallResponse_ReturnDependencies) String() string { return proto.CompactTextString(m) }
+func (*CallResponse_ReturnDependencies) ProtoMessage()    {}
+func (*CallResponse_ReturnDependencies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{8, 0}
+}
+
+func (m *CallResponse_ReturnDependencies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallResponse_ReturnDependencies.Unmarshal(m, b)
+}
+func (m *CallResponse_ReturnDependencies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallResponse_ReturnDependencies.Marshal(b, m, deterministic)
+}
+func (m *CallResponse_ReturnDependencies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallResponse_ReturnDependencies.Merge(m, src)
+}
+func (m *CallResponse_ReturnDependencies) XXX_Size() int {
+	return xxx_messageInfo_CallResponse_ReturnDependencies.Size(m)
+}
+func (m *CallResponse_ReturnDependencies) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallResponse_ReturnDependencies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallResponse_ReturnDependencies proto.InternalMessageInfo
+
+func (m *CallResponse_ReturnDependencies) GetUrns() []string {
+	if m != nil {
+		return m.Urns
+	}
+	return nil
+}
+
+type ListResourcesRequest struct {
+	Types                []string          `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListResourcesRequest) Reset()         { *m = ListResourcesRequest{} }
+func (m *ListResourcesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListResourcesRequest) ProtoMessage()    {}
+func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{9}
+}
+
+func (m *ListResourcesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResourcesRequest.Unmarshal(m, b)
+}
+func (m *ListResourcesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResourcesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListResourcesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResourcesRequest.Merge(m, src)
+}
+func (m *ListResourcesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListResourcesRequest.Size(m)
+}
+func (m *ListResourcesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResourcesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResourcesRequest proto.InternalMessageInfo
+
+func (m *ListResourcesRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *ListResourcesRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type ListResourcesResponse struct {
+	Resources            []*ListResourcesResponse_ListedResource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *ListResourcesResponse) Reset()         { *m = ListResourcesResponse{} }
+func (m *ListResourcesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListResourcesResponse) ProtoMessage()    {}
+func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{10}
+}
+
+func (m *ListResourcesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResourcesResponse.Unmarshal(m, b)
+}
+func (m *ListResourcesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResourcesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListResourcesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResourcesResponse.Merge(m, src)
+}
+func (m *ListResourcesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListResourcesResponse.Size(m)
+}
+func (m *ListResourcesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResourcesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResourcesResponse proto.InternalMessageInfo
+
+func (m *ListResourcesResponse) GetResources() []*ListResourcesResponse_ListedResource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// ListedResource describes a single existing resource.
+type ListResourcesResponse_ListedResource struct {
+	Type                 string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   string            `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Properties           *_struct.Struct   `protobuf:"bytes,4,opt,name=properties,proto3" json:"properties,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Parent               string            `protobuf:"bytes,6,opt,name=parent,proto3" json:"parent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListResourcesResponse_ListedResource) Reset()         { *m = ListResourcesResponse_ListedResource{} }
+func (m *ListResourcesResponse_ListedResource) String() string { return proto.CompactTextString(m) }
+func (*ListResourcesResponse_ListedResource) ProtoMessage()    {}
+func (*ListResourcesResponse_ListedResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{10, 0}
+}
+
+func (m *ListResourcesResponse_ListedResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResourcesResponse_ListedResource.Unmarshal(m, b)
+}
+func (m *ListResourcesResponse_ListedResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResourcesResponse_ListedResource.Marshal(b, m, deterministic)
+}
+func (m *ListResourcesResponse_ListedResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResourcesResponse_ListedResource.Merge(m, src)
+}
+func (m *ListResourcesResponse_ListedResource) XXX_Size() int {
+	return xxx_messageInfo_ListResourcesResponse_ListedResource.Size(m)
+}
+func (m *ListResourcesResponse_ListedResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResourcesResponse_ListedResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResourcesResponse_ListedResource proto.InternalMessageInfo
+
+func (m *ListResourcesResponse_ListedResource) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListResourcesResponse_ListedResource) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ListResourcesResponse_ListedResource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListResourcesResponse_ListedResource) GetProperties() *_struct.Struct {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *ListResourcesResponse_ListedResource) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ListResourcesResponse_ListedResource) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
 type CheckRequest struct {
 	Urn                  string          `protobuf:"bytes,1,opt,name=urn,proto3" json:"urn,omitempty"`
 	Olds                 *_struct.Struct `protobuf:"bytes,2,opt,name=olds,proto3" json:"olds,omitempty"`
@@ -505,7 +925,7 @@ func (m *CheckRequest) Reset()         { *m = CheckRequest{} }
 func (m *CheckRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckRequest) ProtoMessage()    {}
 func (*CheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{7}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{11}
 }
 
 func (m *CheckRequest) XXX_Unmarshal(b []byte) error {
@@ -559,7 +979,7 @@ func (m *CheckResponse) Reset()         { *m = CheckResponse{} }
 func (m *CheckResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckResponse) ProtoMessage()    {}
 func (*CheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{8}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{12}
 }
 
 func (m *CheckResponse) XXX_Unmarshal(b []byte) error {
@@ -606,7 +1026,7 @@ func (m *CheckFailure) Reset()         { *m = CheckFailure{} }
 func (m *CheckFailure) String() string { return proto.CompactTextString(m) }
 func (*CheckFailure) ProtoMessage()    {}
 func (*CheckFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{9}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{13}
 }
 
 func (m *CheckFailure) XXX_Unmarshal(b []byte) error {
@@ -656,7 +1076,7 @@ func (m *DiffRequest) Reset()         { *m = DiffRequest{} }
 func (m *DiffRequest) String() string { return proto.CompactTextString(m) }
 func (*DiffRequest) ProtoMessage()    {}
 func (*DiffRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{10}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{14}
 }
 
 func (m *DiffRequest) XXX_Unmarshal(b []byte) error {
@@ -724,7 +1144,7 @@ func (m *PropertyDiff) Reset()         { *m = PropertyDiff{} }
 func (m *PropertyDiff) String() string { return proto.CompactTextString(m) }
 func (*PropertyDiff) ProtoMessage()    {}
 func (*PropertyDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{11}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{15}
 }
 
 func (m *PropertyDiff) XXX_Unmarshal(b []byte) error {
@@ -806,7 +1226,7 @@ func (m *DiffResponse) Reset()         { *m = DiffResponse{} }
 func (m *DiffResponse) String() string { return proto.CompactTextString(m) }
 func (*DiffResponse) ProtoMessage()    {}
 func (*DiffResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{12}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{16}
 }
 
 func (m *DiffResponse) XXX_Unmarshal(b []byte) error {
@@ -890,7 +1310,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{13}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{17}
 }
 
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
@@ -951,7 +1371,7 @@ func (m *CreateResponse) Reset()         { *m = CreateResponse{} }
 func (m *CreateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateResponse) ProtoMessage()    {}
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{14}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{18}
 }
 
 func (m *CreateResponse) XXX_Unmarshal(b []byte) error {
@@ -1000,7 +1420,7 @@ func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
 func (m *ReadRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadRequest) ProtoMessage()    {}
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{15}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{19}
 }
 
 func (m *ReadRequest) XXX_Unmarshal(b []byte) error {
@@ -1062,7 +1482,7 @@ func (m *ReadResponse) Reset()         { *m = ReadResponse{} }
 func (m *ReadResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadResponse) ProtoMessage()    {}
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{16}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{20}
 }
 
 func (m *ReadResponse) XXX_Unmarshal(b []byte) error {
@@ -1121,7 +1541,7 @@ func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()    {}
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{17}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{21}
 }
 
 func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
@@ -1202,7 +1622,7 @@ func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
 func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateResponse) ProtoMessage()    {}
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{18}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{22}
 }
 
 func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
@@ -1244,7 +1664,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{19}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{23}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1318,7 +1738,7 @@ func (m *ConstructRequest) Reset()         { *m = ConstructRequest{} }
 func (m *ConstructRequest) String() string { return proto.CompactTextString(m) }
 func (*ConstructRequest) ProtoMessage()    {}
 func (*ConstructRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{20}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{24}
 }
 
 func (m *ConstructRequest) XXX_Unmarshal(b []byte) error {
@@ -1456,7 +1876,7 @@ func (m *ConstructRequest_PropertyDependencies) Reset()         { *m = Construct
 func (m *ConstructRequest_PropertyDependencies) String() string { return proto.CompactTextString(m) }
 func (*ConstructRequest_PropertyDependencies) ProtoMessage()    {}
 func (*ConstructRequest_PropertyDependencies) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{20, 0}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{24, 0}
 }
 
 func (m *ConstructRequest_PropertyDependencies) XXX_Unmarshal(b []byte) error {
@@ -1497,7 +1917,7 @@ func (m *ConstructResponse) Reset()         { *m = ConstructResponse{} }
 func (m *ConstructResponse) String() string { return proto.CompactTextString(m) }
 func (*ConstructResponse) ProtoMessage()    {}
 func (*ConstructResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{21}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{25}
 }
 
 func (m *ConstructResponse) XXX_Unmarshal(b []byte) error {
@@ -1553,7 +1973,7 @@ func (m *ConstructResponse_PropertyDependencies) Reset() {
 func (m *ConstructResponse_PropertyDependencies) String() string { return proto.CompactTextString(m) }
 func (*ConstructResponse_PropertyDependencies) ProtoMessage()    {}
 func (*ConstructResponse_PropertyDependencies) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{21, 0}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{25, 0}
 }
 
 func (m *ConstructResponse_PropertyDependencies) XXX_Unmarshal(b []byte) error {
@@ -1597,7 +2017,7 @@ func (m *ErrorResourceInitFailed) Reset()         { *m = ErrorResourceInitFailed
 func (m *ErrorResourceInitFailed) String() string { return proto.CompactTextString(m) }
 func (*ErrorResourceInitFailed) ProtoMessage()    {}
 func (*ErrorResourceInitFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6a9f3c02af3d1c8, []int{22}
+	return fileDescriptor_c6a9f3c02af3d1c8, []int{26}
 }
 
 func (m *ErrorResourceInitFailed) XXX_Unmarshal(b []byte) error {
@@ -1658,6 +2078,18 @@ func init() {
 	proto.RegisterType((*ConfigureErrorMissingKeys_MissingKey)(nil), "pulumirpc.ConfigureErrorMissingKeys.MissingKey")
 	proto.RegisterType((*InvokeRequest)(nil), "pulumirpc.InvokeRequest")
 	proto.RegisterType((*InvokeResponse)(nil), "pulumirpc.InvokeResponse")
+	proto.RegisterType((*CallRequest)(nil), "pulumirpc.CallRequest")
+	proto.RegisterMapType((map[string]*CallRequest_ArgumentDependencies)(nil), "pulumirpc.CallRequest.ArgDependenciesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.CallRequest.ConfigEntry")
+	proto.RegisterType((*CallRequest_ArgumentDependencies)(nil), "pulumirpc.CallRequest.ArgumentDependencies")
+	proto.RegisterType((*CallResponse)(nil), "pulumirpc.CallResponse")
+	proto.RegisterMapType((map[string]*CallResponse_ReturnDependencies)(nil), "pulumirpc.CallResponse.ReturnDependenciesEntry")
+	proto.RegisterType((*CallResponse_ReturnDependencies)(nil), "pulumirpc.CallResponse.ReturnDependencies")
+	proto.RegisterType((*ListResourcesRequest)(nil), "pulumirpc.ListResourcesRequest")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.ListResourcesRequest.TagsEntry")
+	proto.RegisterType((*ListResourcesResponse)(nil), "pulumirpc.ListResourcesResponse")
+	proto.RegisterType((*ListResourcesResponse_ListedResource)(nil), "pulumirpc.ListResourcesResponse.ListedResource")
+	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.ListResourcesResponse.ListedResource.TagsEntry")
 	proto.RegisterType((*CheckRequest)(nil), "pulumirpc.CheckRequest")
 	proto.RegisterType((*CheckResponse)(nil), "pulumirpc.CheckResponse")
 	proto.RegisterType((*CheckFailure)(nil), "pulumirpc.CheckFailure")
@@ -1686,112 +2118,132 @@ func init() {
 func init() { proto.RegisterFile("provider.proto", fileDescriptor_c6a9f3c02af3d1c8) }
 
 var fileDescriptor_c6a9f3c02af3d1c8 = []byte{
-	// 1670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0x1b, 0x45,
-	0x10, 0xf6, 0x4a, 0xb2, 0x6c, 0xb5, 0x7e, 0x22, 0x0f, 0xc1, 0x51, 0x14, 0x1f, 0x5c, 0x0b, 0x55,
-	0x98, 0x84, 0xc8, 0xc6, 0x39, 0x40, 0x52, 0x4e, 0x05, 0xdb, 0x92, 0x8d, 0x2b, 0x89, 0x63, 0xd6,
-	0x09, 0x3f, 0xa7, 0x64, 0xb3, 0x1a, 0xc9, 0x8b, 0xa5, 0xdd, 0x65, 0x76, 0x56, 0x29, 0x73, 0xe6,
-	0xc0, 0x05, 0xae, 0x14, 0x0f, 0x01, 0x54, 0xf1, 0x04, 0x3c, 0x08, 0x1c, 0x79, 0x00, 0x8a, 0x17,
-	0xa0, 0xe6, 0x6f, 0x3d, 0x23, 0xad, 0x7f, 0x49, 0xc1, 0x6d, 0x7b, 0xba, 0xa7, 0xa7, 0xfb, 0x9b,
-	0x9e, 0xfe, 0x59, 0xa8, 0x45, 0x24, 0x1c, 0xf9, 0x5d, 0x4c, 0x5a, 0x11, 0x09, 0x69, 0x88, 0x4a,
-	0x51, 0x32, 0x48, 0x86, 0x3e, 0x89, 0xbc, 0x66, 0x25, 0x1a, 0x24, 0x7d, 0x3f, 0x10, 0x8c, 0xe6,
-	0x8d, 0x7e, 0x18, 0xf6, 0x07, 0x78, 0x99, 0x53, 0x2f, 0x93, 0xde, 0x32, 0x1e, 0x46, 0xf4, 0x48,
-	0x32, 0x17, 0xc6, 0x99, 0x31, 0x25, 0x89, 0x47, 0x05, 0xd7, 0x7e, 0x0f, 0xea, 0xdb, 0x98, 0xee,
-	0x7b, 0x07, 0x78, 0xe8, 0x3a, 0xf8, 0xab, 0x04, 0xc7, 0x14, 0x35, 0x60, 0x66, 0x84, 0x49, 0xec,
-	0x87, 0x41, 0xc3, 0x5a, 0xb4, 0x96, 0xa6, 0x1d, 0x45, 0xda, 0xb7, 0x60, 0x4e, 0x93, 0x8e, 0xa3,
-	0x30, 0x88, 0x31, 0x9a, 0x87, 0x62, 0xcc, 0x57, 0xb8, 0x74, 0xc9, 0x91, 0x94, 0xfd, 0x43, 0x0e,
-	0xea, 0x9b, 0x61, 0xd0, 0xf3, 0xfb, 0x09, 0xc1, 0x4a, 0xf7, 0xc7, 0x50, 0x1a, 0xb9, 0xc4, 0x77,
-	0x5f, 0x0e, 0x70, 0xdc, 0xb0, 0x16, 0xf3, 0x4b, 0xe5, 0xd5, 0x9b, 0xad, 0xd4, 0xaf, 0xd6, 0xb8,
-	0x7c, 0xeb, 0x53, 0x25, 0xdc, 0x09, 0x28, 0x39, 0x72, 0x8e, 0x37, 0xa3, 0x5b, 0x50, 0x70, 0x49,
-	0x3f, 0x6e, 0xe4, 0x16, 0xad, 0xa5, 0xf2, 0xea, 0xb5, 0x96, 0x70, 0xb3, 0xa5, 0xdc, 0x6c, 0xed,
-	0x73, 0x37, 0x1d, 0x2e, 0x84, 0xde, 0x86, 0xaa, 0xeb, 0x79, 0x38, 0xa2, 0xfb, 0xd8, 0x23, 0x98,
-	0xc6, 0x8d, 0xfc, 0xa2, 0xb5, 0x34, 0xeb, 0x98, 0x8b, 0x68, 0x09, 0xae, 0x88, 0x05, 0x07, 0xc7,
-	0x61, 0x42, 0x3c, 0x1c, 0x37, 0x0a, 0x5c, 0x6e, 0x7c, 0xb9, 0xb9, 0x06, 0x35, 0xd3, 0x32, 0x54,
-	0x87, 0xfc, 0x21, 0x3e, 0x92, 0x10, 0xb0, 0x4f, 0x74, 0x15, 0xa6, 0x47, 0xee, 0x20, 0xc1, 0xdc,
-	0xc2, 0x92, 0x23, 0x88, 0x7b, 0xb9, 0x0f, 0x2d, 0xfb, 0x3b, 0x0b, 0xe6, 0x34, 0x4f, 0x25, 0x8e,
-	0x13, 0x36, 0x5a, 0x27, 0xd8, 0x18, 0x27, 0x51, 0x14, 0x12, 0x1a, 0xef, 0x11, 0x3c, 0xf2, 0xf1,
-	0x2b, 0xae, 0x7f, 0xd6, 0x19, 0x5f, 0xce, 0xf2, 0x26, 0x9f, 0xe9, 0x8d, 0xfd, 0xab, 0x05, 0xd7,
-	0x53, 0x7b, 0x3a, 0x84, 0x84, 0xe4, 0xb1, 0x1f, 0xc7, 0x7e, 0xd0, 0x7f, 0x88, 0x8f, 0x62, 0xf4,
-	0x09, 0x94, 0x87, 0xc7, 0xa4, 0xbc, 0xb4, 0xe5, 0xac, 0x4b, 0x1b, 0xdf, 0xda, 0x3a, 0xfe, 0x76,
-	0x74, 0x1d, 0xcd, 0x0d, 0x80, 0x63, 0x16, 0x42, 0x50, 0x08, 0xdc, 0x21, 0x96, 0xd8, 0xf1, 0x6f,
-	0xb4, 0x08, 0xe5, 0x2e, 0x8e, 0x3d, 0xe2, 0x47, 0x94, 0xc5, 0xa1, 0x80, 0x50, 0x5f, 0xb2, 0x7f,
-	0xb6, 0xa0, 0xba, 0x13, 0x8c, 0xc2, 0xc3, 0x34, 0xb6, 0xea, 0x90, 0xa7, 0xe1, 0xa1, 0xba, 0x02,
-	0x1a, 0x1e, 0x5e, 0x2c, 0x46, 0x9a, 0x30, 0xab, 0x1e, 0x1c, 0x07, 0xaa, 0xe4, 0xa4, 0xb4, 0xfe,
-	0x24, 0x0a, 0x9c, 0xa5, 0xc8, 0x2c, 0x94, 0xa7, 0xb3, 0x51, 0x1e, 0x41, 0x4d, 0xd9, 0x2b, 0x6f,
-	0x7c, 0x19, 0x8a, 0x04, 0xd3, 0x84, 0x88, 0x77, 0x76, 0x8a, 0x81, 0x52, 0x0c, 0xdd, 0x81, 0xd9,
-	0x9e, 0xeb, 0x0f, 0x12, 0x82, 0x99, 0x4f, 0x79, 0xbe, 0x45, 0xbb, 0x87, 0x03, 0xec, 0x1d, 0x6e,
-	0x09, 0xbe, 0x93, 0x0a, 0xda, 0x5f, 0x43, 0x85, 0x73, 0x34, 0x98, 0xd4, 0x91, 0x25, 0x87, 0x7d,
-	0x32, 0x98, 0xc2, 0x41, 0xf7, 0x6c, 0x98, 0x98, 0x10, 0x13, 0x0e, 0xf0, 0x2b, 0x11, 0x4b, 0xa7,
-	0x09, 0x33, 0x21, 0x3b, 0x81, 0xaa, 0x3c, 0xfb, 0xd8, 0x65, 0x3f, 0x88, 0x12, 0x19, 0xdd, 0xa7,
-	0xb9, 0x2c, 0xc4, 0x2e, 0xe7, 0xf2, 0x86, 0x74, 0x59, 0x72, 0xe4, 0xd5, 0x46, 0x98, 0x50, 0xf5,
-	0x42, 0x53, 0x9a, 0xa5, 0x2f, 0x82, 0xdd, 0x38, 0x0d, 0x32, 0x49, 0xd9, 0xbf, 0x58, 0x50, 0x6e,
-	0xfb, 0xbd, 0x9e, 0x82, 0xad, 0x06, 0x39, 0xbf, 0x2b, 0x77, 0xe7, 0xfc, 0xae, 0x82, 0x31, 0x37,
-	0x09, 0x63, 0xfe, 0x22, 0x30, 0x16, 0xce, 0x01, 0x23, 0x4b, 0x0d, 0x7e, 0x3f, 0x08, 0x09, 0xde,
-	0x3c, 0x70, 0x83, 0x3e, 0x0f, 0xb1, 0xfc, 0x52, 0xc9, 0x31, 0x17, 0xed, 0xdf, 0x2c, 0xa8, 0xec,
-	0x49, 0xb7, 0x98, 0xe5, 0x68, 0x05, 0x0a, 0x87, 0x7e, 0x20, 0x8c, 0xae, 0xad, 0x2e, 0x68, 0xb8,
-	0xe9, 0x62, 0xad, 0x87, 0x7e, 0xd0, 0x75, 0xb8, 0x24, 0x5a, 0x80, 0x12, 0xc7, 0x9d, 0xad, 0xcb,
-	0xbc, 0x72, 0xbc, 0x60, 0xbf, 0x80, 0x02, 0x93, 0x45, 0x33, 0x90, 0x5f, 0x6f, 0xb7, 0xeb, 0x53,
-	0xe8, 0x0a, 0x94, 0xd7, 0xdb, 0xed, 0xe7, 0x4e, 0x67, 0xef, 0xd1, 0xfa, 0x66, 0xa7, 0x6e, 0x21,
-	0x80, 0x62, 0xbb, 0xf3, 0xa8, 0xf3, 0xb4, 0x53, 0xcf, 0x21, 0x04, 0x35, 0xf1, 0x9d, 0xf2, 0xf3,
-	0x8c, 0xff, 0x6c, 0xaf, 0xbd, 0xfe, 0xb4, 0x53, 0x2f, 0x30, 0xbe, 0xf8, 0x4e, 0xf9, 0xd3, 0xf6,
-	0x1f, 0x79, 0xa8, 0x08, 0xd0, 0x65, 0xbc, 0x34, 0x61, 0x96, 0xe0, 0x68, 0xe0, 0x7a, 0xb2, 0x5c,
-	0x94, 0x9c, 0x94, 0x66, 0x8f, 0x32, 0xa6, 0xa2, 0x92, 0xe4, 0x38, 0x4b, 0x91, 0x68, 0x05, 0xde,
-	0xe8, 0xe2, 0x01, 0xa6, 0x78, 0x03, 0xf7, 0x42, 0x96, 0x62, 0xf9, 0x0e, 0x99, 0xfe, 0xb2, 0x58,
-	0xe8, 0x3e, 0xcc, 0x78, 0x12, 0xdb, 0x02, 0x47, 0xeb, 0x2d, 0x0d, 0x2d, 0xdd, 0x22, 0x4e, 0x48,
-	0xc4, 0x1d, 0xb5, 0x87, 0xe5, 0xfa, 0xae, 0xdf, 0xeb, 0xa9, 0x8b, 0x11, 0x04, 0x7a, 0x0c, 0x95,
-	0x2e, 0xa6, 0xae, 0x3f, 0xc0, 0x5d, 0x0e, 0x68, 0x91, 0xc7, 0xef, 0xbb, 0x27, 0x6a, 0xd6, 0x64,
-	0x45, 0xb9, 0x33, 0xb6, 0xb3, 0x54, 0x73, 0xe0, 0xc6, 0xba, 0x54, 0x63, 0x46, 0xa4, 0x9a, 0xb1,
-	0xe5, 0xe6, 0xe7, 0x30, 0x37, 0xa1, 0x2c, 0xa3, 0x42, 0xdd, 0xd6, 0x2b, 0x94, 0xf9, 0xb0, 0xf4,
-	0x00, 0xd1, 0x4b, 0xd7, 0x7d, 0xf1, 0x28, 0x24, 0x00, 0xa8, 0x0e, 0x95, 0xf6, 0xce, 0xd6, 0xd6,
-	0xf3, 0x67, 0xbb, 0x0f, 0x77, 0x9f, 0x7c, 0xb6, 0x5b, 0x9f, 0x42, 0x55, 0x28, 0xf1, 0x95, 0xdd,
-	0x27, 0xbb, 0x2c, 0x20, 0x14, 0xb9, 0xff, 0xe4, 0x71, 0xa7, 0x9e, 0xb3, 0xbf, 0xb7, 0xa0, 0xba,
-	0x49, 0xb0, 0x4b, 0xf1, 0xc9, 0xd9, 0xe8, 0x03, 0x00, 0xf9, 0x38, 0x7d, 0x7c, 0x66, 0x4e, 0xd2,
-	0x44, 0x59, 0x3c, 0x50, 0x7f, 0x88, 0xc3, 0x84, 0xf2, 0x9b, 0xb6, 0x1c, 0x45, 0x32, 0x4e, 0x24,
-	0x8b, 0xa5, 0x28, 0xe8, 0x8a, 0xb4, 0xbf, 0x80, 0x9a, 0xb2, 0x47, 0x46, 0xdc, 0xf8, 0x3b, 0xbf,
-	0xac, 0x39, 0xf6, 0x8f, 0x16, 0x94, 0x1d, 0xec, 0x76, 0xcf, 0x9f, 0x40, 0xcc, 0xa3, 0xf2, 0xe7,
-	0xf7, 0xfc, 0x38, 0xab, 0x16, 0xce, 0x95, 0x55, 0xed, 0x6f, 0x2d, 0xa8, 0x08, 0xdb, 0x5e, 0xb3,
-	0xd7, 0x9a, 0x29, 0xf9, 0xf3, 0x99, 0xf2, 0xa7, 0x05, 0xd5, 0x67, 0x51, 0x57, 0x0b, 0x89, 0xff,
-	0x33, 0xd3, 0x6a, 0x31, 0x34, 0x6d, 0xc6, 0xd0, 0x44, 0x0e, 0x2e, 0x66, 0xe4, 0x60, 0x3d, 0xd2,
-	0x66, 0xcc, 0x48, 0xdb, 0x81, 0x9a, 0x72, 0x53, 0x62, 0x6e, 0x62, 0x6c, 0x9d, 0x3f, 0xb2, 0xbe,
-	0xb1, 0xa0, 0xda, 0xe6, 0x49, 0xec, 0x3f, 0x88, 0x2d, 0x0d, 0x91, 0x82, 0x81, 0x88, 0xfd, 0x77,
-	0x91, 0x37, 0xf8, 0x62, 0x9e, 0xd0, 0x86, 0x87, 0x88, 0x84, 0x5f, 0x62, 0x8f, 0x4a, 0x73, 0x14,
-	0xc9, 0x72, 0x64, 0x4c, 0x5d, 0xef, 0x50, 0xf5, 0xc3, 0x9c, 0x40, 0x0f, 0xa0, 0xe8, 0xf1, 0xfe,
-	0xb1, 0x91, 0xe7, 0xd9, 0xf1, 0x1d, 0xb3, 0xb1, 0x34, 0x94, 0xcb, 0x4e, 0x53, 0xe4, 0x46, 0xb9,
-	0x8d, 0xd5, 0xef, 0x2e, 0x39, 0x72, 0x92, 0x40, 0x3e, 0x6d, 0x49, 0xf1, 0x9a, 0xef, 0x12, 0x77,
-	0x30, 0xc0, 0x03, 0x7e, 0x95, 0xd3, 0x4e, 0x4a, 0xb3, 0x4c, 0x3a, 0x0c, 0x03, 0x9f, 0x86, 0xa4,
-	0x13, 0x74, 0xa3, 0xd0, 0x0f, 0x68, 0xa3, 0xc8, 0x8d, 0x1a, 0x5f, 0x66, 0xbd, 0x29, 0x3d, 0x8a,
-	0x30, 0xbf, 0xcc, 0x92, 0xc3, 0xbf, 0xd3, 0x7e, 0x75, 0x56, 0xeb, 0x57, 0xe7, 0xa1, 0x18, 0xb9,
-	0x04, 0x07, 0xb4, 0x51, 0x12, 0x5d, 0x84, 0xa0, 0xb4, 0xe7, 0x00, 0xe7, 0xeb, 0x77, 0x5e, 0xc0,
-	0x9c, 0x28, 0xb8, 0x38, 0xc2, 0x41, 0x17, 0x07, 0x1e, 0xbb, 0xae, 0x32, 0x87, 0x66, 0xf5, 0x34,
-	0x68, 0x76, 0xc6, 0x37, 0x09, 0x94, 0x26, 0x95, 0xc9, 0x1b, 0xa2, 0xec, 0x86, 0x2a, 0x2a, 0x44,
-	0x39, 0xc9, 0x86, 0x33, 0xd5, 0xf1, 0xc6, 0x8d, 0x6a, 0xd6, 0x70, 0x66, 0x9e, 0xb9, 0xa7, 0x84,
-	0xe5, 0x70, 0x96, 0x6e, 0x66, 0x67, 0xb8, 0x03, 0xdf, 0x8d, 0x71, 0xdc, 0xa8, 0x89, 0xd2, 0x2c,
-	0x49, 0x64, 0xb3, 0x9a, 0xa8, 0xb9, 0x76, 0x85, 0xb3, 0x8d, 0xb5, 0xe6, 0x4d, 0xb8, 0x9a, 0xd6,
-	0x1f, 0xdd, 0x72, 0x04, 0x85, 0x84, 0x04, 0xaa, 0x11, 0xe0, 0xdf, 0xcd, 0xbb, 0x50, 0xd6, 0xa2,
-	0xe2, 0x22, 0x63, 0x58, 0x73, 0x04, 0xf3, 0xd9, 0xa8, 0x65, 0x68, 0xd9, 0x32, 0x4b, 0xe5, 0xca,
-	0x19, 0xb0, 0x4c, 0xd8, 0xae, 0x9f, 0xbb, 0x06, 0x35, 0x13, 0xb9, 0x0b, 0x0d, 0x8f, 0xbf, 0xe7,
-	0xf8, 0xf0, 0xa8, 0x8e, 0x94, 0xb9, 0x64, 0xb2, 0x8c, 0xde, 0xe6, 0xcf, 0x8d, 0xe2, 0xb3, 0x92,
-	0xb7, 0x90, 0x42, 0x2e, 0xcc, 0xf1, 0x0f, 0x23, 0xee, 0xc4, 0x93, 0xbc, 0x93, 0xed, 0xac, 0xec,
-	0x5a, 0xf6, 0xc7, 0x77, 0xc9, 0xc0, 0x9b, 0xd0, 0x76, 0xa1, 0x6b, 0x7d, 0x05, 0xf3, 0xd9, 0x8a,
-	0x33, 0xb0, 0xda, 0x36, 0xef, 0xe6, 0xfd, 0x53, 0xcd, 0x3d, 0xe3, 0x72, 0xec, 0x9f, 0x2c, 0xb8,
-	0xc6, 0xe7, 0x58, 0x35, 0xb8, 0xed, 0x04, 0x3e, 0xdd, 0xe2, 0xad, 0xd4, 0xeb, 0x2b, 0x92, 0x0d,
-	0x98, 0x11, 0x53, 0x86, 0x80, 0xb8, 0xe4, 0x28, 0xf2, 0xc2, 0x95, 0x7c, 0xf5, 0xaf, 0x19, 0xa8,
-	0x2b, 0x53, 0x55, 0x54, 0xb1, 0x87, 0x9c, 0xfe, 0xa7, 0x41, 0x37, 0x34, 0x3c, 0xc6, 0xff, 0xf5,
-	0x34, 0x17, 0xb2, 0x99, 0x02, 0x2c, 0x7b, 0x0a, 0x6d, 0x40, 0x99, 0x4f, 0x52, 0xe2, 0x8d, 0xa1,
-	0x89, 0xd9, 0x4b, 0xe9, 0x69, 0x4c, 0x32, 0x52, 0x1d, 0x0f, 0x00, 0x78, 0xcf, 0x28, 0xf3, 0xf5,
-	0x44, 0xfb, 0x2b, 0x34, 0x5c, 0x3b, 0xa1, 0x2d, 0xb6, 0xa7, 0x98, 0x3b, 0xe9, 0x3f, 0x06, 0xc3,
-	0x9d, 0xf1, 0xdf, 0x45, 0x86, 0x3b, 0x13, 0x7f, 0x58, 0xb8, 0x29, 0x45, 0x31, 0x83, 0x23, 0xdd,
-	0x60, 0xe3, 0x37, 0x42, 0xf3, 0x7a, 0x06, 0x27, 0x55, 0xb0, 0x0d, 0x95, 0x7d, 0x4a, 0xb0, 0x3b,
-	0xfc, 0x57, 0x6a, 0x56, 0x2c, 0xb4, 0x06, 0xd3, 0x1c, 0xa7, 0xcb, 0x41, 0x7a, 0x17, 0x0a, 0x7c,
-	0x24, 0xb8, 0x04, 0x98, 0x0f, 0xa0, 0x28, 0x3a, 0x5e, 0xc3, 0x76, 0xa3, 0x29, 0x37, 0x6c, 0x37,
-	0xdb, 0x63, 0x71, 0x36, 0x6b, 0x1d, 0x8d, 0xb3, 0xb5, 0x3e, 0xd7, 0x38, 0x5b, 0xef, 0x31, 0xc5,
-	0xd9, 0xa2, 0x07, 0x32, 0xce, 0x36, 0xba, 0x3f, 0xe3, 0x6c, 0xb3, 0x61, 0xb2, 0xa7, 0xd0, 0x1a,
-	0x14, 0x45, 0xe3, 0x63, 0x28, 0x30, 0x7a, 0xa1, 0xe6, 0xfc, 0xc4, 0x93, 0xe9, 0x0c, 0x23, 0x7a,
-	0x94, 0xc6, 0x91, 0x48, 0x08, 0xe3, 0x71, 0x64, 0xa4, 0xf0, 0xf1, 0x38, 0x32, 0x73, 0x88, 0x3d,
-	0x85, 0xee, 0x41, 0x71, 0xd3, 0x0d, 0x3c, 0x3c, 0x40, 0x27, 0x9c, 0x76, 0x8a, 0x15, 0x1f, 0x41,
-	0x75, 0x1b, 0xd3, 0x3d, 0xfe, 0x03, 0x77, 0x27, 0xe8, 0x85, 0x27, 0xaa, 0x78, 0x53, 0x9f, 0xc7,
-	0x52, 0x71, 0x7b, 0xea, 0x65, 0x91, 0x0b, 0xde, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff, 0xac, 0xa3,
-	0xd1, 0x1f, 0x21, 0x16, 0x00, 0x00,
+	// 1995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1c, 0x4b,
+	0x11, 0xf7, 0xec, 0xa7, 0xb7, 0xf6, 0x23, 0xeb, 0x26, 0x2f, 0x9e, 0xcc, 0xb3, 0x84, 0x35, 0x20,
+	0x61, 0x12, 0xde, 0x26, 0x38, 0x87, 0x47, 0x9e, 0x12, 0xe5, 0x39, 0xde, 0x75, 0xb0, 0x12, 0x3b,
+	0x66, 0x9c, 0xf0, 0x71, 0xca, 0x9b, 0xec, 0xf6, 0x6e, 0x06, 0xcf, 0xce, 0x4c, 0x7a, 0x66, 0x1c,
+	0x99, 0x23, 0xe2, 0x80, 0x90, 0xe0, 0x8a, 0x38, 0x22, 0x71, 0x05, 0x24, 0xfe, 0x02, 0xfe, 0x10,
+	0x38, 0xf2, 0x0f, 0xc0, 0x91, 0x0b, 0xea, 0xaf, 0xd9, 0xee, 0x9d, 0x59, 0xef, 0xda, 0x44, 0x70,
+	0x9b, 0xea, 0xaa, 0xae, 0xae, 0xfa, 0x75, 0x75, 0x75, 0x55, 0x0f, 0x74, 0x22, 0x12, 0x9e, 0x7b,
+	0x23, 0x4c, 0x7a, 0x11, 0x09, 0x93, 0x10, 0x35, 0xa2, 0xd4, 0x4f, 0xa7, 0x1e, 0x89, 0x86, 0x56,
+	0x2b, 0xf2, 0xd3, 0x89, 0x17, 0x70, 0x86, 0xf5, 0xe9, 0x24, 0x0c, 0x27, 0x3e, 0xbe, 0xc7, 0xa8,
+	0xb7, 0xe9, 0xf8, 0x1e, 0x9e, 0x46, 0xc9, 0x85, 0x60, 0x6e, 0xcd, 0x33, 0xe3, 0x84, 0xa4, 0xc3,
+	0x84, 0x73, 0xed, 0xef, 0x40, 0xf7, 0x19, 0x4e, 0x4e, 0x87, 0xef, 0xf0, 0xd4, 0x75, 0xf0, 0xfb,
+	0x14, 0xc7, 0x09, 0x32, 0xa1, 0x7e, 0x8e, 0x49, 0xec, 0x85, 0x81, 0x69, 0x6c, 0x1b, 0x3b, 0x55,
+	0x47, 0x92, 0xf6, 0x5d, 0xd8, 0x50, 0xa4, 0xe3, 0x28, 0x0c, 0x62, 0x8c, 0x6e, 0x41, 0x2d, 0x66,
+	0x23, 0x4c, 0xba, 0xe1, 0x08, 0xca, 0xfe, 0x6d, 0x09, 0xba, 0xfb, 0x61, 0x30, 0xf6, 0x26, 0x29,
+	0xc1, 0x52, 0xf7, 0xf7, 0xa1, 0x71, 0xee, 0x12, 0xcf, 0x7d, 0xeb, 0xe3, 0xd8, 0x34, 0xb6, 0xcb,
+	0x3b, 0xcd, 0xdd, 0x3b, 0xbd, 0xcc, 0xaf, 0xde, 0xbc, 0x7c, 0xef, 0x87, 0x52, 0x78, 0x10, 0x24,
+	0xe4, 0xc2, 0x99, 0x4d, 0x46, 0x77, 0xa1, 0xe2, 0x92, 0x49, 0x6c, 0x96, 0xb6, 0x8d, 0x9d, 0xe6,
+	0xee, 0x66, 0x8f, 0xbb, 0xd9, 0x93, 0x6e, 0xf6, 0x4e, 0x99, 0x9b, 0x0e, 0x13, 0x42, 0xdf, 0x84,
+	0xb6, 0x3b, 0x1c, 0xe2, 0x28, 0x39, 0xc5, 0x43, 0x82, 0x93, 0xd8, 0x2c, 0x6f, 0x1b, 0x3b, 0xeb,
+	0x8e, 0x3e, 0x88, 0x76, 0xe0, 0x06, 0x1f, 0x70, 0x70, 0x1c, 0xa6, 0x64, 0x88, 0x63, 0xb3, 0xc2,
+	0xe4, 0xe6, 0x87, 0xad, 0x47, 0xd0, 0xd1, 0x2d, 0x43, 0x5d, 0x28, 0x9f, 0xe1, 0x0b, 0x01, 0x01,
+	0xfd, 0x44, 0x37, 0xa1, 0x7a, 0xee, 0xfa, 0x29, 0x66, 0x16, 0x36, 0x1c, 0x4e, 0x7c, 0x51, 0xfa,
+	0x9e, 0x61, 0xff, 0xda, 0x80, 0x0d, 0xc5, 0x53, 0x81, 0x63, 0xce, 0x46, 0x63, 0x81, 0x8d, 0x71,
+	0x1a, 0x45, 0x21, 0x49, 0xe2, 0x13, 0x82, 0xcf, 0x3d, 0xfc, 0x81, 0xe9, 0x5f, 0x77, 0xe6, 0x87,
+	0x8b, 0xbc, 0x29, 0x17, 0x7a, 0x63, 0xff, 0xc5, 0x80, 0xdb, 0x99, 0x3d, 0x03, 0x42, 0x42, 0x72,
+	0xe4, 0xc5, 0xb1, 0x17, 0x4c, 0x9e, 0xe3, 0x8b, 0x18, 0xfd, 0x00, 0x9a, 0xd3, 0x19, 0x29, 0x36,
+	0xed, 0x5e, 0xd1, 0xa6, 0xcd, 0x4f, 0xed, 0xcd, 0xbe, 0x1d, 0x55, 0x87, 0xf5, 0x14, 0x60, 0xc6,
+	0x42, 0x08, 0x2a, 0x81, 0x3b, 0xc5, 0x02, 0x3b, 0xf6, 0x8d, 0xb6, 0xa1, 0x39, 0xc2, 0xf1, 0x90,
+	0x78, 0x51, 0x42, 0xe3, 0x90, 0x43, 0xa8, 0x0e, 0xd9, 0x7f, 0x32, 0xa0, 0x7d, 0x18, 0x9c, 0x87,
+	0x67, 0x59, 0x6c, 0x75, 0xa1, 0x9c, 0x84, 0x67, 0x72, 0x0b, 0x92, 0xf0, 0xec, 0x6a, 0x31, 0x62,
+	0xc1, 0xba, 0x3c, 0x70, 0x0c, 0xa8, 0x86, 0x93, 0xd1, 0xea, 0x91, 0xa8, 0x30, 0x96, 0x24, 0x8b,
+	0x50, 0xae, 0x16, 0xa3, 0x7c, 0x0e, 0x1d, 0x69, 0xaf, 0xd8, 0xf1, 0x7b, 0x50, 0x23, 0x38, 0x49,
+	0x09, 0x3f, 0x67, 0x97, 0x18, 0x28, 0xc4, 0xd0, 0x03, 0x58, 0x1f, 0xbb, 0x9e, 0x9f, 0x12, 0x4c,
+	0x7d, 0x2a, 0xb3, 0x29, 0xca, 0x3e, 0xbc, 0xc3, 0xc3, 0xb3, 0x03, 0xce, 0x77, 0x32, 0x41, 0xfb,
+	0xdf, 0x15, 0x68, 0xee, 0xbb, 0xbe, 0xff, 0x91, 0x60, 0x7a, 0x0d, 0x37, 0x5c, 0x32, 0xe9, 0xe3,
+	0x08, 0x07, 0x23, 0x1c, 0x0c, 0x3d, 0x16, 0x56, 0xd4, 0x94, 0xbb, 0xaa, 0x29, 0xb3, 0xf5, 0x7a,
+	0x7b, 0xba, 0x34, 0x3f, 0xc8, 0xf3, 0x3a, 0x34, 0xf4, 0x2b, 0x8b, 0xd1, 0xaf, 0xea, 0xe8, 0x9b,
+	0x50, 0x8f, 0x48, 0xf8, 0x53, 0x3c, 0x4c, 0xcc, 0x1a, 0xe7, 0x08, 0x92, 0x9e, 0xbe, 0x38, 0x71,
+	0x87, 0x67, 0x66, 0x9d, 0x9f, 0x3e, 0x46, 0xa0, 0x2f, 0xa0, 0x36, 0x64, 0xd1, 0x6a, 0xae, 0x33,
+	0x9b, 0xed, 0x05, 0x36, 0xf3, 0x90, 0xe6, 0xa6, 0x8a, 0x19, 0x34, 0xcf, 0x8d, 0xc8, 0x85, 0x93,
+	0x06, 0x66, 0x83, 0x6d, 0xb0, 0xa0, 0x98, 0xe5, 0x2e, 0x71, 0x7d, 0x1f, 0xfb, 0x26, 0xb0, 0x7c,
+	0x99, 0xd1, 0x34, 0x3a, 0xa6, 0x61, 0xe0, 0x25, 0x21, 0x19, 0x04, 0xa3, 0x28, 0xf4, 0x82, 0xc4,
+	0x6c, 0x32, 0x7b, 0xe6, 0x87, 0xad, 0x3b, 0x70, 0x73, 0x8f, 0x4c, 0xd2, 0x29, 0x0e, 0x12, 0x0d,
+	0x17, 0x04, 0x95, 0x94, 0x04, 0xfc, 0xd8, 0x35, 0x1c, 0xf6, 0x6d, 0x85, 0x4c, 0x36, 0x07, 0x6a,
+	0x41, 0x0e, 0xda, 0x53, 0x73, 0xd0, 0xa5, 0x5b, 0x94, 0x5b, 0x59, 0x49, 0x58, 0xd6, 0x43, 0x68,
+	0x2a, 0x88, 0x5c, 0x29, 0xd7, 0xfd, 0xb3, 0x04, 0x2d, 0xbe, 0xd4, 0x75, 0x83, 0xfe, 0x0d, 0x20,
+	0xfe, 0xa5, 0xc5, 0x5c, 0x29, 0x9f, 0x86, 0x94, 0x55, 0x7a, 0x4e, 0x6e, 0x06, 0xdf, 0xcc, 0x02,
+	0x55, 0xda, 0xa9, 0x2a, 0xaf, 0x78, 0xaa, 0xac, 0x1d, 0x40, 0xf9, 0x35, 0x0a, 0x77, 0xeb, 0x3d,
+	0x6c, 0x2e, 0xb0, 0xa6, 0x00, 0xc8, 0x2f, 0xf5, 0x0d, 0xbb, 0xb3, 0xba, 0x7f, 0x2a, 0xe8, 0x7f,
+	0x30, 0xe0, 0xe6, 0x0b, 0x2f, 0x9e, 0x25, 0x1f, 0x79, 0xf6, 0x6f, 0x42, 0x35, 0xb9, 0x88, 0xb0,
+	0x34, 0x90, 0x13, 0xe8, 0x31, 0x54, 0x12, 0x77, 0x22, 0x31, 0xfd, 0xb6, 0xb2, 0x66, 0x91, 0x92,
+	0xde, 0x2b, 0x77, 0x22, 0xd0, 0x64, 0xd3, 0xac, 0xcf, 0xa1, 0x91, 0x0d, 0x5d, 0x29, 0x36, 0x7e,
+	0x55, 0x86, 0x4f, 0xe6, 0x56, 0x10, 0x41, 0x72, 0x04, 0x0d, 0x22, 0x07, 0x0b, 0x6e, 0x9c, 0xc2,
+	0x49, 0x6c, 0x14, 0x8f, 0xe4, 0xb8, 0x33, 0xd3, 0x60, 0xfd, 0xbe, 0x04, 0x1d, 0x9d, 0x4b, 0x77,
+	0x8a, 0x3a, 0x2f, 0x2f, 0x1d, 0xfa, 0x8d, 0x3a, 0x50, 0xf2, 0x46, 0xc2, 0xcc, 0x92, 0x37, 0xca,
+	0x2e, 0xa6, 0xb2, 0x72, 0x31, 0x7d, 0x0e, 0x10, 0x91, 0x30, 0xc2, 0x24, 0xf1, 0x44, 0x79, 0x70,
+	0x49, 0x08, 0x2b, 0xa2, 0xe8, 0x48, 0x80, 0x5c, 0x65, 0xde, 0x3c, 0xbc, 0xa2, 0x37, 0xf3, 0xa0,
+	0xd3, 0x6c, 0x14, 0xb9, 0x04, 0x07, 0x32, 0xf1, 0x09, 0xea, 0xfa, 0x9b, 0xf1, 0x33, 0x68, 0xb1,
+	0x50, 0x57, 0xae, 0x09, 0x79, 0x48, 0x1b, 0x0e, 0xfd, 0xa4, 0xd7, 0x44, 0xe8, 0x8f, 0x96, 0x5f,
+	0x13, 0x54, 0x88, 0x0a, 0x07, 0xf8, 0x03, 0x2f, 0x39, 0x2e, 0x13, 0xa6, 0x42, 0x76, 0x0a, 0x6d,
+	0xb1, 0xf6, 0x2c, 0x49, 0x78, 0x41, 0x94, 0x8a, 0x22, 0xe8, 0xb2, 0x24, 0xc1, 0xc5, 0xae, 0x77,
+	0x33, 0x3e, 0x85, 0x96, 0xca, 0x11, 0x77, 0x10, 0xdd, 0x30, 0x89, 0x59, 0x46, 0x53, 0xbc, 0x09,
+	0x76, 0xe3, 0xac, 0x16, 0x11, 0x94, 0xfd, 0x67, 0x03, 0x9a, 0x7d, 0x6f, 0x3c, 0x96, 0xb0, 0xf1,
+	0x18, 0x32, 0xb2, 0x18, 0x12, 0x30, 0x96, 0xf2, 0x30, 0x96, 0xaf, 0x02, 0x63, 0x65, 0x05, 0x18,
+	0x69, 0x05, 0xe9, 0x4d, 0x82, 0x90, 0xe0, 0xfd, 0x77, 0x6e, 0x30, 0xc1, 0x3c, 0xd6, 0x1a, 0x8e,
+	0x3e, 0x68, 0xff, 0xd5, 0x80, 0xd6, 0x89, 0x70, 0x8b, 0x5a, 0x8e, 0xee, 0x43, 0xe5, 0xcc, 0x0b,
+	0xb8, 0xd1, 0x9d, 0xdd, 0x2d, 0x05, 0x37, 0x55, 0xac, 0xf7, 0xdc, 0x0b, 0x46, 0x0e, 0x93, 0x44,
+	0x5b, 0xd0, 0x60, 0xb8, 0xd3, 0x71, 0x51, 0x7e, 0xce, 0x06, 0xec, 0xaf, 0xa0, 0x42, 0x65, 0x51,
+	0x1d, 0xca, 0x7b, 0xfd, 0x7e, 0x77, 0x0d, 0xdd, 0x80, 0xe6, 0x5e, 0xbf, 0xff, 0xc6, 0x19, 0x9c,
+	0xbc, 0xd8, 0xdb, 0x1f, 0x74, 0x0d, 0x04, 0x50, 0xeb, 0x0f, 0x5e, 0x0c, 0x5e, 0x0d, 0xba, 0x25,
+	0x84, 0xa0, 0xc3, 0xbf, 0x33, 0x7e, 0x99, 0xf2, 0x5f, 0x9f, 0xf4, 0xf7, 0x5e, 0x0d, 0xba, 0x15,
+	0xca, 0xe7, 0xdf, 0x19, 0xbf, 0x6a, 0xff, 0xbd, 0x0c, 0x2d, 0x0e, 0xba, 0x88, 0x17, 0x0b, 0xd6,
+	0x09, 0x8e, 0x7c, 0x77, 0x98, 0xa5, 0xb6, 0x8c, 0xa6, 0x35, 0x42, 0x9c, 0xf0, 0x86, 0xa3, 0xc4,
+	0x58, 0x92, 0x44, 0xf7, 0xe1, 0x6b, 0x23, 0xec, 0xe3, 0x04, 0x3f, 0xc5, 0xe3, 0x90, 0x60, 0x87,
+	0xcf, 0x10, 0x55, 0x72, 0x11, 0x0b, 0x3d, 0x86, 0xfa, 0x50, 0x60, 0x5b, 0x61, 0x68, 0x7d, 0x43,
+	0x41, 0x4b, 0xb5, 0x88, 0x11, 0x02, 0x71, 0x47, 0xce, 0xa1, 0xa7, 0x6f, 0xe4, 0x8d, 0xc7, 0x72,
+	0x63, 0x38, 0x81, 0x8e, 0xa0, 0x35, 0xc2, 0x89, 0xeb, 0xf9, 0x78, 0xc4, 0x00, 0xad, 0xe5, 0xd2,
+	0xb0, 0xae, 0x59, 0x91, 0xe5, 0x19, 0x41, 0x9b, 0x4e, 0x6b, 0x8e, 0x77, 0x6e, 0xac, 0x4a, 0xb1,
+	0x1a, 0x68, 0xdd, 0x99, 0x1f, 0xb6, 0x7e, 0x0c, 0x1b, 0x39, 0x65, 0x05, 0x39, 0xe3, 0x33, 0xfd,
+	0x4e, 0xda, 0x5c, 0x10, 0x20, 0x6a, 0x32, 0x79, 0x0c, 0x4d, 0x05, 0x00, 0xd4, 0x85, 0x56, 0xff,
+	0xf0, 0xe0, 0xe0, 0xcd, 0xeb, 0xe3, 0xe7, 0xc7, 0x2f, 0x7f, 0x74, 0xdc, 0x5d, 0x43, 0x6d, 0x68,
+	0xb0, 0x91, 0xe3, 0x97, 0xc7, 0x34, 0x20, 0x24, 0x79, 0xfa, 0xf2, 0x68, 0xd0, 0x2d, 0xd9, 0xbf,
+	0x31, 0xa0, 0xbd, 0x4f, 0xb0, 0x9b, 0xe0, 0xc5, 0xd9, 0x48, 0x4f, 0xc4, 0xa5, 0xd5, 0x13, 0xb1,
+	0x09, 0xf5, 0xc4, 0x9b, 0xe2, 0x30, 0x4d, 0xd8, 0x4e, 0x1b, 0x8e, 0x24, 0x79, 0x35, 0xc9, 0x7b,
+	0x2a, 0xde, 0xf7, 0x49, 0xd2, 0xfe, 0x09, 0x74, 0xa4, 0x3d, 0x22, 0xe2, 0xe6, 0xcf, 0xf9, 0x75,
+	0xcd, 0xb1, 0x7f, 0x67, 0x40, 0xd3, 0xc1, 0xee, 0x68, 0xf5, 0x04, 0xa2, 0x2f, 0x55, 0x5e, 0xdd,
+	0xf3, 0x59, 0x56, 0xad, 0xac, 0x94, 0x55, 0xed, 0x5f, 0x1a, 0xd0, 0xe2, 0xb6, 0x7d, 0x64, 0xaf,
+	0x15, 0x53, 0xca, 0xab, 0x99, 0xf2, 0x0f, 0x03, 0xda, 0xaf, 0xa3, 0x91, 0x12, 0x12, 0xff, 0xcf,
+	0x4c, 0xab, 0xc4, 0x50, 0x55, 0x8f, 0xa1, 0x5c, 0x0e, 0xae, 0x15, 0xe4, 0x60, 0x35, 0xd2, 0xea,
+	0x7a, 0xa4, 0x1d, 0x42, 0x47, 0xba, 0x29, 0x30, 0xd7, 0x31, 0x36, 0x56, 0x8f, 0xac, 0x5f, 0x18,
+	0xd0, 0xee, 0xb3, 0x24, 0xf6, 0x3f, 0x88, 0x2d, 0x05, 0x91, 0x8a, 0x86, 0x88, 0xfd, 0xaf, 0x1a,
+	0x7b, 0x07, 0xe2, 0xcf, 0x4e, 0xca, 0x1b, 0x93, 0x6c, 0xdc, 0x8c, 0x05, 0x8d, 0x5b, 0x49, 0x6d,
+	0xdc, 0x9e, 0x64, 0x8d, 0x1b, 0xaf, 0xd0, 0xbf, 0xa5, 0xbf, 0x3f, 0x68, 0xca, 0x97, 0x74, 0x6f,
+	0x95, 0x85, 0xdd, 0x5b, 0x75, 0x79, 0xf7, 0x56, 0x2b, 0xec, 0xde, 0xb2, 0x6a, 0xb2, 0xae, 0x54,
+	0x93, 0xb2, 0x7a, 0x5c, 0x57, 0xaa, 0xc7, 0x59, 0xd5, 0xd6, 0x50, 0xab, 0x36, 0xe5, 0x38, 0xc0,
+	0x6a, 0xf5, 0xce, 0x57, 0xb0, 0xc1, 0xbe, 0xb4, 0x9e, 0xa8, 0xc9, 0xa0, 0xd9, 0xbd, 0x0c, 0x9a,
+	0xc3, 0xf9, 0x49, 0x1c, 0xa5, 0xbc, 0x32, 0xb1, 0x43, 0x09, 0xdd, 0xa1, 0x96, 0x0c, 0x51, 0x46,
+	0xd2, 0x37, 0x3c, 0xd9, 0x9a, 0xc7, 0x66, 0xbb, 0xe8, 0x0d, 0x4f, 0x5f, 0xf3, 0x44, 0x0a, 0xf3,
+	0xb5, 0x66, 0x93, 0xe9, 0x1a, 0xae, 0xef, 0xb9, 0x31, 0x8e, 0xcd, 0x0e, 0xbf, 0x9a, 0x05, 0x89,
+	0x6c, 0x7a, 0x27, 0x2a, 0xae, 0xdd, 0x60, 0x6c, 0x6d, 0x8c, 0xb6, 0xcc, 0xd9, 0xfd, 0xb3, 0xac,
+	0x09, 0xbb, 0x7e, 0x07, 0x6b, 0x9d, 0xc3, 0xad, 0x62, 0xd4, 0x0a, 0xb4, 0x1c, 0xe8, 0x57, 0xe5,
+	0xfd, 0x25, 0xb0, 0xe4, 0x6c, 0x57, 0xd7, 0x7d, 0x04, 0x1d, 0x1d, 0xb9, 0x2b, 0x95, 0xf3, 0x7f,
+	0x2b, 0xc1, 0x86, 0xb2, 0xa4, 0xc8, 0x25, 0xf9, 0x6b, 0xf4, 0x33, 0x76, 0xdc, 0x12, 0xbc, 0x2c,
+	0x79, 0x73, 0x29, 0xe4, 0xc2, 0x06, 0xfb, 0x28, 0x78, 0xff, 0x79, 0x50, 0xec, 0x2c, 0x5f, 0xb9,
+	0x77, 0x3a, 0x3f, 0x4b, 0x04, 0x5e, 0x4e, 0xdb, 0x95, 0xb6, 0xf5, 0x03, 0xdc, 0x2a, 0x56, 0x5c,
+	0x80, 0xd5, 0x33, 0x7d, 0x6f, 0xbe, 0x7b, 0xa9, 0xb9, 0x4b, 0x36, 0xc7, 0xfe, 0xa3, 0x01, 0x9b,
+	0xec, 0xb9, 0x53, 0x36, 0x68, 0x87, 0x81, 0x97, 0x1c, 0xb0, 0x52, 0xea, 0xe3, 0x5d, 0x92, 0x26,
+	0xd4, 0x79, 0x97, 0xc1, 0x21, 0x6e, 0x38, 0x92, 0xbc, 0xf2, 0x4d, 0xbe, 0xfb, 0xf3, 0x06, 0x74,
+	0xa5, 0xa9, 0x32, 0xaa, 0xe8, 0x41, 0xce, 0x9e, 0xf3, 0xd1, 0xa7, 0x0a, 0x1e, 0xf3, 0xbf, 0x04,
+	0xac, 0xad, 0x62, 0x26, 0x07, 0xcb, 0x5e, 0x43, 0x4f, 0xa1, 0xc9, 0x3a, 0x29, 0x7e, 0xc6, 0x50,
+	0xae, 0xf7, 0x92, 0x7a, 0xcc, 0x3c, 0x23, 0xd3, 0xf1, 0x04, 0x80, 0xd5, 0x8c, 0x22, 0x5f, 0xe7,
+	0xca, 0x5f, 0xae, 0x61, 0x73, 0x41, 0x59, 0x6c, 0xaf, 0x51, 0x77, 0xb2, 0xa7, 0x68, 0xcd, 0x9d,
+	0xf9, 0xbf, 0x0a, 0xd6, 0x56, 0x31, 0x53, 0x31, 0xa5, 0xc6, 0x9f, 0x6a, 0x91, 0x6a, 0xb0, 0xf6,
+	0xda, 0x6c, 0xdd, 0x2e, 0xe0, 0x64, 0x0a, 0x9e, 0x41, 0xeb, 0x34, 0x21, 0xd8, 0x9d, 0xfe, 0x57,
+	0x6a, 0xee, 0x1b, 0xe8, 0x11, 0x54, 0x19, 0x4e, 0xd7, 0x83, 0xf4, 0x21, 0x54, 0x58, 0x4b, 0x70,
+	0x0d, 0x30, 0x9f, 0x40, 0x8d, 0x57, 0xbc, 0x9a, 0xed, 0x5a, 0x51, 0x6e, 0xdd, 0x2e, 0xe0, 0xa8,
+	0x6b, 0xd3, 0xd2, 0x51, 0x5b, 0x5b, 0xa9, 0x73, 0xad, 0xcd, 0xdc, 0xb8, 0xba, 0x36, 0xaf, 0x81,
+	0xb4, 0xb5, 0xb5, 0xea, 0xcf, 0xba, 0x5d, 0xc0, 0xc9, 0x14, 0x3c, 0x82, 0x1a, 0x2f, 0x7c, 0x34,
+	0x05, 0x5a, 0x2d, 0x64, 0xdd, 0xca, 0x1d, 0x99, 0x01, 0xfd, 0x6b, 0x96, 0xc5, 0x11, 0x4f, 0x08,
+	0xf3, 0x71, 0xa4, 0xa5, 0x70, 0x6b, 0xab, 0x98, 0xa9, 0x62, 0x40, 0x5f, 0xed, 0x34, 0x0c, 0x94,
+	0x77, 0x57, 0x6b, 0x33, 0x37, 0x9e, 0x4d, 0x7d, 0x05, 0x6d, 0xed, 0x5d, 0x08, 0x7d, 0x7d, 0xc9,
+	0xb3, 0x9c, 0xb5, 0xbd, 0xec, 0x49, 0xc9, 0x5e, 0xa3, 0xef, 0xdf, 0xfb, 0x6e, 0x30, 0xc4, 0x3e,
+	0x5a, 0xe0, 0xfe, 0x25, 0xb0, 0x7c, 0x09, 0xed, 0x67, 0x38, 0x39, 0x61, 0x3f, 0x1e, 0x0f, 0x83,
+	0x71, 0xb8, 0x50, 0xc5, 0x27, 0x6a, 0x83, 0x98, 0x89, 0xdb, 0x6b, 0x6f, 0x6b, 0x4c, 0xf0, 0xc1,
+	0x7f, 0x06, 0x00, 0xef, 0xbe, 0xd2, 0x03, 0xd9, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1839,6 +2291,11 @@ type ResourceProviderClient interface {
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Construct creates a new instance of the provided component resource and returns its state.
 	Construct(ctx context.Context, in *ConstructRequest, opts ...grpc.CallOption) (*ConstructResponse, error)
+	// Call dynamically executes a method in the provider associated with a component resource.
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
+	// ListResources enumerates the existing resources that the provider is able to manage, e.g. so that they may be
+	// discovered and imported in bulk. Providers that cannot enumerate their resources need not implement this.
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
 	// Cancel signals the provider to abort all outstanding resource operations.
 	Cancel(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
@@ -1993,6 +2450,24 @@ func (c *resourceProviderClient) Construct(ctx context.Context, in *ConstructReq
 	return out, nil
 }
 
+func (c *resourceProviderClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceProviderClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/ListResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceProviderClient) Cancel(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceProvider/Cancel", in, out, opts...)
@@ -2046,6 +2521,11 @@ type ResourceProviderServer interface {
 	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
 	// Construct creates a new instance of the provided component resource and returns its state.
 	Construct(context.Context, *ConstructRequest) (*ConstructResponse, error)
+	// Call dynamically executes a method in the provider associated with a component resource.
+	Call(context.Context, *CallRequest) (*CallResponse, error)
+	// ListResources enumerates the existing resources that the provider is able to manage, e.g. so that they may be
+	// discovered and imported in bulk. Providers that cannot enumerate their resources need not implement this.
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
 	// Cancel signals the provider to abort all outstanding resource operations.
 	Cancel(context.Context, *empty.Empty) (*empty.Empty, error)
 	// GetPluginInfo returns generic information about this plugin, like its version.
@@ -2095,6 +2575,12 @@ func (*UnimplementedResourceProviderServer) Delete(ctx context.Context, req *Del
 func (*UnimplementedResourceProviderServer) Construct(ctx context.Context, req *ConstructRequest) (*ConstructResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Construct not implemented")
 }
+func (*UnimplementedResourceProviderServer) Call(ctx context.Context, req *CallRequest) (*CallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+func (*UnimplementedResourceProviderServer) ListResources(ctx context.Context, req *ListResourcesRequest) (*ListResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
+}
 func (*UnimplementedResourceProviderServer) Cancel(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
@@ -2343,6 +2829,42 @@ func _ResourceProvider_Construct_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceProvider_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).Call(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceProvider_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceProviderServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceProvider/ListResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceProviderServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceProvider_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -2431,6 +2953,14 @@ var _ResourceProvider_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Construct",
 			Handler:    _ResourceProvider_Construct_Handler,
 		},
+		{
+			MethodName: "Call",
+			Handler:    _ResourceProvider_Call_Handler,
+		},
+		{
+			MethodName: "ListResources",
+			Handler:    _ResourceProvider_ListResources_Handler,
+		},
 		{
 			MethodName: "Cancel",
 			Handler:    _ResourceProvider_Cancel_Handler,
@@ -306,6 +306,7 @@ type RegisterResourceRequest struct {
 	Remote                     bool                                                     `protobuf:"varint,20,opt,name=remote,proto3" json:"remote,omitempty"`
 	AcceptResources            bool                                                     `protobuf:"varint,21,opt,name=acceptResources,proto3" json:"acceptResources,omitempty"`
 	Providers                  map[string]string                                        `protobuf:"bytes,22,rep,name=providers,proto3" json:"providers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SourcePosition             *SourcePosition                                          `protobuf:"bytes,23,opt,name=sourcePosition,proto3" json:"sourcePosition,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                                                 `json:"-"`
 	XXX_unrecognized           []byte                                                   `json:"-"`
 	XXX_sizecache              int32                                                    `json:"-"`
@@ -490,6 +491,13 @@ func (m *RegisterResourceRequest) GetProviders() map[string]string {
 	return nil
 }
 
+func (m *RegisterResourceRequest) GetSourcePosition() *SourcePosition {
+	if m != nil {
+		return m.SourcePosition
+	}
+	return nil
+}
+
 // PropertyDependencies describes the resources that a particular property depends on.
 type RegisterResourceRequest_PropertyDependencies struct {
 	Urns                 []string `protobuf:"bytes,1,rep,name=urns,proto3" json:"urns,omitempty"`
@@ -592,6 +600,62 @@ func (m *RegisterResourceRequest_CustomTimeouts) GetDelete() string {
 	return ""
 }
 
+// SourcePosition is a position in a source file.
+type SourcePosition struct {
+	Uri                  string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Line                 int32    `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Column               int32    `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SourcePosition) Reset()         { *m = SourcePosition{} }
+func (m *SourcePosition) String() string { return proto.CompactTextString(m) }
+func (*SourcePosition) ProtoMessage()    {}
+func (*SourcePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1b72f771c35e3b8, []int{5}
+}
+
+func (m *SourcePosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SourcePosition.Unmarshal(m, b)
+}
+func (m *SourcePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SourcePosition.Marshal(b, m, deterministic)
+}
+func (m *SourcePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourcePosition.Merge(m, src)
+}
+func (m *SourcePosition) XXX_Size() int {
+	return xxx_messageInfo_SourcePosition.Size(m)
+}
+func (m *SourcePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourcePosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourcePosition proto.InternalMessageInfo
+
+func (m *SourcePosition) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *SourcePosition) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *SourcePosition) GetColumn() int32 {
+	if m != nil {
+		return m.Column
+	}
+	return 0
+}
+
 // RegisterResourceResponse is returned by the engine after a resource has finished being initialized.  It includes the
 // auto-assigned URN, the provider-assigned ID, and any other properties initialized by the engine.
 type RegisterResourceResponse struct {
@@ -610,7 +674,7 @@ func (m *RegisterResourceResponse) Reset()         { *m = RegisterResourceRespon
 func (m *RegisterResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterResourceResponse) ProtoMessage()    {}
 func (*RegisterResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1b72f771c35e3b8, []int{5}
+	return fileDescriptor_d1b72f771c35e3b8, []int{6}
 }
 
 func (m *RegisterResourceResponse) XXX_Unmarshal(b []byte) error {
@@ -689,7 +753,7 @@ func (m *RegisterResourceResponse_PropertyDependencies) String() string {
 }
 func (*RegisterResourceResponse_PropertyDependencies) ProtoMessage() {}
 func (*RegisterResourceResponse_PropertyDependencies) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1b72f771c35e3b8, []int{5, 0}
+	return fileDescriptor_d1b72f771c35e3b8, []int{6, 0}
 }
 
 func (m *RegisterResourceResponse_PropertyDependencies) XXX_Unmarshal(b []byte) error {
@@ -730,7 +794,7 @@ func (m *RegisterResourceOutputsRequest) Reset()         { *m = RegisterResource
 func (m *RegisterResourceOutputsRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterResourceOutputsRequest) ProtoMessage()    {}
 func (*RegisterResourceOutputsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1b72f771c35e3b8, []int{6}
+	return fileDescriptor_d1b72f771c35e3b8, []int{7}
 }
 
 func (m *RegisterResourceOutputsRequest) XXX_Unmarshal(b []byte) error {
@@ -775,6 +839,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "pulumirpc.RegisterResourceRequest.ProvidersEntry")
 	proto.RegisterType((*RegisterResourceRequest_PropertyDependencies)(nil), "pulumirpc.RegisterResourceRequest.PropertyDependencies")
 	proto.RegisterType((*RegisterResourceRequest_CustomTimeouts)(nil), "pulumirpc.RegisterResourceRequest.CustomTimeouts")
+	proto.RegisterType((*SourcePosition)(nil), "pulumirpc.SourcePosition")
 	proto.RegisterType((*RegisterResourceResponse)(nil), "pulumirpc.RegisterResourceResponse")
 	proto.RegisterMapType((map[string]*RegisterResourceResponse_PropertyDependencies)(nil), "pulumirpc.RegisterResourceResponse.PropertyDependenciesEntry")
 	proto.RegisterType((*RegisterResourceResponse_PropertyDependencies)(nil), "pulumirpc.RegisterResourceResponse.PropertyDependencies")
@@ -784,69 +849,73 @@ func init() {
 func init() { proto.RegisterFile("resource.proto", fileDescriptor_d1b72f771c35e3b8) }
 
 var fileDescriptor_d1b72f771c35e3b8 = []byte{
-	// 977 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x73, 0xdb, 0x44,
-	0x14, 0x8f, 0xed, 0xd4, 0xb1, 0x5f, 0x52, 0x27, 0x6c, 0x5c, 0x67, 0x2b, 0x98, 0x10, 0x04, 0x07,
-	0xc3, 0xc1, 0x69, 0x02, 0x33, 0x0d, 0x4c, 0x81, 0x19, 0xda, 0xc2, 0xf4, 0x50, 0x5a, 0x14, 0x86,
-	0x01, 0x66, 0x60, 0x66, 0x23, 0xbd, 0xb8, 0x22, 0xb6, 0x56, 0xdd, 0x5d, 0x65, 0xc6, 0x37, 0x38,
-	0xf2, 0x1d, 0xb8, 0xf0, 0x55, 0xf8, 0x64, 0xcc, 0xee, 0x6a, 0x5d, 0xc9, 0x92, 0x13, 0x27, 0xbd,
-	0xe9, 0xfd, 0xd7, 0xfe, 0xde, 0xef, 0xbd, 0x5d, 0xe8, 0x09, 0x94, 0x3c, 0x13, 0x21, 0x8e, 0x52,
-	0xc1, 0x15, 0x27, 0xdd, 0x34, 0x9b, 0x64, 0xd3, 0x58, 0xa4, 0xa1, 0xf7, 0xee, 0x98, 0xf3, 0xf1,
-	0x04, 0x0f, 0x8d, 0xe1, 0x2c, 0x3b, 0x3f, 0xc4, 0x69, 0xaa, 0x66, 0xd6, 0xcf, 0x7b, 0x6f, 0xd1,
-	0x28, 0x95, 0xc8, 0x42, 0x95, 0x5b, 0x7b, 0xa9, 0xe0, 0x97, 0x71, 0x84, 0xc2, 0xca, 0xfe, 0x10,
-	0x06, 0xa7, 0x59, 0x9a, 0x72, 0xa1, 0xe4, 0xb7, 0xc8, 0x54, 0x26, 0x30, 0xc0, 0xd7, 0x19, 0x4a,
-	0x45, 0x7a, 0xd0, 0x8c, 0x23, 0xda, 0x38, 0x68, 0x0c, 0xbb, 0x41, 0x33, 0x8e, 0xfc, 0xcf, 0x61,
-	0xaf, 0xe2, 0x29, 0x53, 0x9e, 0x48, 0x24, 0xfb, 0x00, 0xaf, 0x98, 0xcc, 0xad, 0x26, 0xa4, 0x13,
-	0x14, 0x34, 0xfe, 0x3f, 0x2d, 0xd8, 0x0d, 0x90, 0x45, 0x41, 0x7e, 0xa2, 0x25, 0x25, 0x08, 0x81,
-	0x75, 0x35, 0x4b, 0x91, 0x36, 0x8d, 0xc6, 0x7c, 0x6b, 0x5d, 0xc2, 0xa6, 0x48, 0x5b, 0x56, 0xa7,
-	0xbf, 0xc9, 0x00, 0xda, 0x29, 0x13, 0x98, 0x28, 0xba, 0x6e, 0xb4, 0xb9, 0x44, 0x1e, 0x02, 0xa4,
-	0x82, 0xa7, 0x28, 0x54, 0x8c, 0x92, 0xde, 0x39, 0x68, 0x0c, 0x37, 0x8f, 0xf7, 0x46, 0x16, 0x8f,
-	0x91, 0xc3, 0x63, 0x74, 0x6a, 0xf0, 0x08, 0x0a, 0xae, 0xc4, 0x87, 0xad, 0x08, 0x53, 0x4c, 0x22,
-	0x4c, 0x42, 0x1d, 0xda, 0x3e, 0x68, 0x0d, 0xbb, 0x41, 0x49, 0x47, 0x3c, 0xe8, 0x38, 0xec, 0xe8,
-	0x86, 0x29, 0x3b, 0x97, 0x09, 0x85, 0x8d, 0x4b, 0x14, 0x32, 0xe6, 0x09, 0xed, 0x18, 0x93, 0x13,
-	0xc9, 0x47, 0x70, 0x97, 0x85, 0x21, 0xa6, 0xea, 0x14, 0x43, 0x81, 0x4a, 0xd2, 0xae, 0x41, 0xa7,
-	0xac, 0x24, 0x27, 0xb0, 0xc7, 0xa2, 0x28, 0x56, 0x31, 0x4f, 0xd8, 0xc4, 0x2a, 0x5f, 0x64, 0x2a,
-	0xcd, 0x94, 0xa4, 0x60, 0x7e, 0x65, 0x99, 0x59, 0x57, 0x66, 0x93, 0x98, 0x49, 0x94, 0x74, 0xd3,
-	0x78, 0x3a, 0x91, 0x0c, 0x61, 0xdb, 0x16, 0x71, 0xa8, 0x4b, 0xba, 0x65, 0x6a, 0x2f, 0xaa, 0x7d,
-	0x06, 0xfd, 0x72, 0x77, 0xf2, 0xb6, 0xee, 0x40, 0x2b, 0x13, 0x49, 0xde, 0x1f, 0xfd, 0xb9, 0x00,
-	0x70, 0x73, 0x65, 0x80, 0xfd, 0x7f, 0x01, 0xf6, 0x02, 0x1c, 0xc7, 0x52, 0xa1, 0x58, 0x64, 0x81,
-	0xeb, 0x7a, 0xa3, 0xa6, 0xeb, 0xcd, 0xda, 0xae, 0xb7, 0x4a, 0x5d, 0x1f, 0x40, 0x3b, 0xcc, 0xa4,
-	0xe2, 0x53, 0xc3, 0x86, 0x4e, 0x90, 0x4b, 0xe4, 0x10, 0xda, 0xfc, 0xec, 0x0f, 0x0c, 0xd5, 0x75,
-	0x4c, 0xc8, 0xdd, 0x34, 0x96, 0xda, 0xa4, 0x23, 0xda, 0x26, 0x93, 0x13, 0x2b, 0xfc, 0xd8, 0xb8,
-	0x86, 0x1f, 0x9d, 0x05, 0x7e, 0xa4, 0xd0, 0xcf, 0xc1, 0x98, 0x3d, 0x29, 0xe6, 0xe9, 0x1e, 0xb4,
-	0x86, 0x9b, 0xc7, 0x8f, 0x46, 0xf3, 0xd1, 0x1e, 0x2d, 0x01, 0x69, 0xf4, 0xb2, 0x26, 0xfc, 0x69,
-	0xa2, 0xc4, 0x2c, 0xa8, 0xcd, 0x4c, 0x1e, 0xc0, 0x6e, 0x84, 0x13, 0x54, 0xf8, 0x0d, 0x9e, 0x73,
-	0x3d, 0xaa, 0xe9, 0x84, 0x85, 0x48, 0xc1, 0x9c, 0xab, 0xce, 0x54, 0xe4, 0xf0, 0x66, 0x85, 0xc3,
-	0xf1, 0x38, 0xe1, 0x02, 0x1f, 0xbf, 0x62, 0xc9, 0xd8, 0xf0, 0x48, 0x1f, 0xbf, 0xac, 0xac, 0x32,
-	0xfd, 0xee, 0x0d, 0x99, 0xde, 0x5b, 0x99, 0xe9, 0xdb, 0x65, 0xa6, 0x7b, 0xd0, 0x89, 0xa7, 0x7a,
-	0xd1, 0x3c, 0x8b, 0xe8, 0x8e, 0x45, 0xde, 0xc9, 0xe4, 0x17, 0xe8, 0x59, 0x3a, 0xfc, 0x18, 0x4f,
-	0x91, 0xeb, 0x32, 0xef, 0x18, 0x32, 0x1c, 0xad, 0x80, 0xf9, 0xe3, 0x52, 0x60, 0xb0, 0x90, 0x88,
-	0x7c, 0x05, 0x5e, 0x0d, 0x8e, 0x4f, 0xf0, 0x3c, 0x4e, 0x30, 0xa2, 0xc4, 0x9c, 0xfe, 0x0a, 0x0f,
-	0xf2, 0x19, 0xdc, 0x93, 0xf9, 0x42, 0x7d, 0xc9, 0x84, 0x8a, 0xd9, 0xe4, 0x27, 0x36, 0xc9, 0x50,
-	0xd2, 0x5d, 0x13, 0x5a, 0x6f, 0xd4, 0x6c, 0x17, 0x38, 0xe5, 0x0a, 0x69, 0xdf, 0xb2, 0xdd, 0x4a,
-	0x75, 0xe3, 0x7e, 0xaf, 0x76, 0xdc, 0xc9, 0x0b, 0xe8, 0x3a, 0x62, 0x4a, 0x3a, 0x30, 0x0c, 0x3c,
-	0x5a, 0x8d, 0x81, 0x36, 0xc6, 0xd2, 0xee, 0x4d, 0x0e, 0xef, 0x13, 0xe8, 0xd7, 0xd1, 0x53, 0x0f,
-	0x71, 0x26, 0x12, 0x49, 0x1b, 0xa6, 0x5d, 0xe6, 0xdb, 0xfb, 0x19, 0x7a, 0x65, 0x58, 0xcd, 0xf8,
-	0x0a, 0x64, 0xca, 0x2d, 0x80, 0x5c, 0xd2, 0xfa, 0x2c, 0x8d, 0xb4, 0xde, 0x2e, 0x81, 0x5c, 0xd2,
-	0x7a, 0x0b, 0xaa, 0x5b, 0x03, 0x56, 0xf2, 0xfe, 0x6c, 0xc0, 0xfd, 0xa5, 0x53, 0xa2, 0x77, 0xd9,
-	0x05, 0xce, 0xdc, 0x2e, 0xbb, 0xc0, 0x19, 0x79, 0x0e, 0x77, 0x2e, 0x35, 0xa4, 0xf9, 0x1a, 0x7b,
-	0x78, 0xcb, 0x21, 0x0c, 0x6c, 0x96, 0x2f, 0x9a, 0x27, 0x0d, 0xef, 0x11, 0xf4, 0xca, 0x28, 0xd5,
-	0x94, 0xed, 0x17, 0xcb, 0x76, 0x0b, 0xd1, 0xfe, 0x7f, 0x2d, 0xa0, 0xd5, 0xca, 0x4b, 0x77, 0xb1,
-	0xbd, 0x3c, 0x9b, 0xf3, 0xcb, 0xf3, 0xcd, 0xba, 0x6b, 0xad, 0xb6, 0xee, 0x06, 0xd0, 0x96, 0x8a,
-	0x9d, 0x4d, 0xd0, 0xed, 0x4d, 0x2b, 0xe9, 0x41, 0xb3, 0x5f, 0xfa, 0x0a, 0x35, 0x83, 0x96, 0x8b,
-	0xe4, 0xf5, 0x92, 0x35, 0xd6, 0x36, 0x24, 0xfa, 0xf2, 0x4a, 0x04, 0xed, 0x39, 0x6e, 0xba, 0xc7,
-	0x6e, 0xc4, 0xad, 0xbf, 0x6e, 0xc8, 0x80, 0xef, 0xcb, 0x0c, 0x38, 0xb9, 0xed, 0xff, 0x17, 0x9b,
-	0x88, 0xb0, 0xbf, 0x18, 0x9b, 0x2f, 0x30, 0x77, 0xdd, 0x55, 0x3b, 0x79, 0x04, 0x1b, 0x3c, 0xdf,
-	0x81, 0xd7, 0x5c, 0xa9, 0xce, 0xef, 0xf8, 0xef, 0x75, 0xd8, 0x76, 0xf9, 0x9f, 0xf3, 0x24, 0x56,
-	0x5c, 0x90, 0x5f, 0x61, 0x7b, 0xe1, 0x81, 0x46, 0x3e, 0x28, 0x1c, 0xa9, 0xfe, 0x99, 0xe7, 0xf9,
-	0x57, 0xb9, 0xd8, 0x43, 0xfb, 0x6b, 0xe4, 0x6b, 0x68, 0x3f, 0x4b, 0x2e, 0xf9, 0x05, 0x12, 0x5a,
-	0xf0, 0xb7, 0x2a, 0x97, 0xe9, 0x7e, 0x8d, 0x65, 0x9e, 0xe0, 0x3b, 0xd8, 0x3a, 0x55, 0x02, 0xd9,
-	0xf4, 0xad, 0xd2, 0x3c, 0x68, 0x90, 0x1f, 0x60, 0xab, 0xf8, 0x58, 0x21, 0xfb, 0xa5, 0xae, 0x55,
-	0xde, 0x98, 0xde, 0xfb, 0x4b, 0xed, 0xf3, 0x7f, 0xfb, 0x0d, 0x76, 0x16, 0x7b, 0x46, 0xfc, 0xeb,
-	0xd7, 0x81, 0xf7, 0xe1, 0x0a, 0x84, 0xf1, 0xd7, 0xc8, 0xef, 0xd5, 0xa7, 0x8f, 0xbb, 0xd3, 0x3e,
-	0xbe, 0x22, 0x43, 0x99, 0x36, 0xde, 0xa0, 0xc2, 0x89, 0xa7, 0xfa, 0xd1, 0xef, 0xaf, 0x9d, 0xb5,
-	0x8d, 0xe6, 0xd3, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xe7, 0x38, 0x56, 0x31, 0x0c, 0x00,
-	0x00,
+	// 1045 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x72, 0x1b, 0x35,
+	0x14, 0x8e, 0xed, 0xc4, 0xb1, 0x4f, 0x52, 0x27, 0x28, 0xa9, 0xad, 0x2c, 0x4c, 0x08, 0x0b, 0x17,
+	0x86, 0x0b, 0xa7, 0x0d, 0xcc, 0x34, 0x65, 0x0a, 0x0c, 0xa4, 0x85, 0xe9, 0x45, 0xdb, 0xb0, 0x61,
+	0x18, 0x60, 0x06, 0x66, 0x14, 0xef, 0x89, 0xbb, 0x64, 0xbd, 0xda, 0x4a, 0xda, 0xcc, 0xf8, 0x0e,
+	0xde, 0x83, 0xa7, 0x61, 0x78, 0x14, 0x1e, 0x84, 0x91, 0xb4, 0x72, 0x76, 0xed, 0x75, 0xe2, 0x94,
+	0x3b, 0x9d, 0x5f, 0x59, 0xdf, 0xf9, 0xf4, 0x69, 0x0d, 0x1d, 0x81, 0x92, 0x67, 0x62, 0x88, 0x83,
+	0x54, 0x70, 0xc5, 0x49, 0x3b, 0xcd, 0xe2, 0x6c, 0x1c, 0x89, 0x74, 0xe8, 0xbd, 0x3b, 0xe2, 0x7c,
+	0x14, 0xe3, 0xa1, 0x09, 0x9c, 0x67, 0x17, 0x87, 0x38, 0x4e, 0xd5, 0xc4, 0xe6, 0x79, 0xef, 0xcd,
+	0x06, 0xa5, 0x12, 0xd9, 0x50, 0xe5, 0xd1, 0x4e, 0x2a, 0xf8, 0x55, 0x14, 0xa2, 0xb0, 0xb6, 0xdf,
+	0x87, 0xee, 0x59, 0x96, 0xa6, 0x5c, 0x28, 0xf9, 0x2d, 0x32, 0x95, 0x09, 0x0c, 0xf0, 0x4d, 0x86,
+	0x52, 0x91, 0x0e, 0xd4, 0xa3, 0x90, 0xd6, 0x0e, 0x6a, 0xfd, 0x76, 0x50, 0x8f, 0x42, 0xff, 0x31,
+	0xf4, 0xe6, 0x32, 0x65, 0xca, 0x13, 0x89, 0x64, 0x1f, 0xe0, 0x35, 0x93, 0x79, 0xd4, 0x94, 0xb4,
+	0x82, 0x82, 0xc7, 0xff, 0xab, 0x01, 0x3b, 0x01, 0xb2, 0x30, 0xc8, 0x4f, 0xb4, 0x60, 0x0b, 0x42,
+	0x60, 0x55, 0x4d, 0x52, 0xa4, 0x75, 0xe3, 0x31, 0x6b, 0xed, 0x4b, 0xd8, 0x18, 0x69, 0xc3, 0xfa,
+	0xf4, 0x9a, 0x74, 0xa1, 0x99, 0x32, 0x81, 0x89, 0xa2, 0xab, 0xc6, 0x9b, 0x5b, 0xe4, 0x11, 0x40,
+	0x2a, 0x78, 0x8a, 0x42, 0x45, 0x28, 0xe9, 0xda, 0x41, 0xad, 0xbf, 0x71, 0xd4, 0x1b, 0x58, 0x3c,
+	0x06, 0x0e, 0x8f, 0xc1, 0x99, 0xc1, 0x23, 0x28, 0xa4, 0x12, 0x1f, 0x36, 0x43, 0x4c, 0x31, 0x09,
+	0x31, 0x19, 0xea, 0xd2, 0xe6, 0x41, 0xa3, 0xdf, 0x0e, 0x4a, 0x3e, 0xe2, 0x41, 0xcb, 0x61, 0x47,
+	0xd7, 0xcd, 0xb6, 0x53, 0x9b, 0x50, 0x58, 0xbf, 0x42, 0x21, 0x23, 0x9e, 0xd0, 0x96, 0x09, 0x39,
+	0x93, 0x7c, 0x04, 0xf7, 0xd8, 0x70, 0x88, 0xa9, 0x3a, 0xc3, 0xa1, 0x40, 0x25, 0x69, 0xdb, 0xa0,
+	0x53, 0x76, 0x92, 0x63, 0xe8, 0xb1, 0x30, 0x8c, 0x54, 0xc4, 0x13, 0x16, 0x5b, 0xe7, 0xab, 0x4c,
+	0xa5, 0x99, 0x92, 0x14, 0xcc, 0x4f, 0x59, 0x14, 0xd6, 0x3b, 0xb3, 0x38, 0x62, 0x12, 0x25, 0xdd,
+	0x30, 0x99, 0xce, 0x24, 0x7d, 0xd8, 0xb2, 0x9b, 0x38, 0xd4, 0x25, 0xdd, 0x34, 0x7b, 0xcf, 0xba,
+	0x7d, 0x06, 0xbb, 0xe5, 0xe9, 0xe4, 0x63, 0xdd, 0x86, 0x46, 0x26, 0x92, 0x7c, 0x3e, 0x7a, 0x39,
+	0x03, 0x70, 0x7d, 0x69, 0x80, 0xfd, 0x7f, 0x01, 0x7a, 0x01, 0x8e, 0x22, 0xa9, 0x50, 0xcc, 0xb2,
+	0xc0, 0x4d, 0xbd, 0x56, 0x31, 0xf5, 0x7a, 0xe5, 0xd4, 0x1b, 0xa5, 0xa9, 0x77, 0xa1, 0x39, 0xcc,
+	0xa4, 0xe2, 0x63, 0xc3, 0x86, 0x56, 0x90, 0x5b, 0xe4, 0x10, 0x9a, 0xfc, 0xfc, 0x77, 0x1c, 0xaa,
+	0xdb, 0x98, 0x90, 0xa7, 0x69, 0x2c, 0x75, 0x48, 0x57, 0x34, 0x4d, 0x27, 0x67, 0xce, 0xf1, 0x63,
+	0xfd, 0x16, 0x7e, 0xb4, 0x66, 0xf8, 0x91, 0xc2, 0x6e, 0x0e, 0xc6, 0xe4, 0x69, 0xb1, 0x4f, 0xfb,
+	0xa0, 0xd1, 0xdf, 0x38, 0x7a, 0x32, 0x98, 0x5e, 0xed, 0xc1, 0x02, 0x90, 0x06, 0xa7, 0x15, 0xe5,
+	0xcf, 0x12, 0x25, 0x26, 0x41, 0x65, 0x67, 0xf2, 0x00, 0x76, 0x42, 0x8c, 0x51, 0xe1, 0x37, 0x78,
+	0xc1, 0x05, 0x06, 0x98, 0xc6, 0x6c, 0x88, 0x14, 0xcc, 0xb9, 0xaa, 0x42, 0x45, 0x0e, 0x6f, 0xcc,
+	0x71, 0x38, 0x1a, 0x25, 0x5c, 0xe0, 0xc9, 0x6b, 0x96, 0x8c, 0x0c, 0x8f, 0xf4, 0xf1, 0xcb, 0xce,
+	0x79, 0xa6, 0xdf, 0xbb, 0x23, 0xd3, 0x3b, 0x4b, 0x33, 0x7d, 0xab, 0xcc, 0x74, 0x0f, 0x5a, 0xd1,
+	0x38, 0xe5, 0x42, 0x3d, 0x0f, 0xe9, 0xb6, 0x45, 0xde, 0xd9, 0xe4, 0x67, 0xe8, 0x58, 0x3a, 0xfc,
+	0x10, 0x8d, 0x91, 0xeb, 0x6d, 0xde, 0x31, 0x64, 0x78, 0xb8, 0x04, 0xe6, 0x27, 0xa5, 0xc2, 0x60,
+	0xa6, 0x11, 0xf9, 0x12, 0xbc, 0x0a, 0x1c, 0x9f, 0xe2, 0x45, 0x94, 0x60, 0x48, 0x89, 0x39, 0xfd,
+	0x0d, 0x19, 0xe4, 0x33, 0xb8, 0x2f, 0x73, 0x41, 0x3d, 0x65, 0x42, 0x45, 0x2c, 0xfe, 0x91, 0xc5,
+	0x19, 0x4a, 0xba, 0x63, 0x4a, 0xab, 0x83, 0x9a, 0xed, 0x02, 0xc7, 0x5c, 0x21, 0xdd, 0xb5, 0x6c,
+	0xb7, 0x56, 0xd5, 0x75, 0xbf, 0x5f, 0x79, 0xdd, 0xc9, 0x2b, 0x68, 0x3b, 0x62, 0x4a, 0xda, 0x3d,
+	0x68, 0x2c, 0x89, 0xc6, 0xa9, 0xab, 0xb1, 0xb4, 0xbb, 0xee, 0x41, 0xbe, 0x86, 0x8e, 0x4d, 0x3d,
+	0xe5, 0xd2, 0x8c, 0x8e, 0xf6, 0x0c, 0xc6, 0x7b, 0x85, 0xae, 0x67, 0xa5, 0x84, 0x60, 0xa6, 0xc0,
+	0xfb, 0x04, 0x76, 0xab, 0x18, 0xae, 0x75, 0x20, 0x13, 0x89, 0xa4, 0x35, 0x33, 0x71, 0xb3, 0xf6,
+	0x7e, 0x82, 0x4e, 0x79, 0x32, 0x46, 0x01, 0x04, 0x32, 0xe5, 0x34, 0x24, 0xb7, 0xb4, 0x3f, 0x4b,
+	0x43, 0xa6, 0x9c, 0x8e, 0xe4, 0x96, 0xf6, 0xdb, 0xb9, 0x38, 0x25, 0xb1, 0x96, 0xf7, 0x47, 0x0d,
+	0xf6, 0x16, 0x5e, 0x34, 0x2d, 0x87, 0x97, 0x38, 0x71, 0x72, 0x78, 0x89, 0x13, 0xf2, 0x02, 0xd6,
+	0xae, 0xf4, 0x54, 0x72, 0x25, 0x7c, 0xf4, 0x96, 0xf7, 0x38, 0xb0, 0x5d, 0x3e, 0xaf, 0x1f, 0xd7,
+	0xbc, 0x27, 0xd0, 0x29, 0x03, 0x5d, 0xb1, 0xed, 0x6e, 0x71, 0xdb, 0x76, 0xa1, 0xda, 0x7f, 0x09,
+	0x9d, 0x32, 0xd0, 0x56, 0xc3, 0xa3, 0x6b, 0x0d, 0x8f, 0x34, 0xa4, 0x71, 0x94, 0xd8, 0xe2, 0xb5,
+	0xc0, 0xac, 0x0d, 0x80, 0x3c, 0xce, 0xc6, 0x89, 0x01, 0x64, 0x2d, 0xc8, 0x2d, 0xff, 0xef, 0x06,
+	0xd0, 0xf9, 0x93, 0x2c, 0x7c, 0x1e, 0xec, 0x7b, 0x5e, 0x9f, 0xbe, 0xe7, 0xd7, 0x0a, 0xdc, 0x58,
+	0x4e, 0x81, 0xbb, 0xd0, 0x94, 0x8a, 0x9d, 0xc7, 0xe8, 0xa4, 0xdc, 0x5a, 0xfa, 0xee, 0xdb, 0x95,
+	0x7e, 0xd5, 0xcd, 0xdd, 0xcf, 0x4d, 0xf2, 0x66, 0x81, 0xb2, 0x36, 0x0d, 0xaf, 0xbf, 0xb8, 0x71,
+	0x22, 0xf6, 0x1c, 0x77, 0x95, 0xd6, 0x3b, 0x71, 0xf5, 0xcf, 0x3b, 0x32, 0xea, 0x65, 0x99, 0x51,
+	0xc7, 0x6f, 0xfb, 0xfb, 0x8b, 0xa4, 0x40, 0xd8, 0x9f, 0xad, 0xcd, 0x35, 0xd5, 0xbd, 0xc0, 0xf3,
+	0x93, 0x7c, 0x08, 0xeb, 0x3c, 0x97, 0xe5, 0x5b, 0x5e, 0x79, 0x97, 0x77, 0xf4, 0xcf, 0x2a, 0x6c,
+	0xb9, 0xfe, 0x2f, 0x78, 0x12, 0x29, 0x2e, 0xc8, 0x2f, 0xb0, 0x35, 0xf3, 0xcd, 0x48, 0x3e, 0x28,
+	0x8a, 0x42, 0xe5, 0x97, 0xa7, 0xe7, 0xdf, 0x94, 0x62, 0x0f, 0xed, 0xaf, 0x90, 0xaf, 0xa0, 0xf9,
+	0x3c, 0xb9, 0xe2, 0x97, 0x48, 0x68, 0x21, 0xdf, 0xba, 0x5c, 0xa7, 0xbd, 0x8a, 0xc8, 0xb4, 0xc1,
+	0x77, 0xb0, 0x79, 0xa6, 0x04, 0xb2, 0xf1, 0xff, 0x6a, 0xf3, 0xa0, 0x46, 0x1e, 0xc3, 0xea, 0x09,
+	0x8b, 0x63, 0xd2, 0x2d, 0xa4, 0x69, 0x87, 0x2b, 0xef, 0xcd, 0xf9, 0xa7, 0xbf, 0xe1, 0x7b, 0xd8,
+	0x2c, 0x7e, 0x7a, 0x91, 0xfd, 0xd2, 0xc0, 0xe7, 0xbe, 0x98, 0xbd, 0xf7, 0x17, 0xc6, 0xa7, 0x2d,
+	0x7f, 0x85, 0xed, 0xd9, 0x71, 0x13, 0xff, 0x76, 0x65, 0xf2, 0x3e, 0x5c, 0x82, 0x6b, 0xfe, 0x0a,
+	0xf9, 0x0d, 0x7a, 0x0b, 0xd8, 0x44, 0x3e, 0xbe, 0xa1, 0x43, 0x99, 0x71, 0x5e, 0x77, 0x8e, 0x4e,
+	0xcf, 0xf4, 0x5f, 0x18, 0x7f, 0xe5, 0xbc, 0x69, 0x3c, 0x9f, 0xfe, 0x37, 0x00, 0x56, 0xcc, 0x4b,
+	0x14, 0xff, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -864,6 +933,7 @@ type ResourceMonitorClient interface {
 	SupportsFeature(ctx context.Context, in *SupportsFeatureRequest, opts ...grpc.CallOption) (*SupportsFeatureResponse, error)
 	Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*InvokeResponse, error)
 	StreamInvoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (ResourceMonitor_StreamInvokeClient, error)
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
 	ReadResource(ctx context.Context, in *ReadResourceRequest, opts ...grpc.CallOption) (*ReadResourceResponse, error)
 	RegisterResource(ctx context.Context, in *RegisterResourceRequest, opts ...grpc.CallOption) (*RegisterResourceResponse, error)
 	RegisterResourceOutputs(ctx context.Context, in *RegisterResourceOutputsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -927,6 +997,15 @@ func (x *resourceMonitorStreamInvokeClient) Recv() (*InvokeResponse, error) {
 	return m, nil
 }
 
+func (c *resourceMonitorClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
+	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceMonitor/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceMonitorClient) ReadResource(ctx context.Context, in *ReadResourceRequest, opts ...grpc.CallOption) (*ReadResourceResponse, error) {
 	out := new(ReadResourceResponse)
 	err := c.cc.Invoke(ctx, "/pulumirpc.ResourceMonitor/ReadResource", in, out, opts...)
@@ -959,6 +1038,7 @@ type ResourceMonitorServer interface {
 	SupportsFeature(context.Context, *SupportsFeatureRequest) (*SupportsFeatureResponse, error)
 	Invoke(context.Context, *InvokeRequest) (*InvokeResponse, error)
 	StreamInvoke(*InvokeRequest, ResourceMonitor_StreamInvokeServer) error
+	Call(context.Context, *CallRequest) (*CallResponse, error)
 	ReadResource(context.Context, *ReadResourceRequest) (*ReadResourceResponse, error)
 	RegisterResource(context.Context, *RegisterResourceRequest) (*RegisterResourceResponse, error)
 	RegisterResourceOutputs(context.Context, *RegisterResourceOutputsRequest) (*empty.Empty, error)
@@ -977,6 +1057,9 @@ func (*UnimplementedResourceMonitorServer) Invoke(ctx context.Context, req *Invo
 func (*UnimplementedResourceMonitorServer) StreamInvoke(req *InvokeRequest, srv ResourceMonitor_StreamInvokeServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamInvoke not implemented")
 }
+func (*UnimplementedResourceMonitorServer) Call(ctx context.Context, req *CallRequest) (*CallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
 func (*UnimplementedResourceMonitorServer) ReadResource(ctx context.Context, req *ReadResourceRequest) (*ReadResourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadResource not implemented")
 }
@@ -1048,6 +1131,24 @@ func (x *resourceMonitorStreamInvokeServer) Send(m *InvokeResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ResourceMonitor_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceMonitorServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pulumirpc.ResourceMonitor/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceMonitorServer).Call(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceMonitor_ReadResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadResourceRequest)
 	if err := dec(in); err != nil {
@@ -1114,6 +1215,10 @@ var _ResourceMonitor_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Invoke",
 			Handler:    _ResourceMonitor_Invoke_Handler,
 		},
+		{
+			MethodName: "Call",
+			Handler:    _ResourceMonitor_Call_Handler,
+		},
 		{
 			MethodName: "ReadResource",
 			Handler:    _ResourceMonitor_ReadResource_Handler,
@@ -49,6 +49,7 @@ from .stack import (
 
 from .invoke import (
     invoke,
+    call,
 )
 
 from ._json import (
@@ -90,6 +91,7 @@ __all__ = [
 
     # invoke
     "invoke",
+    "call",
 
     # _json
     "to_json",
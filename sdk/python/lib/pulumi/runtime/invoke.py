@@ -13,7 +13,7 @@
 # limitations under the License.
 import asyncio
 import os
-from typing import Optional, TYPE_CHECKING
+from typing import Any, Dict, List, Optional, Set, TYPE_CHECKING
 import grpc
 
 from .. import log
@@ -26,7 +26,7 @@ from .settings import get_monitor, grpc_error_to_exception
 from .sync_await import _sync_await
 
 if TYPE_CHECKING:
-    from .. import Inputs
+    from .. import Inputs, Output, Resource
 
 # This setting overrides a hardcoded maximum protobuf size in the python protobuf bindings. This avoids deserialization
 # exceptions on large gRPC payloads, but makes it possible to use enough memory to cause an OOM error instead [1].
@@ -143,3 +143,109 @@ def invoke(tok: str, props: 'Inputs', opts: Optional[InvokeOptions] = None, typ:
     if invoke_error is not None:
         raise invoke_error
     return InvokeResult(invoke_result)
+
+
+def call(tok: str, props: 'Inputs', res: Optional['Resource'] = None, typ: Optional[type] = None) -> 'Output[Any]':
+    """
+    call dynamically invokes the method, tok, of the resource, res, which is offered by a provider plugin.
+    The inputs can be a bag of computed values (Ts, Awaitable[T]s or Output[T]s), and the result is an
+    Output[Any] that resolves when the call finishes.
+    """
+    log.debug(f"Calling function: tok={tok}")
+
+    if typ and not _types.is_output_type(typ):
+        raise TypeError("Expected typ to be decorated with @output_type")
+
+    # Setup the futures for the output.
+    resolve_value: 'asyncio.Future' = asyncio.Future()
+    resolve_is_known: 'asyncio.Future[bool]' = asyncio.Future()
+    resolve_is_secret: 'asyncio.Future[bool]' = asyncio.Future()
+    resolve_deps: 'asyncio.Future[Set[Resource]]' = asyncio.Future()
+
+    from .. import Output  # pylint: disable=import-outside-toplevel
+    out = Output(resolve_deps, resolve_value, resolve_is_known, resolve_is_secret)
+
+    async def do_call():
+        try:
+            # Construct a provider reference from the resource's provider, if there is one.
+            provider_ref = None
+            provider = res.get_provider(tok) if res is not None else None
+            if provider is not None:
+                provider_urn = await provider.urn.future()
+                provider_id = (await provider.id.future()) or rpc.UNKNOWN
+                provider_ref = f"{provider_urn}::{provider_id}"
+                log.debug(f"Call using provider {provider_ref}")
+
+            monitor = get_monitor()
+
+            # Serialize out all props to their final values.  In doing so, we'll also collect all
+            # the Resources pointed to by any Dependency objects we encounter, adding them to 'property_dependencies'.
+            property_dependencies_resources: Dict[str, List['Resource']] = {}
+            inputs = await rpc.serialize_properties(props, property_dependencies_resources)
+
+            property_dependencies = {}
+            for key, property_deps in property_dependencies_resources.items():
+                urns = set()
+                for dep in property_deps:
+                    urn = await dep.urn.future()
+                    urns.add(urn)
+                property_dependencies[key] = provider_pb2.CallRequest.ArgumentDependencies(urns=list(urns))
+
+            log.debug(f"Call prepared: tok={tok}")
+            req = provider_pb2.CallRequest(
+                tok=tok,
+                args=inputs,
+                argDependencies=property_dependencies,
+                provider=provider_ref,
+            )
+
+            def do_rpc_call():
+                try:
+                    return monitor.Call(req), None
+                except grpc.RpcError as exn:
+                    return None, grpc_error_to_exception(exn)
+
+            resp, error = await asyncio.get_event_loop().run_in_executor(None, do_rpc_call)
+            log.debug(f"Call completed: tok={tok}, error={error}")
+
+            # If the call failed, raise an error.
+            if error is not None:
+                raise Exception(f"call of {tok} failed: {error}")
+            if resp.failures:
+                raise Exception(f"call of {tok} failed: {resp.failures[0].reason} ({resp.failures[0].property})")
+
+            # Combine the individual dependencies into a single set of dependency resources.
+            from ..resource import DependencyResource  # pylint: disable=import-outside-toplevel
+            deps_urns: Set[str] = set()
+            for return_deps in resp.returnDependencies.values():
+                deps_urns |= set(return_deps.urns)
+            deps = set(map(DependencyResource, deps_urns))
+            if res is not None:
+                deps.add(res)
+
+            # Unwrap the return value, keeping track of whether any of the properties are secret.
+            value = None
+            is_secret = False
+            ret_obj = getattr(resp, 'return')
+            if ret_obj:
+                deserialized = rpc.deserialize_properties(ret_obj, keep_unknowns=True)
+                for k, v in deserialized.items():
+                    if rpc.is_rpc_secret(v):
+                        is_secret = True
+                        deserialized[k] = rpc.unwrap_rpc_secret(v)
+                # If typ is not None, call translate_output_properties to instantiate any output types.
+                value = rpc.translate_output_properties(deserialized, lambda prop: prop, typ) if typ else deserialized
+
+            resolve_value.set_result(value)
+            resolve_is_known.set_result(not rpc.contains_unknowns(value))
+            resolve_is_secret.set_result(is_secret)
+            resolve_deps.set_result(deps)
+        except Exception as exn:
+            resolve_value.set_exception(exn)
+            resolve_is_known.set_exception(exn)
+            resolve_is_secret.set_exception(exn)
+            resolve_deps.set_exception(exn)
+
+    asyncio.ensure_future(RPC_MANAGER.do_rpc("call", do_call)())
+
+    return out
@@ -163,6 +163,24 @@ class MockMonitor:
         fields = {"failures": failures, "return": ret_proto}
         return provider_pb2.InvokeResponse(**fields)
 
+    def Call(self, request):
+        # Ensure we have an event loop on this thread because it's needed when deserializing resource references.
+        _ensure_event_loop()
+
+        args = rpc.deserialize_properties(request.args)
+
+        call_args = MockCallArgs(token=request.tok, args=args, provider=request.provider)
+        tup = self.mocks.call(call_args)
+        if isinstance(tup, dict):
+            (ret, failures) = (tup, None)
+        else:
+            (ret, failures) = tup[0], [provider_pb2.CheckFailure(property=failure[0], reason=failure[1]) for failure in tup[1]]
+
+        ret_proto = _sync_await(rpc.serialize_properties(ret, {}))
+
+        fields = {"failures": failures, "return": ret_proto}
+        return provider_pb2.CallResponse(**fields)
+
     def ReadResource(self, request):
         # Ensure we have an event loop on this thread because it's needed when deserializing resource references.
         _ensure_event_loop()
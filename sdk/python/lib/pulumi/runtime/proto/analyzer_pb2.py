@@ -13,6 +13,7 @@ _sym_db = _symbol_database.Default()
 
 
 from . import plugin_pb2 as plugin__pb2
+from . import provider_pb2 as provider__pb2
 from google.protobuf import empty_pb2 as google_dot_protobuf_dot_empty__pb2
 from google.protobuf import struct_pb2 as google_dot_protobuf_dot_struct__pb2
 
@@ -22,9 +23,9 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='pulumirpc',
   syntax='proto3',
   serialized_options=None,
-  serialized_pb=b'\n\x0e\x61nalyzer.proto\x12\tpulumirpc\x1a\x0cplugin.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xd2\x01\n\x0e\x41nalyzeRequest\x12\x0c\n\x04type\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0b\n\x03urn\x18\x03 \x01(\t\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x33\n\x07options\x18\x05 \x01(\x0b\x32\".pulumirpc.AnalyzerResourceOptions\x12\x35\n\x08provider\x18\x06 \x01(\x0b\x32#.pulumirpc.AnalyzerProviderResource\"\xb5\x03\n\x10\x41nalyzerResource\x12\x0c\n\x04type\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0b\n\x03urn\x18\x03 \x01(\t\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x33\n\x07options\x18\x05 \x01(\x0b\x32\".pulumirpc.AnalyzerResourceOptions\x12\x35\n\x08provider\x18\x06 \x01(\x0b\x32#.pulumirpc.AnalyzerProviderResource\x12\x0e\n\x06parent\x18\x07 \x01(\t\x12\x14\n\x0c\x64\x65pendencies\x18\x08 \x03(\t\x12S\n\x14propertyDependencies\x18\t \x03(\x0b\x32\x35.pulumirpc.AnalyzerResource.PropertyDependenciesEntry\x1a\x64\n\x19PropertyDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x36\n\x05value\x18\x02 \x01(\x0b\x32\'.pulumirpc.AnalyzerPropertyDependencies:\x02\x38\x01\"\xc1\x02\n\x17\x41nalyzerResourceOptions\x12\x0f\n\x07protect\x18\x01 \x01(\x08\x12\x15\n\rignoreChanges\x18\x02 \x03(\t\x12\x1b\n\x13\x64\x65leteBeforeReplace\x18\x03 \x01(\x08\x12\"\n\x1a\x64\x65leteBeforeReplaceDefined\x18\x04 \x01(\x08\x12\x1f\n\x17\x61\x64\x64itionalSecretOutputs\x18\x05 \x03(\t\x12\x0f\n\x07\x61liases\x18\x06 \x03(\t\x12I\n\x0e\x63ustomTimeouts\x18\x07 \x01(\x0b\x32\x31.pulumirpc.AnalyzerResourceOptions.CustomTimeouts\x1a@\n\x0e\x43ustomTimeouts\x12\x0e\n\x06\x63reate\x18\x01 \x01(\x01\x12\x0e\n\x06update\x18\x02 \x01(\x01\x12\x0e\n\x06\x64\x65lete\x18\x03 \x01(\x01\"p\n\x18\x41nalyzerProviderResource\x12\x0c\n\x04type\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0b\n\x03urn\x18\x03 \x01(\t\x12\x0c\n\x04name\x18\x04 \x01(\t\",\n\x1c\x41nalyzerPropertyDependencies\x12\x0c\n\x04urns\x18\x01 \x03(\t\"E\n\x13\x41nalyzeStackRequest\x12.\n\tresources\x18\x01 \x03(\x0b\x32\x1b.pulumirpc.AnalyzerResource\"D\n\x0f\x41nalyzeResponse\x12\x31\n\x0b\x64iagnostics\x18\x02 \x03(\x0b\x32\x1c.pulumirpc.AnalyzeDiagnostic\"\xd2\x01\n\x11\x41nalyzeDiagnostic\x12\x12\n\npolicyName\x18\x01 \x01(\t\x12\x16\n\x0epolicyPackName\x18\x02 \x01(\t\x12\x19\n\x11policyPackVersion\x18\x03 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x04 \x01(\t\x12\x0f\n\x07message\x18\x05 \x01(\t\x12\x0c\n\x04tags\x18\x06 \x03(\t\x12\x35\n\x10\x65nforcementLevel\x18\x07 \x01(\x0e\x32\x1b.pulumirpc.EnforcementLevel\x12\x0b\n\x03urn\x18\x08 \x01(\t\"\x95\x02\n\x0c\x41nalyzerInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12\'\n\x08policies\x18\x03 \x03(\x0b\x32\x15.pulumirpc.PolicyInfo\x12\x0f\n\x07version\x18\x04 \x01(\t\x12\x16\n\x0esupportsConfig\x18\x05 \x01(\x08\x12\x41\n\rinitialConfig\x18\x06 \x03(\x0b\x32*.pulumirpc.AnalyzerInfo.InitialConfigEntry\x1aM\n\x12InitialConfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.pulumirpc.PolicyConfig:\x02\x38\x01\"\xc1\x01\n\nPolicyInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\x0f\n\x07message\x18\x04 \x01(\t\x12\x35\n\x10\x65nforcementLevel\x18\x05 \x01(\x0e\x32\x1b.pulumirpc.EnforcementLevel\x12\x33\n\x0c\x63onfigSchema\x18\x06 \x01(\x0b\x32\x1d.pulumirpc.PolicyConfigSchema\"S\n\x12PolicyConfigSchema\x12+\n\nproperties\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x10\n\x08required\x18\x02 \x03(\t\"r\n\x0cPolicyConfig\x12\x35\n\x10\x65nforcementLevel\x18\x01 \x01(\x0e\x32\x1b.pulumirpc.EnforcementLevel\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\"\xb5\x01\n\x18\x43onfigureAnalyzerRequest\x12K\n\x0cpolicyConfig\x18\x01 \x03(\x0b\x32\x35.pulumirpc.ConfigureAnalyzerRequest.PolicyConfigEntry\x1aL\n\x11PolicyConfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.pulumirpc.PolicyConfig:\x02\x38\x01*=\n\x10\x45nforcementLevel\x12\x0c\n\x08\x41\x44VISORY\x10\x00\x12\r\n\tMANDATORY\x10\x01\x12\x0c\n\x08\x44ISABLED\x10\x02\x32\xf0\x02\n\x08\x41nalyzer\x12\x42\n\x07\x41nalyze\x12\x19.pulumirpc.AnalyzeRequest\x1a\x1a.pulumirpc.AnalyzeResponse\"\x00\x12L\n\x0c\x41nalyzeStack\x12\x1e.pulumirpc.AnalyzeStackRequest\x1a\x1a.pulumirpc.AnalyzeResponse\"\x00\x12\x44\n\x0fGetAnalyzerInfo\x12\x16.google.protobuf.Empty\x1a\x17.pulumirpc.AnalyzerInfo\"\x00\x12@\n\rGetPluginInfo\x12\x16.google.protobuf.Empty\x1a\x15.pulumirpc.PluginInfo\"\x00\x12J\n\tConfigure\x12#.pulumirpc.ConfigureAnalyzerRequest\x1a\x16.google.protobuf.Empty\"\x00\x62\x06proto3'
+  serialized_pb=b'\n\x0e\x61nalyzer.proto\x12\tpulumirpc\x1a\x0cplugin.proto\x1a\x0eprovider.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xd2\x01\n\x0e\x41nalyzeRequest\x12\x0c\n\x04type\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0b\n\x03urn\x18\x03 \x01(\t\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x33\n\x07options\x18\x05 \x01(\x0b\x32\".pulumirpc.AnalyzerResourceOptions\x12\x35\n\x08provider\x18\x06 \x01(\x0b\x32#.pulumirpc.AnalyzerProviderResource\"\xb5\x03\n\x10\x41nalyzerResource\x12\x0c\n\x04type\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0b\n\x03urn\x18\x03 \x01(\t\x12\x0c\n\x04name\x18\x04 \x01(\t\x12\x33\n\x07options\x18\x05 \x01(\x0b\x32\".pulumirpc.AnalyzerResourceOptions\x12\x35\n\x08provider\x18\x06 \x01(\x0b\x32#.pulumirpc.AnalyzerProviderResource\x12\x0e\n\x06parent\x18\x07 \x01(\t\x12\x14\n\x0c\x64\x65pendencies\x18\x08 \x03(\t\x12S\n\x14propertyDependencies\x18\t \x03(\x0b\x32\x35.pulumirpc.AnalyzerResource.PropertyDependenciesEntry\x1a\x64\n\x19PropertyDependenciesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x36\n\x05value\x18\x02 \x01(\x0b\x32\'.pulumirpc.AnalyzerPropertyDependencies:\x02\x38\x01\"\xc1\x02\n\x17\x41nalyzerResourceOptions\x12\x0f\n\x07protect\x18\x01 \x01(\x08\x12\x15\n\rignoreChanges\x18\x02 \x03(\t\x12\x1b\n\x13\x64\x65leteBeforeReplace\x18\x03 \x01(\x08\x12\"\n\x1a\x64\x65leteBeforeReplaceDefined\x18\x04 \x01(\x08\x12\x1f\n\x17\x61\x64\x64itionalSecretOutputs\x18\x05 \x03(\t\x12\x0f\n\x07\x61liases\x18\x06 \x03(\t\x12I\n\x0e\x63ustomTimeouts\x18\x07 \x01(\x0b\x32\x31.pulumirpc.AnalyzerResourceOptions.CustomTimeouts\x1a@\n\x0e\x43ustomTimeouts\x12\x0e\n\x06\x63reate\x18\x01 \x01(\x01\x12\x0e\n\x06update\x18\x02 \x01(\x01\x12\x0e\n\x06\x64\x65lete\x18\x03 \x01(\x01\"p\n\x18\x41nalyzerProviderResource\x12\x0c\n\x04type\x18\x01 \x01(\t\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x0b\n\x03urn\x18\x03 \x01(\t\x12\x0c\n\x04name\x18\x04 \x01(\t\",\n\x1c\x41nalyzerPropertyDependencies\x12\x0c\n\x04urns\x18\x01 \x03(\t\"E\n\x13\x41nalyzeStackRequest\x12.\n\tresources\x18\x01 \x03(\x0b\x32\x1b.pulumirpc.AnalyzerResource\"C\n\x12\x41nalyzePlanRequest\x12-\n\x05steps\x18\x01 \x03(\x0b\x32\x1e.pulumirpc.AnalyzerPlannedStep\"\xca\x02\n\x13\x41nalyzerPlannedStep\x12\n\n\x02op\x18\x01 \x01(\t\x12\x0b\n\x03urn\x18\x02 \x01(\t\x12\x0c\n\x04type\x18\x03 \x01(\t\x12(\n\x03old\x18\x04 \x01(\x0b\x32\x1b.pulumirpc.AnalyzerResource\x12(\n\x03new\x18\x05 \x01(\x0b\x32\x1b.pulumirpc.AnalyzerResource\x12\x13\n\x0breplaceKeys\x18\x06 \x03(\t\x12\r\n\x05\x64iffs\x18\x07 \x03(\t\x12\x46\n\x0c\x64\x65tailedDiff\x18\x08 \x03(\x0b\x32\x30.pulumirpc.AnalyzerPlannedStep.DetailedDiffEntry\x1aL\n\x11\x44\x65tailedDiffEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.pulumirpc.PropertyDiff:\x02\x38\x01\"D\n\x0f\x41nalyzeResponse\x12\x31\n\x0b\x64iagnostics\x18\x02 \x03(\x0b\x32\x1c.pulumirpc.AnalyzeDiagnostic\"\xd2\x01\n\x11\x41nalyzeDiagnostic\x12\x12\n\npolicyName\x18\x01 \x01(\t\x12\x16\n\x0epolicyPackName\x18\x02 \x01(\t\x12\x19\n\x11policyPackVersion\x18\x03 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x04 \x01(\t\x12\x0f\n\x07message\x18\x05 \x01(\t\x12\x0c\n\x04tags\x18\x06 \x03(\t\x12\x35\n\x10\x65nforcementLevel\x18\x07 \x01(\x0e\x32\x1b.pulumirpc.EnforcementLevel\x12\x0b\n\x03urn\x18\x08 \x01(\t\"\xaa\x01\n\x0bRemediation\x12\x12\n\npolicyName\x18\x01 \x01(\t\x12\x16\n\x0epolicyPackName\x18\x02 \x01(\t\x12\x19\n\x11policyPackVersion\x18\x03 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x04 \x01(\t\x12+\n\nproperties\x18\x05 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x12\n\ndiagnostic\x18\x06 \x01(\t\"A\n\x11RemediateResponse\x12,\n\x0cremediations\x18\x01 \x03(\x0b\x32\x16.pulumirpc.Remediation\"\x95\x02\n\x0c\x41nalyzerInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12\'\n\x08policies\x18\x03 \x03(\x0b\x32\x15.pulumirpc.PolicyInfo\x12\x0f\n\x07version\x18\x04 \x01(\t\x12\x16\n\x0esupportsConfig\x18\x05 \x01(\x08\x12\x41\n\rinitialConfig\x18\x06 \x03(\x0b\x32*.pulumirpc.AnalyzerInfo.InitialConfigEntry\x1aM\n\x12InitialConfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.pulumirpc.PolicyConfig:\x02\x38\x01\"\xc1\x01\n\nPolicyInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\x0f\n\x07message\x18\x04 \x01(\t\x12\x35\n\x10\x65nforcementLevel\x18\x05 \x01(\x0e\x32\x1b.pulumirpc.EnforcementLevel\x12\x33\n\x0c\x63onfigSchema\x18\x06 \x01(\x0b\x32\x1d.pulumirpc.PolicyConfigSchema\"S\n\x12PolicyConfigSchema\x12+\n\nproperties\x18\x01 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x10\n\x08required\x18\x02 \x03(\t\"r\n\x0cPolicyConfig\x12\x35\n\x10\x65nforcementLevel\x18\x01 \x01(\x0e\x32\x1b.pulumirpc.EnforcementLevel\x12+\n\nproperties\x18\x02 \x01(\x0b\x32\x17.google.protobuf.Struct\"\xb5\x01\n\x18\x43onfigureAnalyzerRequest\x12K\n\x0cpolicyConfig\x18\x01 \x03(\x0b\x32\x35.pulumirpc.ConfigureAnalyzerRequest.PolicyConfigEntry\x1aL\n\x11PolicyConfigEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.pulumirpc.PolicyConfig:\x02\x38\x01*L\n\x10\x45nforcementLevel\x12\x0c\n\x08\x41\x44VISORY\x10\x00\x12\r\n\tMANDATORY\x10\x01\x12\x0c\n\x08\x44ISABLED\x10\x02\x12\r\n\tREMEDIATE\x10\x03\x32\x84\x04\n\x08\x41nalyzer\x12\x42\n\x07\x41nalyze\x12\x19.pulumirpc.AnalyzeRequest\x1a\x1a.pulumirpc.AnalyzeResponse\"\x00\x12L\n\x0c\x41nalyzeStack\x12\x1e.pulumirpc.AnalyzeStackRequest\x1a\x1a.pulumirpc.AnalyzeResponse\"\x00\x12J\n\x0b\x41nalyzePlan\x12\x1d.pulumirpc.AnalyzePlanRequest\x1a\x1a.pulumirpc.AnalyzeResponse\"\x00\x12\x46\n\tRemediate\x12\x19.pulumirpc.AnalyzeRequest\x1a\x1c.pulumirpc.RemediateResponse\"\x00\x12\x44\n\x0fGetAnalyzerInfo\x12\x16.google.protobuf.Empty\x1a\x17.pulumirpc.AnalyzerInfo\"\x00\x12@\n\rGetPluginInfo\x12\x16.google.protobuf.Empty\x1a\x15.pulumirpc.PluginInfo\"\x00\x12J\n\tConfigure\x12#.pulumirpc.ConfigureAnalyzerRequest\x1a\x16.google.protobuf.Empty\"\x00\x62\x06proto3'
   ,
-  dependencies=[plugin__pb2.DESCRIPTOR,google_dot_protobuf_dot_empty__pb2.DESCRIPTOR,google_dot_protobuf_dot_struct__pb2.DESCRIPTOR,])
+  dependencies=[plugin__pb2.DESCRIPTOR,provider__pb2.DESCRIPTOR,google_dot_protobuf_dot_empty__pb2.DESCRIPTOR,google_dot_protobuf_dot_struct__pb2.DESCRIPTOR,])
 
 _ENFORCEMENTLEVEL = _descriptor.EnumDescriptor(
   name='EnforcementLevel',
@@ -44,11 +45,15 @@ _ENFORCEMENTLEVEL = _descriptor.EnumDescriptor(
       name='DISABLED', index=2, number=2,
       serialized_options=None,
       type=None),
+    _descriptor.EnumValueDescriptor(
+      name='REMEDIATE', index=3, number=3,
+      serialized_options=None,
+      type=None),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2454,
-  serialized_end=2515,
+  serialized_start=3112,
+  serialized_end=3188,
 )
 _sym_db.RegisterEnumDescriptor(_ENFORCEMENTLEVEL)
 
@@ -56,6 +61,7 @@ EnforcementLevel = enum_type_wrapper.EnumTypeWrapper(_ENFORCEMENTLEVEL)
 ADVISORY = 0
 MANDATORY = 1
 DISABLED = 2
+REMEDIATE = 3
 
 
 
@@ -120,8 +126,8 @@ _ANALYZEREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=103,
-  serialized_end=313,
+  serialized_start=119,
+  serialized_end=329,
 )
 
 
@@ -158,8 +164,8 @@ _ANALYZERRESOURCE_PROPERTYDEPENDENCIESENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=653,
-  serialized_end=753,
+  serialized_start=669,
+  serialized_end=769,
 )
 
 _ANALYZERRESOURCE = _descriptor.Descriptor(
@@ -244,8 +250,8 @@ _ANALYZERRESOURCE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=316,
-  serialized_end=753,
+  serialized_start=332,
+  serialized_end=769,
 )
 
 
@@ -289,8 +295,8 @@ _ANALYZERRESOURCEOPTIONS_CUSTOMTIMEOUTS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1013,
-  serialized_end=1077,
+  serialized_start=1029,
+  serialized_end=1093,
 )
 
 _ANALYZERRESOURCEOPTIONS = _descriptor.Descriptor(
@@ -361,8 +367,8 @@ _ANALYZERRESOURCEOPTIONS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=756,
-  serialized_end=1077,
+  serialized_start=772,
+  serialized_end=1093,
 )
 
 
@@ -413,8 +419,8 @@ _ANALYZERPROVIDERRESOURCE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1079,
-  serialized_end=1191,
+  serialized_start=1095,
+  serialized_end=1207,
 )
 
 
@@ -444,8 +450,8 @@ _ANALYZERPROPERTYDEPENDENCIES = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1193,
-  serialized_end=1237,
+  serialized_start=1209,
+  serialized_end=1253,
 )
 
 
@@ -475,8 +481,156 @@ _ANALYZESTACKREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1239,
-  serialized_end=1308,
+  serialized_start=1255,
+  serialized_end=1324,
+)
+
+
+_ANALYZEPLANREQUEST = _descriptor.Descriptor(
+  name='AnalyzePlanRequest',
+  full_name='pulumirpc.AnalyzePlanRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='steps', full_name='pulumirpc.AnalyzePlanRequest.steps', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1326,
+  serialized_end=1393,
+)
+
+
+_ANALYZERPLANNEDSTEP_DETAILEDDIFFENTRY = _descriptor.Descriptor(
+  name='DetailedDiffEntry',
+  full_name='pulumirpc.AnalyzerPlannedStep.DetailedDiffEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='pulumirpc.AnalyzerPlannedStep.DetailedDiffEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='pulumirpc.AnalyzerPlannedStep.DetailedDiffEntry.value', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1650,
+  serialized_end=1726,
+)
+
+_ANALYZERPLANNEDSTEP = _descriptor.Descriptor(
+  name='AnalyzerPlannedStep',
+  full_name='pulumirpc.AnalyzerPlannedStep',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='op', full_name='pulumirpc.AnalyzerPlannedStep.op', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='urn', full_name='pulumirpc.AnalyzerPlannedStep.urn', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='pulumirpc.AnalyzerPlannedStep.type', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='old', full_name='pulumirpc.AnalyzerPlannedStep.old', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='new', full_name='pulumirpc.AnalyzerPlannedStep.new', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='replaceKeys', full_name='pulumirpc.AnalyzerPlannedStep.replaceKeys', index=5,
+      number=6, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='diffs', full_name='pulumirpc.AnalyzerPlannedStep.diffs', index=6,
+      number=7, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='detailedDiff', full_name='pulumirpc.AnalyzerPlannedStep.detailedDiff', index=7,
+      number=8, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[_ANALYZERPLANNEDSTEP_DETAILEDDIFFENTRY, ],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1396,
+  serialized_end=1726,
 )
 
 
@@ -506,8 +660,8 @@ _ANALYZERESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1310,
-  serialized_end=1378,
+  serialized_start=1728,
+  serialized_end=1796,
 )
 
 
@@ -586,8 +740,105 @@ _ANALYZEDIAGNOSTIC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1381,
-  serialized_end=1591,
+  serialized_start=1799,
+  serialized_end=2009,
+)
+
+
+_REMEDIATION = _descriptor.Descriptor(
+  name='Remediation',
+  full_name='pulumirpc.Remediation',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='policyName', full_name='pulumirpc.Remediation.policyName', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='policyPackName', full_name='pulumirpc.Remediation.policyPackName', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='policyPackVersion', full_name='pulumirpc.Remediation.policyPackVersion', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='description', full_name='pulumirpc.Remediation.description', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='properties', full_name='pulumirpc.Remediation.properties', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='diagnostic', full_name='pulumirpc.Remediation.diagnostic', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2012,
+  serialized_end=2182,
+)
+
+
+_REMEDIATERESPONSE = _descriptor.Descriptor(
+  name='RemediateResponse',
+  full_name='pulumirpc.RemediateResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='remediations', full_name='pulumirpc.RemediateResponse.remediations', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2184,
+  serialized_end=2249,
 )
 
 
@@ -624,8 +875,8 @@ _ANALYZERINFO_INITIALCONFIGENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1794,
-  serialized_end=1871,
+  serialized_start=2452,
+  serialized_end=2529,
 )
 
 _ANALYZERINFO = _descriptor.Descriptor(
@@ -689,8 +940,8 @@ _ANALYZERINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1594,
-  serialized_end=1871,
+  serialized_start=2252,
+  serialized_end=2529,
 )
 
 
@@ -755,8 +1006,8 @@ _POLICYINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1874,
-  serialized_end=2067,
+  serialized_start=2532,
+  serialized_end=2725,
 )
 
 
@@ -793,8 +1044,8 @@ _POLICYCONFIGSCHEMA = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2069,
-  serialized_end=2152,
+  serialized_start=2727,
+  serialized_end=2810,
 )
 
 
@@ -831,8 +1082,8 @@ _POLICYCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2154,
-  serialized_end=2268,
+  serialized_start=2812,
+  serialized_end=2926,
 )
 
 
@@ -869,8 +1120,8 @@ _CONFIGUREANALYZERREQUEST_POLICYCONFIGENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2376,
-  serialized_end=2452,
+  serialized_start=3034,
+  serialized_end=3110,
 )
 
 _CONFIGUREANALYZERREQUEST = _descriptor.Descriptor(
@@ -899,8 +1150,8 @@ _CONFIGUREANALYZERREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=2271,
-  serialized_end=2452,
+  serialized_start=2929,
+  serialized_end=3110,
 )
 
 _ANALYZEREQUEST.fields_by_name['properties'].message_type = google_dot_protobuf_dot_struct__pb2._STRUCT
@@ -916,8 +1167,16 @@ _ANALYZERRESOURCEOPTIONS_CUSTOMTIMEOUTS.containing_type = _ANALYZERRESOURCEOPTIO
 _ANALYZERRESOURCEOPTIONS.fields_by_name['customTimeouts'].message_type = _ANALYZERRESOURCEOPTIONS_CUSTOMTIMEOUTS
 _ANALYZERPROVIDERRESOURCE.fields_by_name['properties'].message_type = google_dot_protobuf_dot_struct__pb2._STRUCT
 _ANALYZESTACKREQUEST.fields_by_name['resources'].message_type = _ANALYZERRESOURCE
+_ANALYZEPLANREQUEST.fields_by_name['steps'].message_type = _ANALYZERPLANNEDSTEP
+_ANALYZERPLANNEDSTEP_DETAILEDDIFFENTRY.fields_by_name['value'].message_type = provider__pb2._PROPERTYDIFF
+_ANALYZERPLANNEDSTEP_DETAILEDDIFFENTRY.containing_type = _ANALYZERPLANNEDSTEP
+_ANALYZERPLANNEDSTEP.fields_by_name['old'].message_type = _ANALYZERRESOURCE
+_ANALYZERPLANNEDSTEP.fields_by_name['new'].message_type = _ANALYZERRESOURCE
+_ANALYZERPLANNEDSTEP.fields_by_name['detailedDiff'].message_type = _ANALYZERPLANNEDSTEP_DETAILEDDIFFENTRY
 _ANALYZERESPONSE.fields_by_name['diagnostics'].message_type = _ANALYZEDIAGNOSTIC
 _ANALYZEDIAGNOSTIC.fields_by_name['enforcementLevel'].enum_type = _ENFORCEMENTLEVEL
+_REMEDIATION.fields_by_name['properties'].message_type = google_dot_protobuf_dot_struct__pb2._STRUCT
+_REMEDIATERESPONSE.fields_by_name['remediations'].message_type = _REMEDIATION
 _ANALYZERINFO_INITIALCONFIGENTRY.fields_by_name['value'].message_type = _POLICYCONFIG
 _ANALYZERINFO_INITIALCONFIGENTRY.containing_type = _ANALYZERINFO
 _ANALYZERINFO.fields_by_name['policies'].message_type = _POLICYINFO
@@ -936,8 +1195,12 @@ DESCRIPTOR.message_types_by_name['AnalyzerResourceOptions'] = _ANALYZERRESOURCEO
 DESCRIPTOR.message_types_by_name['AnalyzerProviderResource'] = _ANALYZERPROVIDERRESOURCE
 DESCRIPTOR.message_types_by_name['AnalyzerPropertyDependencies'] = _ANALYZERPROPERTYDEPENDENCIES
 DESCRIPTOR.message_types_by_name['AnalyzeStackRequest'] = _ANALYZESTACKREQUEST
+DESCRIPTOR.message_types_by_name['AnalyzePlanRequest'] = _ANALYZEPLANREQUEST
+DESCRIPTOR.message_types_by_name['AnalyzerPlannedStep'] = _ANALYZERPLANNEDSTEP
 DESCRIPTOR.message_types_by_name['AnalyzeResponse'] = _ANALYZERESPONSE
 DESCRIPTOR.message_types_by_name['AnalyzeDiagnostic'] = _ANALYZEDIAGNOSTIC
+DESCRIPTOR.message_types_by_name['Remediation'] = _REMEDIATION
+DESCRIPTOR.message_types_by_name['RemediateResponse'] = _REMEDIATERESPONSE
 DESCRIPTOR.message_types_by_name['AnalyzerInfo'] = _ANALYZERINFO
 DESCRIPTOR.message_types_by_name['PolicyInfo'] = _POLICYINFO
 DESCRIPTOR.message_types_by_name['PolicyConfigSchema'] = _POLICYCONFIGSCHEMA
@@ -1004,6 +1267,28 @@ AnalyzeStackRequest = _reflection.GeneratedProtocolMessageType('AnalyzeStackRequ
   })
 _sym_db.RegisterMessage(AnalyzeStackRequest)
 
+AnalyzePlanRequest = _reflection.GeneratedProtocolMessageType('AnalyzePlanRequest', (_message.Message,), {
+  'DESCRIPTOR' : _ANALYZEPLANREQUEST,
+  '__module__' : 'analyzer_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.AnalyzePlanRequest)
+  })
+_sym_db.RegisterMessage(AnalyzePlanRequest)
+
+AnalyzerPlannedStep = _reflection.GeneratedProtocolMessageType('AnalyzerPlannedStep', (_message.Message,), {
+
+  'DetailedDiffEntry' : _reflection.GeneratedProtocolMessageType('DetailedDiffEntry', (_message.Message,), {
+    'DESCRIPTOR' : _ANALYZERPLANNEDSTEP_DETAILEDDIFFENTRY,
+    '__module__' : 'analyzer_pb2'
+    # @@protoc_insertion_point(class_scope:pulumirpc.AnalyzerPlannedStep.DetailedDiffEntry)
+    })
+  ,
+  'DESCRIPTOR' : _ANALYZERPLANNEDSTEP,
+  '__module__' : 'analyzer_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.AnalyzerPlannedStep)
+  })
+_sym_db.RegisterMessage(AnalyzerPlannedStep)
+_sym_db.RegisterMessage(AnalyzerPlannedStep.DetailedDiffEntry)
+
 AnalyzeResponse = _reflection.GeneratedProtocolMessageType('AnalyzeResponse', (_message.Message,), {
   'DESCRIPTOR' : _ANALYZERESPONSE,
   '__module__' : 'analyzer_pb2'
@@ -1018,6 +1303,20 @@ AnalyzeDiagnostic = _reflection.GeneratedProtocolMessageType('AnalyzeDiagnostic'
   })
 _sym_db.RegisterMessage(AnalyzeDiagnostic)
 
+Remediation = _reflection.GeneratedProtocolMessageType('Remediation', (_message.Message,), {
+  'DESCRIPTOR' : _REMEDIATION,
+  '__module__' : 'analyzer_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.Remediation)
+  })
+_sym_db.RegisterMessage(Remediation)
+
+RemediateResponse = _reflection.GeneratedProtocolMessageType('RemediateResponse', (_message.Message,), {
+  'DESCRIPTOR' : _REMEDIATERESPONSE,
+  '__module__' : 'analyzer_pb2'
+  # @@protoc_insertion_point(class_scope:pulumirpc.RemediateResponse)
+  })
+_sym_db.RegisterMessage(RemediateResponse)
+
 AnalyzerInfo = _reflection.GeneratedProtocolMessageType('AnalyzerInfo', (_message.Message,), {
 
   'InitialConfigEntry' : _reflection.GeneratedProtocolMessageType('InitialConfigEntry', (_message.Message,), {
@@ -1071,6 +1370,7 @@ _sym_db.RegisterMessage(ConfigureAnalyzerRequest.PolicyConfigEntry)
 
 
 _ANALYZERRESOURCE_PROPERTYDEPENDENCIESENTRY._options = None
+_ANALYZERPLANNEDSTEP_DETAILEDDIFFENTRY._options = None
 _ANALYZERINFO_INITIALCONFIGENTRY._options = None
 _CONFIGUREANALYZERREQUEST_POLICYCONFIGENTRY._options = None
 
@@ -1080,8 +1380,8 @@ _ANALYZER = _descriptor.ServiceDescriptor(
   file=DESCRIPTOR,
   index=0,
   serialized_options=None,
-  serialized_start=2518,
-  serialized_end=2886,
+  serialized_start=3191,
+  serialized_end=3707,
   methods=[
   _descriptor.MethodDescriptor(
     name='Analyze',
@@ -1101,10 +1401,28 @@ _ANALYZER = _descriptor.ServiceDescriptor(
     output_type=_ANALYZERESPONSE,
     serialized_options=None,
   ),
+  _descriptor.MethodDescriptor(
+    name='AnalyzePlan',
+    full_name='pulumirpc.Analyzer.AnalyzePlan',
+    index=2,
+    containing_service=None,
+    input_type=_ANALYZEPLANREQUEST,
+    output_type=_ANALYZERESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Remediate',
+    full_name='pulumirpc.Analyzer.Remediate',
+    index=3,
+    containing_service=None,
+    input_type=_ANALYZEREQUEST,
+    output_type=_REMEDIATERESPONSE,
+    serialized_options=None,
+  ),
   _descriptor.MethodDescriptor(
     name='GetAnalyzerInfo',
     full_name='pulumirpc.Analyzer.GetAnalyzerInfo',
-    index=2,
+    index=4,
     containing_service=None,
     input_type=google_dot_protobuf_dot_empty__pb2._EMPTY,
     output_type=_ANALYZERINFO,
@@ -1113,7 +1431,7 @@ _ANALYZER = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='GetPluginInfo',
     full_name='pulumirpc.Analyzer.GetPluginInfo',
-    index=3,
+    index=5,
     containing_service=None,
     input_type=google_dot_protobuf_dot_empty__pb2._EMPTY,
     output_type=plugin__pb2._PLUGININFO,
@@ -1122,7 +1440,7 @@ _ANALYZER = _descriptor.ServiceDescriptor(
   _descriptor.MethodDescriptor(
     name='Configure',
     full_name='pulumirpc.Analyzer.Configure',
-    index=4,
+    index=6,
     containing_service=None,
     input_type=_CONFIGUREANALYZERREQUEST,
     output_type=google_dot_protobuf_dot_empty__pb2._EMPTY,
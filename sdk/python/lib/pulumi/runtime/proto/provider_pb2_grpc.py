@@ -82,6 +82,16 @@ class ResourceProviderStub(object):
         request_serializer=provider__pb2.ConstructRequest.SerializeToString,
         response_deserializer=provider__pb2.ConstructResponse.FromString,
         )
+    self.Call = channel.unary_unary(
+        '/pulumirpc.ResourceProvider/Call',
+        request_serializer=provider__pb2.CallRequest.SerializeToString,
+        response_deserializer=provider__pb2.CallResponse.FromString,
+        )
+    self.ListResources = channel.unary_unary(
+        '/pulumirpc.ResourceProvider/ListResources',
+        request_serializer=provider__pb2.ListResourcesRequest.SerializeToString,
+        response_deserializer=provider__pb2.ListResourcesResponse.FromString,
+        )
     self.Cancel = channel.unary_unary(
         '/pulumirpc.ResourceProvider/Cancel',
         request_serializer=google_dot_protobuf_dot_empty__pb2.Empty.SerializeToString,
@@ -197,6 +207,21 @@ class ResourceProviderServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def Call(self, request, context):
+    """Call dynamically executes a method in the provider associated with a component resource.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def ListResources(self, request, context):
+    """ListResources enumerates the existing resources that the provider is able to manage, e.g. so that they may be
+    discovered and imported in bulk. Providers that cannot enumerate their resources need not implement this.
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def Cancel(self, request, context):
     """Cancel signals the provider to abort all outstanding resource operations.
     """
@@ -279,6 +304,16 @@ def add_ResourceProviderServicer_to_server(servicer, server):
           request_deserializer=provider__pb2.ConstructRequest.FromString,
           response_serializer=provider__pb2.ConstructResponse.SerializeToString,
       ),
+      'Call': grpc.unary_unary_rpc_method_handler(
+          servicer.Call,
+          request_deserializer=provider__pb2.CallRequest.FromString,
+          response_serializer=provider__pb2.CallResponse.SerializeToString,
+      ),
+      'ListResources': grpc.unary_unary_rpc_method_handler(
+          servicer.ListResources,
+          request_deserializer=provider__pb2.ListResourcesRequest.FromString,
+          response_serializer=provider__pb2.ListResourcesResponse.SerializeToString,
+      ),
       'Cancel': grpc.unary_unary_rpc_method_handler(
           servicer.Cancel,
           request_deserializer=google_dot_protobuf_dot_empty__pb2.Empty.FromString,
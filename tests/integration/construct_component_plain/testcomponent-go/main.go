@@ -157,6 +157,16 @@ func (p *testcomponentProvider) Invoke(ctx context.Context,
 	return nil, errors.Errorf("Unknown Invoke token '%s'", req.GetTok())
 }
 
+func (p *testcomponentProvider) Call(ctx context.Context,
+	req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+	return nil, errors.Errorf("Unknown Call token '%s'", req.GetTok())
+}
+
+func (p *testcomponentProvider) ListResources(ctx context.Context,
+	req *pulumirpc.ListResourcesRequest) (*pulumirpc.ListResourcesResponse, error) {
+	return nil, errors.New("ListResources is not supported")
+}
+
 func (p *testcomponentProvider) StreamInvoke(req *pulumirpc.InvokeRequest,
 	server pulumirpc.ResourceProvider_StreamInvokeServer) error {
 	return errors.Errorf("Unknown StreamInvoke token '%s'", req.GetTok())